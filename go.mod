@@ -3,10 +3,13 @@ module github.com/johnfercher/maroto/v2
 go 1.21.1
 
 require (
+	github.com/boombuler/barcode v1.0.1
 	github.com/google/uuid v1.5.0
 	github.com/johnfercher/go-tree v1.0.5
 	github.com/jung-kurt/gofpdf v1.16.2
 	github.com/stretchr/testify v1.8.4
+	golang.org/x/image v0.24.0
+	golang.org/x/net v0.35.0
 )
 
 require (
@@ -0,0 +1,239 @@
+// Package code implements the encoding of values into 1D and 2D codes.
+package code
+
+import (
+	"errors"
+	"fmt"
+	"image/color"
+	"strings"
+
+	"github.com/boombuler/barcode"
+	"github.com/boombuler/barcode/code128"
+	"github.com/boombuler/barcode/ean"
+	"github.com/boombuler/barcode/pdf417"
+	"github.com/boombuler/barcode/qr"
+	"github.com/boombuler/barcode/twooffive"
+
+	barcodetype "github.com/johnfercher/maroto/v2/pkg/consts/barcode"
+	"github.com/johnfercher/maroto/v2/pkg/consts/matrixcode"
+)
+
+// pdf417SecurityLevel is the error correction level used by PDF417, level 2 is the
+// minimum recommended by the specification.
+const pdf417SecurityLevel = 2
+
+// Matrix is the grid of modules of an encoded code, indexed by row and column.
+// A true module is a dark one.
+type Matrix [][]bool
+
+// GetColumns returns the amount of modules in a row of the Matrix.
+func (m Matrix) GetColumns() int {
+	if len(m) == 0 {
+		return 0
+	}
+
+	return len(m[0])
+}
+
+// GetRows returns the amount of rows of the Matrix.
+func (m Matrix) GetRows() int {
+	return len(m)
+}
+
+// GenQr encodes a value into a QR code Matrix.
+func GenQr(value string) (Matrix, error) {
+	if value == "" {
+		return nil, errors.New("qrcode value cannot be empty")
+	}
+
+	qrCode, err := qr.Encode(value, qr.M, qr.Auto)
+	if err != nil {
+		return nil, err
+	}
+
+	return fromBarcode(qrCode), nil
+}
+
+// GenMatrix encodes a value into a 2D Matrix of the given symbology.
+func GenMatrix(value string, symbology matrixcode.Symbology) (Matrix, error) {
+	switch symbology {
+	case matrixcode.DataMatrix:
+		return genDataMatrix(value, false)
+	case matrixcode.GS1DataMatrix:
+		return genDataMatrix(value, true)
+	case matrixcode.PDF417:
+		return genPDF417(value)
+	default:
+		return nil, fmt.Errorf("matrix code symbology %q is not supported", symbology)
+	}
+}
+
+// GenBar encodes a value into a 1D barcode Matrix with a single row, validating
+// the value against the rules of the symbology.
+func GenBar(value string, typ barcodetype.Type) (Matrix, error) {
+	var code barcode.Barcode
+	var err error
+
+	switch typ {
+	case barcodetype.EAN13:
+		code, err = encodeEAN13(value)
+	case barcodetype.UPCA:
+		code, err = encodeUPCA(value)
+	case barcodetype.ITF:
+		code, err = encodeITF(value)
+	case barcodetype.Code128:
+		code, err = encodeCode128(value)
+	default:
+		return nil, fmt.Errorf("barcode type %q is not supported", typ)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return fromBarcode(code)[:1], nil
+}
+
+func encodeEAN13(value string) (barcode.Barcode, error) {
+	if err := validateDigits(value, "EAN-13", 12, 13); err != nil {
+		return nil, err
+	}
+
+	if len(value) == 13 {
+		if err := validateCheckDigit(value, "EAN-13"); err != nil {
+			return nil, err
+		}
+	}
+
+	return ean.Encode(value)
+}
+
+func encodeUPCA(value string) (barcode.Barcode, error) {
+	if err := validateDigits(value, "UPC-A", 11, 12); err != nil {
+		return nil, err
+	}
+
+	if len(value) == 12 {
+		if err := validateCheckDigit(value, "UPC-A"); err != nil {
+			return nil, err
+		}
+	}
+
+	// An UPC-A is an EAN-13 with a leading zero.
+	return ean.Encode("0" + value)
+}
+
+func encodeITF(value string) (barcode.Barcode, error) {
+	if value == "" {
+		return nil, errors.New("ITF value cannot be empty")
+	}
+
+	if !isNumeric(value) {
+		return nil, fmt.Errorf("ITF only encodes digits, got %q", value)
+	}
+
+	// ITF encodes digits in pairs, padding an odd amount of digits would encode another value.
+	if len(value)%2 != 0 {
+		return nil, fmt.Errorf("ITF must have an even amount of digits, got %d", len(value))
+	}
+
+	return twooffive.Encode(value, true)
+}
+
+func encodeCode128(value string) (barcode.Barcode, error) {
+	if value == "" {
+		return nil, errors.New("value for Code 128 cannot be empty")
+	}
+
+	return code128.Encode(value)
+}
+
+func validateDigits(value, name string, lengths ...int) error {
+	if !isNumeric(value) {
+		return fmt.Errorf("%s only encodes digits, got %q", name, value)
+	}
+
+	for _, length := range lengths {
+		if len(value) == length {
+			return nil
+		}
+	}
+
+	expected := make([]string, 0, len(lengths))
+	for _, length := range lengths {
+		expected = append(expected, fmt.Sprintf("%d", length))
+	}
+
+	return fmt.Errorf("%s must have %s digits, got %d", name, strings.Join(expected, " or "), len(value))
+}
+
+func validateCheckDigit(value, name string) error {
+	expected := getCheckDigit(value[:len(value)-1])
+	actual := int(value[len(value)-1] - '0')
+
+	if expected != actual {
+		return fmt.Errorf("invalid %s check digit: expected %d, got %d", name, expected, actual)
+	}
+
+	return nil
+}
+
+// getCheckDigit calculates the GS1 modulo 10 check digit, weighting
+// the digits from the right with 3 and 1 alternately.
+func getCheckDigit(digits string) int {
+	sum := 0
+	weight := 3
+	for i := len(digits) - 1; i >= 0; i-- {
+		sum += int(digits[i]-'0') * weight
+		weight = 4 - weight
+	}
+
+	return (10 - sum%10) % 10
+}
+
+func isNumeric(value string) bool {
+	if value == "" {
+		return false
+	}
+
+	for _, r := range value {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
+func genPDF417(value string) (Matrix, error) {
+	if value == "" {
+		return nil, errors.New("pdf417 value cannot be empty")
+	}
+
+	code, err := pdf417.Encode(value, pdf417SecurityLevel)
+	if err != nil {
+		return nil, err
+	}
+
+	return fromBarcode(code), nil
+}
+
+func fromBarcode(code barcode.Barcode) Matrix {
+	bounds := code.Bounds()
+
+	matrix := make(Matrix, 0, bounds.Dy())
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		row := make([]bool, 0, bounds.Dx())
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			row = append(row, isDark(code.At(x, y)))
+		}
+		matrix = append(matrix, row)
+	}
+
+	return matrix
+}
+
+func isDark(c color.Color) bool {
+	gray, ok := color.GrayModel.Convert(c).(color.Gray)
+	return ok && gray.Y < 128
+}
@@ -0,0 +1,290 @@
+package code_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/boombuler/barcode"
+	"github.com/boombuler/barcode/datamatrix"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnfercher/maroto/v2/internal/code"
+	barcodetype "github.com/johnfercher/maroto/v2/pkg/consts/barcode"
+	"github.com/johnfercher/maroto/v2/pkg/consts/matrixcode"
+)
+
+func TestGenQr(t *testing.T) {
+	t.Run("when value is empty, should return error", func(t *testing.T) {
+		// Act
+		matrix, err := code.GenQr("")
+
+		// Assert
+		assert.Nil(t, matrix)
+		assert.NotNil(t, err)
+	})
+	t.Run("when value is valid, should return a square matrix", func(t *testing.T) {
+		// Act
+		matrix, err := code.GenQr("https://github.com/johnfercher/maroto")
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, matrix.GetRows(), matrix.GetColumns())
+		assert.True(t, matrix[0][0])
+	})
+}
+
+func TestGenMatrix(t *testing.T) {
+	t.Run("when symbology is not supported, should return error", func(t *testing.T) {
+		// Act
+		matrix, err := code.GenMatrix("code", "unknown")
+
+		// Assert
+		assert.Nil(t, matrix)
+		assert.NotNil(t, err)
+	})
+	t.Run("when datamatrix value is empty, should return error", func(t *testing.T) {
+		// Act
+		matrix, err := code.GenMatrix("", matrixcode.DataMatrix)
+
+		// Assert
+		assert.Nil(t, matrix)
+		assert.NotNil(t, err)
+	})
+	t.Run("when datamatrix value is too long, should return error", func(t *testing.T) {
+		// Act
+		matrix, err := code.GenMatrix(strings.Repeat("a", 2000), matrixcode.DataMatrix)
+
+		// Assert
+		assert.Nil(t, matrix)
+		assert.NotNil(t, err)
+	})
+	t.Run("when datamatrix value is valid, should have the same modules of the reference encoder", func(t *testing.T) {
+		for _, value := range []string{"code", "https://github.com/johnfercher/maroto", strings.Repeat("0123456789", 150)} {
+			// Arrange
+			reference, _ := datamatrix.Encode(value)
+
+			// Act
+			matrix, err := code.GenMatrix(value, matrixcode.DataMatrix)
+
+			// Assert
+			assert.Nil(t, err)
+			assert.Equal(t, toMatrix(reference), matrix)
+		}
+	})
+	t.Run("when datamatrix value is valid, should have the finder pattern", func(t *testing.T) {
+		// Act
+		matrix, err := code.GenMatrix("code", matrixcode.DataMatrix)
+
+		// Assert
+		assert.Nil(t, err)
+		size := matrix.GetRows()
+		for i := 0; i < size; i++ {
+			assert.True(t, matrix[i][0])
+			assert.True(t, matrix[size-1][i])
+			assert.Equal(t, i%2 == 0, matrix[0][i])
+			assert.Equal(t, i%2 == 1, matrix[i][size-1])
+		}
+	})
+	t.Run("when gs1 value is not written as AI and data, should return error", func(t *testing.T) {
+		// Act
+		matrix, err := code.GenMatrix("0109501101530003", matrixcode.GS1DataMatrix)
+
+		// Assert
+		assert.Nil(t, matrix)
+		assert.NotNil(t, err)
+	})
+	t.Run("when gs1 AI is not numeric, should return error", func(t *testing.T) {
+		// Act
+		matrix, err := code.GenMatrix("(0a)123", matrixcode.GS1DataMatrix)
+
+		// Assert
+		assert.Nil(t, matrix)
+		assert.Equal(t, "gs1 application identifier \"0a\" is not valid", err.Error())
+	})
+	t.Run("when gs1 AI has no data, should return error", func(t *testing.T) {
+		// Act
+		matrix, err := code.GenMatrix("(01)(10)AB12", matrixcode.GS1DataMatrix)
+
+		// Assert
+		assert.Nil(t, matrix)
+		assert.Equal(t, "gs1 application identifier \"01\" has no data", err.Error())
+	})
+	t.Run("when gs1 value is valid, should differ from the plain datamatrix", func(t *testing.T) {
+		// Act
+		gs1, errGs1 := code.GenMatrix("(01)09501101530003(10)AB12", matrixcode.GS1DataMatrix)
+		plain, errPlain := code.GenMatrix("0109501101530003"+"10AB12", matrixcode.DataMatrix)
+
+		// Assert
+		assert.Nil(t, errGs1)
+		assert.Nil(t, errPlain)
+		assert.NotEqual(t, plain, gs1)
+	})
+	t.Run("when gs1 value has separators, should have the finder pattern", func(t *testing.T) {
+		// Act
+		matrix, err := code.GenMatrix("(10)ABC(21)12345(17)250101", matrixcode.GS1DataMatrix)
+
+		// Assert
+		assert.Nil(t, err)
+		size := matrix.GetRows()
+		for i := 0; i < size; i++ {
+			assert.True(t, matrix[i][0])
+			assert.True(t, matrix[size-1][i])
+			assert.Equal(t, i%2 == 0, matrix[0][i])
+			assert.Equal(t, i%2 == 1, matrix[i][size-1])
+		}
+	})
+	t.Run("when pdf417 value is empty, should return error", func(t *testing.T) {
+		// Act
+		matrix, err := code.GenMatrix("", matrixcode.PDF417)
+
+		// Assert
+		assert.Nil(t, matrix)
+		assert.NotNil(t, err)
+	})
+	t.Run("when pdf417 value is valid, should return a wider than taller matrix", func(t *testing.T) {
+		// Act
+		matrix, err := code.GenMatrix("M1DOE/JOHN            EABC123 GRULISLA 0001 123Y012A0001 100", matrixcode.PDF417)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Greater(t, matrix.GetColumns(), matrix.GetRows())
+	})
+}
+
+func toMatrix(reference barcode.Barcode) code.Matrix {
+	bounds := reference.Bounds()
+	matrix := code.Matrix{}
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		var row []bool
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			r, _, _, _ := reference.At(x, y).RGBA()
+			row = append(row, r == 0)
+		}
+		matrix = append(matrix, row)
+	}
+
+	return matrix
+}
+
+func TestGenBar(t *testing.T) {
+	t.Run("when type is not supported, should return error", func(t *testing.T) {
+		// Act
+		matrix, err := code.GenBar("123", "unknown")
+
+		// Assert
+		assert.Nil(t, matrix)
+		assert.NotNil(t, err)
+	})
+	t.Run("when code128 value is empty, should return error", func(t *testing.T) {
+		// Act
+		matrix, err := code.GenBar("", barcodetype.Code128)
+
+		// Assert
+		assert.Nil(t, matrix)
+		assert.NotNil(t, err)
+	})
+	t.Run("when code128 value is valid, should return a single row", func(t *testing.T) {
+		// Act
+		matrix, err := code.GenBar("maroto", barcodetype.Code128)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, 1, matrix.GetRows())
+		assert.True(t, matrix[0][0])
+	})
+	t.Run("when ean13 value is not numeric, should return error", func(t *testing.T) {
+		// Act
+		matrix, err := code.GenBar("78912345678a", barcodetype.EAN13)
+
+		// Assert
+		assert.Nil(t, matrix)
+		assert.NotNil(t, err)
+	})
+	t.Run("when ean13 value has wrong length, should return error", func(t *testing.T) {
+		// Act
+		matrix, err := code.GenBar("789", barcodetype.EAN13)
+
+		// Assert
+		assert.Nil(t, matrix)
+		assert.NotNil(t, err)
+	})
+	t.Run("when ean13 check digit is wrong, should return error", func(t *testing.T) {
+		// Act
+		matrix, err := code.GenBar("7891234567891", barcodetype.EAN13)
+
+		// Assert
+		assert.Nil(t, matrix)
+		assert.Equal(t, "invalid EAN-13 check digit: expected 5, got 1", err.Error())
+	})
+	t.Run("when ean13 check digit is missing, should compute it", func(t *testing.T) {
+		// Act
+		withoutCheck, errWithout := code.GenBar("789123456789", barcodetype.EAN13)
+		withCheck, errWith := code.GenBar("7891234567895", barcodetype.EAN13)
+
+		// Assert
+		assert.Nil(t, errWithout)
+		assert.Nil(t, errWith)
+		assert.Equal(t, 95, withCheck.GetColumns())
+		assert.Equal(t, withCheck, withoutCheck)
+	})
+	t.Run("when upca value is valid, should return the same bars of the equivalent ean13", func(t *testing.T) {
+		// Act
+		upca, errUpca := code.GenBar("03600029145", barcodetype.UPCA)
+		ean, errEan := code.GenBar("003600029145", barcodetype.EAN13)
+
+		// Assert
+		assert.Nil(t, errUpca)
+		assert.Nil(t, errEan)
+		assert.Equal(t, ean, upca)
+	})
+	t.Run("when upca value has wrong length, should return error", func(t *testing.T) {
+		// Act
+		matrix, err := code.GenBar("0360002914", barcodetype.UPCA)
+
+		// Assert
+		assert.Nil(t, matrix)
+		assert.NotNil(t, err)
+	})
+	t.Run("when itf value is not numeric, should return error", func(t *testing.T) {
+		// Act
+		matrix, err := code.GenBar("12ab", barcodetype.ITF)
+
+		// Assert
+		assert.Nil(t, matrix)
+		assert.NotNil(t, err)
+	})
+	t.Run("when itf value has odd length, should return error", func(t *testing.T) {
+		// Act
+		matrix, err := code.GenBar("123", barcodetype.ITF)
+
+		// Assert
+		assert.Nil(t, matrix)
+		assert.Equal(t, "ITF must have an even amount of digits, got 3", err.Error())
+	})
+	t.Run("when itf value has even length, should encode it", func(t *testing.T) {
+		// Act
+		matrix, err := code.GenBar("0123", barcodetype.ITF)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.NotEmpty(t, matrix)
+	})
+}
+
+func TestMatrix_GetColumns(t *testing.T) {
+	t.Run("when matrix is empty, should return zero", func(t *testing.T) {
+		// Arrange
+		matrix := code.Matrix{}
+
+		// Act & Assert
+		assert.Equal(t, 0, matrix.GetColumns())
+	})
+	t.Run("when matrix has rows, should return the size of the first row", func(t *testing.T) {
+		// Arrange
+		matrix := code.Matrix{{true, false, true}}
+
+		// Act & Assert
+		assert.Equal(t, 3, matrix.GetColumns())
+		assert.Equal(t, 1, matrix.GetRows())
+	})
+}
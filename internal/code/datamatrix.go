@@ -0,0 +1,125 @@
+package code
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/boombuler/barcode/datamatrix"
+)
+
+// dataMatrixFNC1 is written in the content of a GS1 Data Matrix where the symbol has FNC1,
+// it is encoded as a single codeword, like the fnc1Characters.
+const dataMatrixFNC1 = 0
+
+// fnc1Characters are encoded as the codewords 128 and 105, the XOR of the codeword of dataMatrixFNC1 (1)
+// with both of them is the codeword of FNC1 (232).
+var fnc1Characters = [2]byte{127, 'h'}
+
+// gs1FixedLengthPrefixes are the AI prefixes with a predefined length, they don't need
+// a separator after their data.
+var gs1FixedLengthPrefixes = []string{
+	"00", "01", "02", "03", "04", "11", "12", "13", "14", "15", "16", "17", "18", "19", "20",
+	"31", "32", "33", "34", "35", "36", "41",
+}
+
+// genDataMatrix encodes a value into an ECC 200 Data Matrix. When gs1 is true the value
+// is parsed as GS1 element strings and the symbol starts with FNC1.
+func genDataMatrix(value string, gs1 bool) (Matrix, error) {
+	if value == "" {
+		return nil, errors.New("datamatrix value cannot be empty")
+	}
+
+	if !gs1 {
+		return encodeDataMatrix(value)
+	}
+
+	content, err := parseGS1(value)
+	if err != nil {
+		return nil, err
+	}
+
+	return encodeGS1DataMatrix(string(rune(dataMatrixFNC1)) + content)
+}
+
+// encodeGS1DataMatrix encodes a content whose dataMatrixFNC1 characters are written as FNC1, which
+// the encoder cannot write. A symbol is linear in its codewords, the error correction codewords are
+// a linear function of the data codewords, and every other module is a bit of a codeword or of a
+// pattern which is the same for contents of the same length. So XOR of the symbols of the content
+// with each FNC1 character replaced by both fnc1Characters gives the symbol with FNC1 in its place.
+func encodeGS1DataMatrix(content string) (Matrix, error) {
+	matrix, err := encodeDataMatrix(content)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range content {
+		if content[i] != dataMatrixFNC1 {
+			continue
+		}
+
+		for _, character := range fnc1Characters {
+			replaced, err := encodeDataMatrix(content[:i] + string(rune(character)) + content[i+1:])
+			if err != nil {
+				return nil, err
+			}
+
+			for y := range matrix {
+				for x := range matrix[y] {
+					matrix[y][x] = matrix[y][x] != replaced[y][x]
+				}
+			}
+		}
+	}
+
+	return matrix, nil
+}
+
+func encodeDataMatrix(content string) (Matrix, error) {
+	code, err := datamatrix.Encode(content)
+	if err != nil {
+		return nil, fmt.Errorf("datamatrix value is too long: %w", err)
+	}
+
+	return fromBarcode(code), nil
+}
+
+// parseGS1 turns "(AI)data(AI)data" into the raw element string, using FNC1 as separator
+// after variable length fields that are not the last one.
+func parseGS1(value string) (string, error) {
+	if !strings.HasPrefix(value, "(") {
+		return "", errors.New("gs1 value must be written as (AI)data")
+	}
+
+	var builder strings.Builder
+	elements := strings.Split(value[1:], "(")
+	for i, element := range elements {
+		ai, data, found := strings.Cut(element, ")")
+		if !found || len(ai) < 2 || len(ai) > 4 || !isNumeric(ai) {
+			return "", fmt.Errorf("gs1 application identifier %q is not valid", ai)
+		}
+
+		if data == "" {
+			return "", fmt.Errorf("gs1 application identifier %q has no data", ai)
+		}
+
+		builder.WriteString(ai)
+		builder.WriteString(data)
+
+		if i < len(elements)-1 && !isGS1FixedLength(ai) {
+			builder.WriteByte(dataMatrixFNC1)
+		}
+	}
+
+	return builder.String(), nil
+}
+
+func isGS1FixedLength(ai string) bool {
+	for _, prefix := range gs1FixedLengthPrefixes {
+		if strings.HasPrefix(ai, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/johnfercher/go-tree/node"
 
 	"github.com/johnfercher/maroto/v2/pkg/consts/align"
+	"github.com/johnfercher/maroto/v2/pkg/consts/barcode"
 	"github.com/johnfercher/maroto/v2/pkg/consts/border"
 	"github.com/johnfercher/maroto/v2/pkg/consts/breakline"
 	"github.com/johnfercher/maroto/v2/pkg/consts/extension"
@@ -64,6 +65,25 @@ func RectProp() props.Rect {
 	return prop
 }
 
+// BarcodeProp is responsible to give a valid props.Barcode.
+func BarcodeProp() props.Barcode {
+	prop := props.Barcode{
+		Top:     10,
+		Left:    10,
+		Percent: 98,
+		Proportion: props.Proportion{
+			Width:  16,
+			Height: 9,
+		},
+		Center:        false,
+		Type:          barcode.EAN13,
+		QuietZone:     10,
+		HumanReadable: true,
+	}
+	prop.MakeValid()
+	return prop
+}
+
 // ConfigEntity is responsible to give a valid entity.Config.
 func ConfigEntity() entity.Config {
 	return entity.Config{
@@ -191,3 +211,195 @@ func PageProp() props.PageNumber {
 	}
 	return prop
 }
+
+// TableProp is responsible to give a valid props.Table.
+func TableProp() props.Table {
+	cellProp := CellProp()
+	colorProp := ColorProp()
+	prop := props.Table{
+		HeaderStyle:     &cellProp,
+		HeaderFontStyle: fontstyle.BoldItalic,
+		StripeColor:     &colorProp,
+		FooterStyle:     &cellProp,
+		FooterFontStyle: fontstyle.Italic,
+		RowHeight:       7,
+	}
+	prop.MakeValid()
+	return prop
+}
+
+// SpanProp is responsible to give a valid props.Span.
+func SpanProp() props.Span {
+	fontProp := FontProp()
+
+	google := "https://www.google.com"
+
+	prop := props.Span{
+		Family:        fontfamily.Courier,
+		Style:         fontstyle.Italic,
+		Size:          8,
+		Color:         fontProp.Color,
+		Hyperlink:     &google,
+		BaselineShift: 2,
+	}
+	prop.MakeValid(&fontProp)
+	return prop
+}
+
+// RichTextProp is responsible to give a valid props.RichText.
+func RichTextProp() props.RichText {
+	prop := props.RichText{
+		Top:             12,
+		Bottom:          13,
+		Left:            3,
+		Right:           4,
+		Align:           align.Center,
+		VerticalPadding: 2,
+	}
+	prop.MakeValid()
+	return prop
+}
+
+// ShapeProp is responsible to give a valid props.Shape.
+func ShapeProp() props.Shape {
+	colorProp := ColorProp()
+	prop := props.Shape{
+		FillColor:       &colorProp,
+		BorderColor:     &colorProp,
+		BorderThickness: 0.5,
+		LineStyle:       linestyle.Dashed,
+		CornerRadius:    2,
+	}
+	prop.MakeValid()
+	return prop
+}
+
+// BarChartProp is responsible to give a valid props.BarChart.
+func BarChartProp() props.BarChart {
+	colorProp := ColorProp()
+	prop := props.BarChart{
+		Palette:      []props.Color{colorProp, props.RedColor},
+		Horizontal:   true,
+		Stacked:      true,
+		ShowValues:   true,
+		ShowLegend:   true,
+		ValueFormat:  "%.2f",
+		GapPercent:   30,
+		LabelPercent: 20,
+		FontSize:     8,
+		AxisColor:    &colorProp,
+		Proportion:   props.Proportion{Width: 4, Height: 3},
+	}
+	prop.MakeValid()
+	return prop
+}
+
+// PieChartProp is responsible to give a valid props.PieChart.
+func PieChartProp() props.PieChart {
+	colorProp := ColorProp()
+	prop := props.PieChart{
+		Palette:          []props.Color{colorProp, props.RedColor},
+		HolePercent:      50,
+		ShowValues:       true,
+		ShowLegend:       true,
+		PercentFormat:    "%.0f%%",
+		SliceBorderColor: &props.WhiteColor,
+		FontSize:         8,
+		Proportion:       props.Proportion{Width: 4, Height: 3},
+	}
+	prop.MakeValid()
+	return prop
+}
+
+// LineChartProp is responsible to give a valid props.LineChart.
+func LineChartProp() props.LineChart {
+	colorProp := ColorProp()
+	prop := props.LineChart{
+		Palette:       []props.Color{colorProp, props.RedColor},
+		Area:          true,
+		AreaIntensity: 50,
+		LineThickness: 0.8,
+		MarkerSize:    2,
+		ShowGrid:      true,
+		GridColor:     &props.WhiteColor,
+		ShowLegend:    true,
+		TimeAxis:      true,
+		TimeFormat:    "15h",
+		XFormat:       "%.1f",
+		ValueFormat:   "%.2f",
+		Ticks:         5,
+		LabelPercent:  15,
+		FontSize:      8,
+		AxisColor:     &colorProp,
+		Proportion:    props.Proportion{Width: 4, Height: 3},
+	}
+	prop.MakeValid()
+	return prop
+}
+
+// BlockProp is responsible to give a valid props.Block.
+func BlockProp() props.Block {
+	prop := props.Block{
+		RowHeight: 7,
+	}
+	prop.MakeValid()
+	return prop
+}
+
+// BookmarkProp is responsible to give a valid props.Bookmark.
+func BookmarkProp() props.Bookmark {
+	prop := props.Bookmark{
+		Level: 1,
+	}
+	prop.MakeValid()
+	return prop
+}
+
+// TOCProp is responsible to give a valid props.TOC.
+func TOCProp() props.TOC {
+	fontProp := FontProp()
+
+	prop := props.TOC{
+		Family:          fontProp.Family,
+		Style:           fontProp.Style,
+		Size:            fontProp.Size,
+		Color:           fontProp.Color,
+		Indent:          4,
+		Leader:          "-",
+		VerticalPadding: 1,
+	}
+	prop.MakeValid(&fontProp)
+	return prop
+}
+
+// FootnoteProp is responsible to give a valid props.Footnote.
+func FootnoteProp() props.Footnote {
+	fontProp := FontProp()
+
+	prop := props.Footnote{
+		Numbering:       props.PerPage,
+		Family:          fontProp.Family,
+		Style:           fontProp.Style,
+		Size:            8,
+		Color:           fontProp.Color,
+		VerticalPadding: 1,
+	}
+	prop.MakeValid(&fontProp)
+	return prop
+}
+
+// IndexProp is responsible to give a valid props.Index.
+func IndexProp() props.Index {
+	fontProp := FontProp()
+
+	prop := props.Index{
+		Family:          fontProp.Family,
+		Style:           fontProp.Style,
+		Size:            fontProp.Size,
+		Color:           fontProp.Color,
+		RangeSeparator:  "-",
+		VerticalPadding: 2,
+	}
+	prop.MakeValid(&fontProp)
+	return prop
+}
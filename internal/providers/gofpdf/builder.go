@@ -18,6 +18,8 @@ type Dependencies struct {
 	Text       core.Text
 	Image      core.Image
 	Line       core.Line
+	Shape      core.Shape
+	Code       core.Code
 	Cache      cache.Cache
 	CellWriter cellwriter.CellWriter
 	Cfg        *entity.Config
@@ -65,6 +67,8 @@ func (b *builder) Build(cfg *entity.Config, cache cache.Cache) *Dependencies {
 	text := NewText(fpdf, math, font)
 	image := NewImage(fpdf, math)
 	line := NewLine(fpdf)
+	shape := NewShape(fpdf)
+	code := NewCode(fpdf, math)
 	cellWriter := cellwriter.NewBuilder().
 		Build(fpdf)
 
@@ -74,6 +78,8 @@ func (b *builder) Build(cfg *entity.Config, cache cache.Cache) *Dependencies {
 		Text:       text,
 		Image:      image,
 		Line:       line,
+		Shape:      shape,
+		Code:       code,
 		CellWriter: cellWriter,
 		Cfg:        cfg,
 		Cache:      cache,
@@ -0,0 +1,132 @@
+package gofpdf
+
+import (
+	gencode "github.com/johnfercher/maroto/v2/internal/code"
+	"github.com/johnfercher/maroto/v2/internal/providers/gofpdf/gofpdfwrapper"
+	"github.com/johnfercher/maroto/v2/pkg/consts/matrixcode"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+type code struct {
+	pdf              gofpdfwrapper.Fpdf
+	math             core.Math
+	defaultFillColor *props.Color
+}
+
+// NewCode create a Code.
+func NewCode(pdf gofpdfwrapper.Fpdf, math core.Math) *code {
+	return &code{
+		pdf:              pdf,
+		math:             math,
+		defaultFillColor: &props.WhiteColor,
+	}
+}
+
+// AddQr draws a QR code inside a cell, each dark module is drawn as a vector rectangle.
+func (c *code) AddQr(value string, cell *entity.Cell, margins *entity.Margins, prop *props.Rect) error {
+	matrix, err := gencode.GenQr(value)
+	if err != nil {
+		return err
+	}
+
+	c.addMatrix(matrix, getMatrixDimensions(matrix), 0, cell, margins, prop)
+	return nil
+}
+
+// AddBar draws a 1D barcode inside a cell, each bar is drawn as a vector rectangle.
+// It returns the cell occupied by the bars, so a caption can be placed below it.
+func (c *code) AddBar(value string, cell *entity.Cell, margins *entity.Margins, prop *props.Barcode) (*entity.Cell, error) {
+	matrix, err := gencode.GenBar(value, prop.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	dimensions := &entity.Dimensions{Width: prop.Proportion.Width, Height: prop.Proportion.Height}
+	return c.addMatrix(matrix, dimensions, prop.QuietZone, cell, margins, prop.ToRectProp()), nil
+}
+
+// AddMatrix draws a 2D code of the given symbology inside a cell.
+func (c *code) AddMatrix(value string, symbology matrixcode.Symbology, cell *entity.Cell, margins *entity.Margins,
+	prop *props.Rect,
+) error {
+	matrix, err := gencode.GenMatrix(value, symbology)
+	if err != nil {
+		return err
+	}
+
+	c.addMatrix(matrix, getMatrixDimensions(matrix), 0, cell, margins, prop)
+	return nil
+}
+
+// GetMatrixDimensions returns the dimensions of a 2D code in modules.
+func (c *code) GetMatrixDimensions(value string, symbology matrixcode.Symbology) (*entity.Dimensions, error) {
+	matrix, err := gencode.GenMatrix(value, symbology)
+	if err != nil {
+		return nil, err
+	}
+
+	return getMatrixDimensions(matrix), nil
+}
+
+// GetQrDimensions returns the dimensions of a QR code in modules.
+func (c *code) GetQrDimensions(value string) (*entity.Dimensions, error) {
+	matrix, err := gencode.GenQr(value)
+	if err != nil {
+		return nil, err
+	}
+
+	return getMatrixDimensions(matrix), nil
+}
+
+func (c *code) addMatrix(matrix gencode.Matrix, proportion *entity.Dimensions, quietZone int, cell *entity.Cell,
+	margins *entity.Margins, prop *props.Rect,
+) *entity.Cell {
+	dimensions := c.math.Resize(proportion, cell.GetDimensions(), prop.Percent, prop.JustReferenceWidth)
+
+	rectCell := &entity.Cell{X: prop.Left, Y: prop.Top, Width: dimensions.Width, Height: dimensions.Height}
+
+	if prop.Center {
+		rectCell = c.math.GetInnerCenterCell(dimensions, cell.GetDimensions())
+	}
+
+	moduleWidth := rectCell.Width / float64(matrix.GetColumns()+2*quietZone)
+	x := cell.X + rectCell.X + margins.Left + float64(quietZone)*moduleWidth
+	y := cell.Y + rectCell.Y + margins.Top
+	moduleHeight := rectCell.Height / float64(matrix.GetRows())
+
+	c.pdf.SetFillColor(props.BlackColor.Red, props.BlackColor.Green, props.BlackColor.Blue)
+
+	for i, row := range matrix {
+		start := -1
+		for j := 0; j <= len(row); j++ {
+			dark := j < len(row) && row[j]
+			if dark && start == -1 {
+				start = j
+			}
+
+			if !dark && start != -1 {
+				c.pdf.Rect(x+float64(start)*moduleWidth, y+float64(i)*moduleHeight,
+					float64(j-start)*moduleWidth, moduleHeight, "F")
+				start = -1
+			}
+		}
+	}
+
+	c.pdf.SetFillColor(c.defaultFillColor.Red, c.defaultFillColor.Green, c.defaultFillColor.Blue)
+
+	return &entity.Cell{
+		X:      cell.X + rectCell.X,
+		Y:      cell.Y + rectCell.Y,
+		Width:  rectCell.Width,
+		Height: rectCell.Height,
+	}
+}
+
+func getMatrixDimensions(matrix gencode.Matrix) *entity.Dimensions {
+	return &entity.Dimensions{
+		Width:  float64(matrix.GetColumns()),
+		Height: float64(matrix.GetRows()),
+	}
+}
@@ -0,0 +1,224 @@
+package gofpdf_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/internal/math"
+	"github.com/johnfercher/maroto/v2/internal/providers/gofpdf"
+	"github.com/johnfercher/maroto/v2/mocks"
+	"github.com/johnfercher/maroto/v2/pkg/consts/barcode"
+	"github.com/johnfercher/maroto/v2/pkg/consts/matrixcode"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+func TestNewCode(t *testing.T) {
+	code := gofpdf.NewCode(mocks.NewFpdf(t), mocks.NewMath(t))
+
+	assert.NotNil(t, code)
+	assert.Equal(t, "*gofpdf.code", fmt.Sprintf("%T", code))
+}
+
+func TestCode_AddQr(t *testing.T) {
+	t.Run("when code cannot be generated, should return error", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		margins := fixture.MarginsEntity()
+		prop := fixture.RectProp()
+
+		sut := gofpdf.NewCode(mocks.NewFpdf(t), mocks.NewMath(t))
+
+		// Act
+		err := sut.AddQr("", &cell, &margins, &prop)
+
+		// Assert
+		assert.NotNil(t, err)
+	})
+	t.Run("when code can be generated, should draw modules inside the cell", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		margins := fixture.MarginsEntity()
+		prop := fixture.RectProp()
+		var rects []float64
+
+		pdf := mocks.NewFpdf(t)
+		pdf.EXPECT().SetFillColor(0, 0, 0)
+		pdf.EXPECT().SetFillColor(255, 255, 255)
+		pdf.EXPECT().Rect(mock.Anything, mock.Anything, mock.Anything, mock.Anything, "F").Run(
+			func(x, y, w, h float64, styleStr string) {
+				rects = append(rects, x, y)
+			})
+
+		sut := gofpdf.NewCode(pdf, math.New())
+
+		// Act
+		err := sut.AddQr("code", &cell, &margins, &prop)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.NotEmpty(t, rects)
+		assert.Equal(t, 30.0, rects[0])
+		assert.Equal(t, 35.0, rects[1])
+	})
+}
+
+func TestCode_AddBar(t *testing.T) {
+	t.Run("when code cannot be generated, should return error", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		margins := fixture.MarginsEntity()
+		prop := fixture.BarcodeProp()
+
+		sut := gofpdf.NewCode(mocks.NewFpdf(t), mocks.NewMath(t))
+
+		// Act
+		barsCell, err := sut.AddBar("abc", &cell, &margins, &prop)
+
+		// Assert
+		assert.Nil(t, barsCell)
+		assert.NotNil(t, err)
+	})
+	t.Run("when symbology is unknown, should return error instead of using another symbology", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		margins := fixture.MarginsEntity()
+		prop := props.Barcode{Type: "invalid"}
+		prop.MakeValid()
+
+		sut := gofpdf.NewCode(mocks.NewFpdf(t), mocks.NewMath(t))
+
+		// Act
+		barsCell, err := sut.AddBar("123", &cell, &margins, &prop)
+
+		// Assert
+		assert.Nil(t, barsCell)
+		assert.Equal(t, `barcode type "invalid" is not supported`, err.Error())
+	})
+	t.Run("when code can be generated, should draw bars after the quiet zone", func(t *testing.T) {
+		// Arrange
+		cell := entity.Cell{X: 0, Y: 0, Width: 115, Height: 100}
+		margins := entity.Margins{}
+		prop := props.Barcode{
+			Percent: 100, Proportion: props.Proportion{Width: 115, Height: 20}, Type: barcode.EAN13, QuietZone: 10,
+		}
+		prop.MakeValid()
+		var rects []float64
+
+		pdf := mocks.NewFpdf(t)
+		pdf.EXPECT().SetFillColor(0, 0, 0)
+		pdf.EXPECT().SetFillColor(255, 255, 255)
+		pdf.EXPECT().Rect(mock.Anything, mock.Anything, mock.Anything, mock.Anything, "F").Run(
+			func(x, y, w, h float64, styleStr string) {
+				rects = append(rects, x, h)
+			})
+
+		sut := gofpdf.NewCode(pdf, math.New())
+
+		// Act
+		barsCell, err := sut.AddBar("7891234567895", &cell, &margins, &prop)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, &entity.Cell{X: 0, Y: 0, Width: 115, Height: 20}, barsCell)
+		assert.Equal(t, 10.0, rects[0])
+		assert.Equal(t, 20.0, rects[1])
+	})
+}
+
+func TestCode_AddMatrix(t *testing.T) {
+	t.Run("when code cannot be generated, should return error", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		margins := fixture.MarginsEntity()
+		prop := fixture.RectProp()
+
+		sut := gofpdf.NewCode(mocks.NewFpdf(t), mocks.NewMath(t))
+
+		// Act
+		err := sut.AddMatrix("", matrixcode.DataMatrix, &cell, &margins, &prop)
+
+		// Assert
+		assert.NotNil(t, err)
+	})
+	t.Run("when code can be generated, should draw modules inside the cell", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		margins := fixture.MarginsEntity()
+		prop := fixture.RectProp()
+		var rects []float64
+
+		pdf := mocks.NewFpdf(t)
+		pdf.EXPECT().SetFillColor(0, 0, 0)
+		pdf.EXPECT().SetFillColor(255, 255, 255)
+		pdf.EXPECT().Rect(mock.Anything, mock.Anything, mock.Anything, mock.Anything, "F").Run(
+			func(x, y, w, h float64, styleStr string) {
+				rects = append(rects, x, y)
+			})
+
+		sut := gofpdf.NewCode(pdf, math.New())
+
+		// Act
+		err := sut.AddMatrix("code", matrixcode.DataMatrix, &cell, &margins, &prop)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.NotEmpty(t, rects)
+		assert.Equal(t, 30.0, rects[0])
+		assert.Equal(t, 35.0, rects[1])
+	})
+}
+
+func TestCode_GetMatrixDimensions(t *testing.T) {
+	t.Run("when code cannot be generated, should return error", func(t *testing.T) {
+		// Arrange
+		sut := gofpdf.NewCode(mocks.NewFpdf(t), mocks.NewMath(t))
+
+		// Act
+		dimensions, err := sut.GetMatrixDimensions("", matrixcode.PDF417)
+
+		// Assert
+		assert.Nil(t, dimensions)
+		assert.NotNil(t, err)
+	})
+	t.Run("when code can be generated, should return dimensions in modules", func(t *testing.T) {
+		// Arrange
+		sut := gofpdf.NewCode(mocks.NewFpdf(t), mocks.NewMath(t))
+
+		// Act
+		dimensions, err := sut.GetMatrixDimensions("code", matrixcode.DataMatrix)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, &entity.Dimensions{Width: 12, Height: 12}, dimensions)
+	})
+}
+
+func TestCode_GetQrDimensions(t *testing.T) {
+	t.Run("when code cannot be generated, should return error", func(t *testing.T) {
+		// Arrange
+		sut := gofpdf.NewCode(mocks.NewFpdf(t), mocks.NewMath(t))
+
+		// Act
+		dimensions, err := sut.GetQrDimensions("")
+
+		// Assert
+		assert.Nil(t, dimensions)
+		assert.NotNil(t, err)
+	})
+	t.Run("when code can be generated, should return square dimensions", func(t *testing.T) {
+		// Arrange
+		sut := gofpdf.NewCode(mocks.NewFpdf(t), mocks.NewMath(t))
+
+		// Act
+		dimensions, err := sut.GetQrDimensions("code")
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, dimensions.Width, dimensions.Height)
+	})
+}
@@ -1,7 +1,11 @@
 package gofpdf
 
 import (
+	"unicode/utf16"
+	"unicode/utf8"
+
 	"github.com/johnfercher/maroto/v2/internal/providers/gofpdf/gofpdfwrapper"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontfamily"
 	"github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
 	"github.com/johnfercher/maroto/v2/pkg/props"
 )
@@ -101,3 +105,34 @@ func (s *font) SetColor(color *props.Color) {
 func (s *font) GetColor() *props.Color {
 	return s.fontColor
 }
+
+// isDefaultFamily reports whether the family is one of the default fonts, which are not utf8.
+func isDefaultFamily(family string) bool {
+	return family == fontfamily.Arial ||
+		family == fontfamily.Helvetica ||
+		family == fontfamily.Symbol ||
+		family == fontfamily.ZapBats ||
+		family == fontfamily.Courier
+}
+
+// toUTF16 encodes an utf8 text as an utf16 PDF text string, plain ascii texts are kept.
+func toUTF16(text string) string {
+	ascii := true
+	for i := 0; i < len(text); i++ {
+		if text[i] >= utf8.RuneSelf {
+			ascii = false
+			break
+		}
+	}
+
+	if ascii {
+		return text
+	}
+
+	encoded := []byte{0xFE, 0xFF}
+	for _, unit := range utf16.Encode([]rune(text)) {
+		encoded = append(encoded, byte(unit>>8), byte(unit))
+	}
+
+	return string(encoded)
+}
@@ -99,6 +99,7 @@ type Fpdf interface {
 	RawWriteBuf(r io.Reader)
 	RawWriteStr(str string)
 	Rect(x, y, w, h float64, styleStr string)
+	RoundedRect(x, y, w, h, r float64, corners string, stylestr string)
 	RegisterAlias(alias, replacement string)
 	RegisterImage(fileStr, tp string) (info *gofpdf.ImageInfoType)
 	RegisterImageOptions(fileStr string, options gofpdf.ImageOptions) (info *gofpdf.ImageInfoType)
@@ -46,6 +46,10 @@ func (s image) GetImageInfo(img *entity.Image, extension extension.Type) (*gofpd
 func (s *image) Add(img *entity.Image, cell *entity.Cell, margins *entity.Margins,
 	prop *props.Rect, extension extension.Type, flow bool,
 ) error {
+	if isSvg(extension) {
+		return s.addSvg(img, cell, margins, prop)
+	}
+
 	imageID, _ := uuid.NewRandom()
 
 	info := s.pdf.RegisterImageOptionsReader(
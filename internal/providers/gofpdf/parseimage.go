@@ -1,19 +1,128 @@
 package gofpdf
 
 import (
+	"bytes"
+	"encoding/binary"
 	"errors"
+	"fmt"
+	goimage "image"
+	"image/draw"
+	"image/gif"
+	"image/png"
+
+	"golang.org/x/image/bmp"
+	"golang.org/x/image/tiff"
+	"golang.org/x/image/webp"
 
 	"github.com/johnfercher/maroto/v2/pkg/consts/extension"
 	"github.com/johnfercher/maroto/v2/pkg/core/entity"
 )
 
-func FromBytes(bytes []byte, ext extension.Type) (*entity.Image, error) {
+// FromBytes creates an image from bytes, extensions which the PDF does not support are converted to png,
+// frame is the page used from a multi-page image.
+func FromBytes(bytes []byte, ext extension.Type, frame int) (*entity.Image, error) {
 	if !ext.IsValid() {
 		return nil, errors.New("invalid image format")
 	}
 
+	if ext.IsConverted() {
+		converted, err := convertToPng(bytes, ext, frame)
+		if err != nil {
+			return nil, err
+		}
+
+		return &entity.Image{
+			Bytes:     converted,
+			Extension: extension.Png,
+		}, nil
+	}
+
 	return &entity.Image{
 		Bytes:     bytes,
 		Extension: ext,
 	}, nil
 }
+
+// convertToPng decodes an image and encodes it as an 8 bits png, which keeps the alpha channel.
+func convertToPng(data []byte, ext extension.Type, frame int) ([]byte, error) {
+	decoded, err := decode(data, ext, frame)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode %s image: %w", ext, err)
+	}
+
+	// the PDF does not support 16 bits png, so every image is converted to 8 bits
+	nrgba := goimage.NewNRGBA(decoded.Bounds())
+	draw.Draw(nrgba, nrgba.Bounds(), decoded, decoded.Bounds().Min, draw.Src)
+
+	var buffer bytes.Buffer
+	if err := png.Encode(&buffer, nrgba); err != nil {
+		return nil, err
+	}
+
+	return buffer.Bytes(), nil
+}
+
+func decode(data []byte, ext extension.Type, frame int) (goimage.Image, error) {
+	switch ext {
+	case extension.Gif:
+		return gif.Decode(bytes.NewReader(data))
+	case extension.Bmp:
+		return bmp.Decode(bytes.NewReader(data))
+	case extension.Webp:
+		return webp.Decode(bytes.NewReader(data))
+	case extension.Tiff, extension.Tif:
+		page, err := getTiffPage(data, frame)
+		if err != nil {
+			return nil, err
+		}
+		return tiff.Decode(bytes.NewReader(page))
+	default:
+		return nil, errors.New("unsupported image format")
+	}
+}
+
+// getTiffPage returns a copy of a tiff where the first page is the frame,
+// since the decoder only reads the first page.
+func getTiffPage(data []byte, frame int) ([]byte, error) {
+	if frame == 0 {
+		return data, nil
+	}
+
+	if len(data) < 8 {
+		return nil, errors.New("invalid tiff header")
+	}
+
+	var order binary.ByteOrder
+	switch string(data[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return nil, errors.New("invalid tiff byte order")
+	}
+
+	// each page directory has 2 bytes of count, 12 bytes for each entry and 4 bytes with the offset of the next one
+	offset := order.Uint32(data[4:8])
+	for i := 0; i < frame; i++ {
+		if offset == 0 || int(offset)+2 > len(data) {
+			return nil, fmt.Errorf("tiff has no frame %d", frame)
+		}
+
+		next := int(offset) + 2 + int(order.Uint16(data[offset:]))*12
+		if next+4 > len(data) {
+			return nil, errors.New("invalid tiff directory")
+		}
+		offset = order.Uint32(data[next:])
+	}
+
+	if offset == 0 {
+		return nil, fmt.Errorf("tiff has no frame %d", frame)
+	}
+
+	page := make([]byte, len(data))
+	copy(page, data)
+	order.PutUint32(page[4:8], offset)
+
+	return page, nil
+}
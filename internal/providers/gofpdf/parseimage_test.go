@@ -1,8 +1,18 @@
 package gofpdf_test
 
 import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/png"
 	"testing"
 
+	"golang.org/x/image/bmp"
+	"golang.org/x/image/tiff"
+
 	"github.com/johnfercher/maroto/v2/internal/providers/gofpdf"
 
 	"github.com/johnfercher/maroto/v2/pkg/consts/extension"
@@ -12,7 +22,7 @@ import (
 func TestFromBytes(t *testing.T) {
 	t.Run("when extension is not valid, should return error", func(t *testing.T) {
 		// Act
-		img, err := gofpdf.FromBytes([]byte{1, 2, 3}, "invalid")
+		img, err := gofpdf.FromBytes([]byte{1, 2, 3}, "invalid", 0)
 
 		// Assert
 		assert.Nil(t, img)
@@ -20,10 +30,138 @@ func TestFromBytes(t *testing.T) {
 	})
 	t.Run("when extension is not valid, should return error", func(t *testing.T) {
 		// Act
-		img, err := gofpdf.FromBytes([]byte{1, 2, 3}, extension.Jpg)
+		img, err := gofpdf.FromBytes([]byte{1, 2, 3}, extension.Jpg, 0)
 
 		// Assert
 		assert.NotNil(t, img)
 		assert.Nil(t, err)
 	})
 }
+
+func TestFromBytes_Converted(t *testing.T) {
+	t.Run("when image is gif, should convert to png keeping alpha", func(t *testing.T) {
+		// Arrange
+		palette := color.Palette{color.Transparent, color.RGBA{R: 255, A: 255}}
+		paletted := image.NewPaletted(image.Rect(0, 0, 2, 1), palette)
+		paletted.SetColorIndex(1, 0, 1)
+		var buffer bytes.Buffer
+		_ = gif.Encode(&buffer, paletted, nil)
+
+		// Act
+		img, err := gofpdf.FromBytes(buffer.Bytes(), extension.Gif, 0)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, extension.Png, img.Extension)
+		decoded, _ := png.Decode(bytes.NewReader(img.Bytes))
+		assert.Equal(t, color.NRGBA{}, decoded.At(0, 0))
+		assert.Equal(t, color.NRGBA{R: 255, A: 255}, decoded.At(1, 0))
+	})
+	t.Run("when image is bmp, should convert to png", func(t *testing.T) {
+		// Arrange
+		rgba := image.NewRGBA(image.Rect(0, 0, 3, 2))
+		var buffer bytes.Buffer
+		_ = bmp.Encode(&buffer, rgba)
+
+		// Act
+		img, err := gofpdf.FromBytes(buffer.Bytes(), extension.Bmp, 0)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, extension.Png, img.Extension)
+		config, _ := png.DecodeConfig(bytes.NewReader(img.Bytes))
+		assert.Equal(t, 3, config.Width)
+		assert.Equal(t, 2, config.Height)
+	})
+	t.Run("when image is webp, should convert to png", func(t *testing.T) {
+		// Arrange
+		data, _ := base64.StdEncoding.DecodeString("UklGRhoAAABXRUJQVlA4TA0AAAAvAAAAEAcQERGIiP4HAA==")
+
+		// Act
+		img, err := gofpdf.FromBytes(data, extension.Webp, 0)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, extension.Png, img.Extension)
+	})
+	t.Run("when image is 16 bits tiff, should convert to 8 bits png", func(t *testing.T) {
+		// Arrange
+		gray := image.NewGray16(image.Rect(0, 0, 1, 1))
+		gray.SetGray16(0, 0, color.Gray16{Y: 0xffff})
+		var buffer bytes.Buffer
+		_ = tiff.Encode(&buffer, gray, nil)
+
+		// Act
+		img, err := gofpdf.FromBytes(buffer.Bytes(), extension.Tiff, 0)
+
+		// Assert
+		assert.Nil(t, err)
+		decoded, _ := png.Decode(bytes.NewReader(img.Bytes))
+		assert.Equal(t, color.RGBA{R: 255, G: 255, B: 255, A: 255}, decoded.At(0, 0))
+	})
+	t.Run("when tiff has many pages, should convert the frame", func(t *testing.T) {
+		// Arrange
+		data := newGrayTiff(10, 200)
+
+		// Act
+		first, firstErr := gofpdf.FromBytes(data, extension.Tif, 0)
+		second, secondErr := gofpdf.FromBytes(data, extension.Tif, 1)
+		_, missingErr := gofpdf.FromBytes(data, extension.Tif, 2)
+
+		// Assert
+		assert.Nil(t, firstErr)
+		assert.Nil(t, secondErr)
+		assert.NotNil(t, missingErr)
+		firstDecoded, _ := png.Decode(bytes.NewReader(first.Bytes))
+		secondDecoded, _ := png.Decode(bytes.NewReader(second.Bytes))
+		assert.Equal(t, color.RGBA{R: 10, G: 10, B: 10, A: 255}, firstDecoded.At(0, 0))
+		assert.Equal(t, color.RGBA{R: 200, G: 200, B: 200, A: 255}, secondDecoded.At(0, 0))
+	})
+	t.Run("when image cannot be decoded, should return error", func(t *testing.T) {
+		// Act
+		img, err := gofpdf.FromBytes([]byte{1, 2, 3}, extension.Webp, 0)
+
+		// Assert
+		assert.Nil(t, img)
+		assert.NotNil(t, err)
+	})
+}
+
+// newGrayTiff creates a little endian tiff with a page of one gray pixel for each value.
+func newGrayTiff(values ...byte) []byte {
+	const entries = 8
+	directorySize := 2 + entries*12 + 4
+
+	data := []byte{'I', 'I', 42, 0}
+	data = binary.LittleEndian.AppendUint32(data, 8)
+
+	for i, value := range values {
+		start := 8 + i*(directorySize+1)
+		pixel := start + directorySize
+		next := 0
+		if i < len(values)-1 {
+			next = pixel + 1
+		}
+
+		data = binary.LittleEndian.AppendUint16(data, entries)
+		for _, entry := range [][3]uint32{
+			{256, 3, 1},             // width
+			{257, 3, 1},             // height
+			{258, 3, 8},             // bits per sample
+			{259, 3, 1},             // no compression
+			{262, 3, 1},             // black is zero
+			{273, 4, uint32(pixel)}, // strip offset
+			{278, 3, 1},             // rows per strip
+			{279, 4, 1},             // strip byte count
+		} {
+			data = binary.LittleEndian.AppendUint16(data, uint16(entry[0]))
+			data = binary.LittleEndian.AppendUint16(data, uint16(entry[1]))
+			data = binary.LittleEndian.AppendUint32(data, 1)
+			data = binary.LittleEndian.AppendUint32(data, entry[2])
+		}
+		data = binary.LittleEndian.AppendUint32(data, uint32(next))
+		data = append(data, value)
+	}
+
+	return data
+}
@@ -2,7 +2,9 @@ package gofpdf
 
 import (
 	"bytes"
+	"crypto/sha256"
 	"errors"
+	"fmt"
 	"path/filepath"
 	"strings"
 
@@ -10,21 +12,46 @@ import (
 	"github.com/johnfercher/maroto/v2/internal/merror"
 	"github.com/johnfercher/maroto/v2/internal/providers/gofpdf/cellwriter"
 	"github.com/johnfercher/maroto/v2/internal/providers/gofpdf/gofpdfwrapper"
+	"github.com/johnfercher/maroto/v2/pkg/consts/align"
 	"github.com/johnfercher/maroto/v2/pkg/consts/extension"
+	"github.com/johnfercher/maroto/v2/pkg/consts/matrixcode"
 	"github.com/johnfercher/maroto/v2/pkg/core"
 	"github.com/johnfercher/maroto/v2/pkg/core/entity"
 	"github.com/johnfercher/maroto/v2/pkg/props"
 )
 
+// pageEndTolerance is the distance to the bottom of the page where the page is full, since the
+// height of the last row of a page is truncated.
+const pageEndTolerance = 1e-6
+
 type provider struct {
 	fpdf       gofpdfwrapper.Fpdf
 	font       core.Font
 	text       core.Text
 	image      core.Image
 	line       core.Line
+	shape      core.Shape
+	code       core.Code
 	cache      cache.Cache
 	cellWriter cellwriter.CellWriter
 	cfg        *entity.Config
+	// cursors are the positions saved by OpenGrid, restored by CloseGrid.
+	cursors []entity.Point
+	// bookmarkLevel is the level of the last bookmark, -1 when there is none.
+	bookmarkLevel int
+	// links are the gofpdf links of the anchors, anchorPages the pages where the anchors were added.
+	links       map[string]int
+	anchorPages map[string]int
+	// converted are the images converted to png, so each image is decoded once even if it is measured many times.
+	converted map[convertedKey]*entity.Image
+}
+
+// convertedKey identifies an image by the hash of its bytes, with the frame converted from them,
+// so a slice of bytes reused for another image is converted again.
+type convertedKey struct {
+	sum       [sha256.Size]byte
+	extension extension.Type
+	frame     int
 }
 
 // New is the constructor of provider for gofpdf
@@ -35,9 +62,16 @@ func New(dep *Dependencies) core.Provider {
 		text:       dep.Text,
 		image:      dep.Image,
 		line:       dep.Line,
+		shape:      dep.Shape,
+		code:       dep.Code,
 		cellWriter: dep.CellWriter,
 		cfg:        dep.Cfg,
 		cache:      dep.Cache,
+		// the first bookmark must be in the top level of the outline
+		bookmarkLevel: -1,
+		links:         make(map[string]int),
+		anchorPages:   make(map[string]int),
+		converted:     make(map[convertedKey]*entity.Image),
 	}
 }
 
@@ -49,14 +83,42 @@ func (g *provider) GetLinesQuantity(text string, textProp *props.Text, colWidth
 	return g.text.GetLinesQuantity(text, textProp, colWidth)
 }
 
+func (g *provider) AddRichText(spans []entity.Span, cell *entity.Cell, prop *props.RichText) {
+	g.text.AddRich(spans, cell, prop)
+}
+
+func (g *provider) GetRichTextHeight(spans []entity.Span, prop *props.RichText, colWidth float64) float64 {
+	return g.text.GetRichHeight(spans, prop, colWidth)
+}
+
 func (g *provider) GetFontHeight(prop *props.Font) float64 {
 	return g.font.GetHeight(prop.Family, prop.Style, prop.Size)
 }
 
+func (g *provider) GetTextWidth(text string, prop *props.Font) float64 {
+	return g.text.GetWidth(text, prop)
+}
+
 func (g *provider) AddLine(cell *entity.Cell, prop *props.Line) {
 	g.line.Add(cell, prop)
 }
 
+func (g *provider) AddPolygon(points []entity.Point, prop *props.Shape) {
+	g.shape.AddPolygon(points, prop)
+}
+
+func (g *provider) AddPolyline(points []entity.Point, prop *props.Shape) {
+	g.shape.AddPolyline(points, prop)
+}
+
+func (g *provider) AddRectangle(cell *entity.Cell, prop *props.Shape) {
+	g.shape.AddRectangle(cell, prop)
+}
+
+func (g *provider) AddEllipse(cell *entity.Cell, prop *props.Shape) {
+	g.shape.AddEllipse(cell, prop)
+}
+
 func (g *provider) AddImageFromFile(file string, cell *entity.Cell, prop *props.Rect) {
 	extensionStr := strings.ToLower(strings.TrimPrefix(filepath.Ext(file), "."))
 	image, err := g.loadImage(file, extensionStr)
@@ -69,13 +131,13 @@ func (g *provider) AddImageFromFile(file string, cell *entity.Cell, prop *props.
 }
 
 func (g *provider) AddImageFromBytes(bytes []byte, cell *entity.Cell, prop *props.Rect, extension extension.Type) {
-	img, err := FromBytes(bytes, extension)
+	img, err := g.fromBytes(bytes, extension, prop.Frame)
 	if err != nil {
 		g.text.Add("could not parse image bytes", cell, merror.DefaultErrorText)
 		return
 	}
 
-	err = g.image.Add(img, cell, g.cfg.Margins, prop, extension, false)
+	err = g.image.Add(img, cell, g.cfg.Margins, prop, img.Extension, false)
 	if err != nil {
 		g.fpdf.ClearError()
 		g.text.Add("could not add image to document", cell, merror.DefaultErrorText)
@@ -83,13 +145,13 @@ func (g *provider) AddImageFromBytes(bytes []byte, cell *entity.Cell, prop *prop
 }
 
 func (g *provider) AddBackgroundImageFromBytes(bytes []byte, cell *entity.Cell, prop *props.Rect, extension extension.Type) {
-	img, err := FromBytes(bytes, extension)
+	img, err := g.fromBytes(bytes, extension, prop.Frame)
 	if err != nil {
 		g.text.Add("could not parse image bytes", cell, merror.DefaultErrorText)
 		return
 	}
 
-	err = g.image.Add(img, cell, g.cfg.Margins, prop, extension, true)
+	err = g.image.Add(img, cell, g.cfg.Margins, prop, img.Extension, true)
 	if err != nil {
 		g.fpdf.ClearError()
 		g.text.Add("could not add image to document", cell, merror.DefaultErrorText)
@@ -97,10 +159,205 @@ func (g *provider) AddBackgroundImageFromBytes(bytes []byte, cell *entity.Cell,
 	g.fpdf.SetHomeXY()
 }
 
+func (g *provider) AddQrCode(code string, cell *entity.Cell, prop *props.Rect) {
+	err := g.code.AddQr(code, cell, g.cfg.Margins, prop)
+	if err != nil {
+		g.text.Add("could not generate qrcode", cell, merror.DefaultErrorText)
+	}
+}
+
+func (g *provider) AddMatrixCode(code string, symbology matrixcode.Symbology, cell *entity.Cell, prop *props.Rect) {
+	err := g.code.AddMatrix(code, symbology, cell, g.cfg.Margins, prop)
+	if err != nil {
+		g.text.Add(fmt.Sprintf("could not generate %s: %s", symbology, err.Error()), cell, merror.DefaultErrorText)
+	}
+}
+
+func (g *provider) GetDimensionsByMatrixCode(code string, symbology matrixcode.Symbology) (*entity.Dimensions, error) {
+	return g.code.GetMatrixDimensions(code, symbology)
+}
+
+func (g *provider) AddBarCode(code string, cell *entity.Cell, prop *props.Barcode) {
+	caption := prop.GetCaption(code)
+	captionProp := g.cfg.DefaultFont.ToTextProp(align.Center, 0, 0)
+	captionHeight := 0.0
+	if caption != "" {
+		captionHeight = g.font.GetHeight(captionProp.Family, captionProp.Style, captionProp.Size)
+	}
+
+	// The bars must leave room for the caption inside the cell.
+	barsCell := cell.Copy()
+	barsCell.Height -= captionHeight
+
+	rectCell, err := g.code.AddBar(code, &barsCell, g.cfg.Margins, prop)
+	if err != nil {
+		g.text.Add(fmt.Sprintf("could not generate barcode: %s", err.Error()), cell, merror.DefaultErrorText)
+		return
+	}
+
+	if caption == "" {
+		return
+	}
+
+	captionCell := &entity.Cell{
+		X:      rectCell.X,
+		Y:      rectCell.Y + rectCell.Height,
+		Width:  rectCell.Width,
+		Height: captionHeight,
+	}
+
+	g.text.Add(caption, captionCell, captionProp)
+}
+
 func (g *provider) CreateRow(height float64) {
 	g.fpdf.Ln(height)
 }
 
+// OpenGrid moves the cursor to the cell, so the rows of a nested grid are created inside it.
+func (g *provider) OpenGrid(cell *entity.Cell) {
+	x, y := g.fpdf.GetXY()
+	g.cursors = append(g.cursors, entity.Point{X: x, Y: y})
+
+	left, top, _, _ := g.fpdf.GetMargins()
+	g.fpdf.SetXY(left+cell.X, top+cell.Y)
+}
+
+// CloseGrid moves the cursor back to where it was before the last OpenGrid.
+func (g *provider) CloseGrid() {
+	if len(g.cursors) == 0 {
+		return
+	}
+
+	cursor := g.cursors[len(g.cursors)-1]
+	g.cursors = g.cursors[:len(g.cursors)-1]
+	g.fpdf.SetXY(cursor.X, cursor.Y)
+}
+
+// AddBookmark adds an entry to the document outline pointing to the top of the cell in the current page,
+// a level deeper than the one after the last bookmark is reduced, since it would not have a parent.
+func (g *provider) AddBookmark(title string, level int, cell *entity.Cell) {
+	level = min(max(level, 0), g.bookmarkLevel+1)
+	g.bookmarkLevel = level
+
+	// gofpdf only encodes the title when the current font is utf8
+	if isDefaultFamily(g.font.GetFamily()) {
+		title = toUTF16(title)
+	}
+
+	_, top, _, _ := g.fpdf.GetMargins()
+	g.fpdf.Bookmark(title, level, top+cell.Y)
+}
+
+// AddAnchor defines a named destination at the top of the cell in the current page.
+func (g *provider) AddAnchor(name string, cell *entity.Cell) {
+	_, top, _, _ := g.fpdf.GetMargins()
+	g.fpdf.SetLink(g.getLink(name), top+cell.Y, -1)
+	g.anchorPages[name] = g.fpdf.PageNo()
+}
+
+// AddInternalLink adds a clickable area over the cell which goes to a named destination,
+// the anchor may be added after the link.
+func (g *provider) AddInternalLink(name string, cell *entity.Cell) {
+	left, top, _, _ := g.fpdf.GetMargins()
+	g.fpdf.Link(left+cell.X, top+cell.Y, cell.Width, cell.Height, g.getLink(name))
+}
+
+// GetAnchorPage returns the page of an anchor already added.
+func (g *provider) GetAnchorPage(name string) (int, bool) {
+	page, ok := g.anchorPages[name]
+	return page, ok
+}
+
+// AddFootnote does nothing, the footnotes are written as rows at the bottom of the pages.
+func (g *provider) AddFootnote(*entity.Footnote) {}
+
+// AddHeading does nothing, the headings are collected while the document is located.
+func (g *provider) AddHeading(*entity.Heading) {}
+
+// GetHeadings returns no heading, the headings are known by the provider which locates the document.
+func (g *provider) GetHeadings() []*entity.Heading {
+	return nil
+}
+
+// AddWatermark draws a text or an image rotated around its center with the opacity of the watermark,
+// placed in the cell of the page.
+func (g *provider) AddWatermark(watermark *entity.Watermark, cell *entity.Cell) {
+	prop := watermark.Prop
+	textProp := prop.ToTextProp()
+
+	// the background watermark is drawn before the rows of the page, when the page has not started yet
+	if !prop.Foreground {
+		g.startPage()
+	}
+
+	var width, height float64
+	if watermark.Image != nil {
+		dimensions, err := g.GetDimensionsByImageByte(watermark.Image.Bytes, watermark.Image.Extension, 0)
+		if err != nil {
+			g.text.Add("could not parse image bytes", cell, merror.DefaultErrorText)
+			return
+		}
+
+		width = cell.Width * prop.Percent / 100
+		height = width * dimensions.Height / dimensions.Width
+	} else {
+		width = g.text.GetWidth(watermark.Text, &props.Font{Family: textProp.Family, Style: textProp.Style, Size: textProp.Size})
+		height = g.font.GetHeight(textProp.Family, textProp.Style, textProp.Size)
+	}
+
+	x, y := getPlacedCenter(cell, prop.Place, width, height)
+	area := &entity.Cell{X: x - width/2, Y: y - height/2, Width: width, Height: height}
+	left, top, _, _ := g.fpdf.GetMargins()
+
+	g.fpdf.TransformBegin()
+	g.fpdf.SetAlpha(prop.Opacity, "Normal")
+	g.fpdf.TransformRotate(prop.Angle, left+x, top+y)
+
+	if watermark.Image != nil {
+		g.AddImageFromBytes(watermark.Image.Bytes, area, &props.Rect{Percent: 100, Center: true}, watermark.Image.Extension)
+	} else {
+		// the text may be a little wider than the measured width when its parts are rounded
+		area.X--
+		area.Width += 2
+		g.text.Add(watermark.Text, area, textProp)
+	}
+
+	g.fpdf.SetAlpha(1, "Normal")
+	g.fpdf.TransformEnd()
+}
+
+// getPlacedCenter returns the center of an area with the width and height placed in the cell.
+func getPlacedCenter(cell *entity.Cell, place props.Place, width, height float64) (float64, float64) {
+	x := cell.X + cell.Width/2
+	y := cell.Y + cell.Height/2
+
+	switch place {
+	case props.LeftTop, props.LeftBottom:
+		x = cell.X + width/2
+	case props.RightTop, props.RightBottom:
+		x = cell.X + cell.Width - width/2
+	}
+
+	switch place {
+	case props.LeftTop, props.Top, props.RightTop:
+		y = cell.Y + height/2
+	case props.LeftBottom, props.Bottom, props.RightBottom:
+		y = cell.Y + cell.Height - height/2
+	}
+
+	return x, y
+}
+
+func (g *provider) getLink(name string) int {
+	link, ok := g.links[name]
+	if !ok {
+		link = g.fpdf.AddLink()
+		g.links[name] = link
+	}
+
+	return link
+}
+
 func (g *provider) SetProtection(protection *entity.Protection) {
 	if protection == nil {
 		return
@@ -139,15 +396,23 @@ func (g *provider) SetMetadata(metadata *entity.Metadata) {
 	}
 }
 
-// GetDimensionsByImage is responsible for obtaining the dimensions of an image
+// GetDimensionsByImage is responsible for obtaining the dimensions of the frame of an image
 // If the image cannot be loaded, an error is returned
-func (g *provider) GetDimensionsByImage(file string) (*entity.Dimensions, error) {
+func (g *provider) GetDimensionsByImage(file string, frame int) (*entity.Dimensions, error) {
 	extensionStr := strings.ToLower(strings.TrimPrefix(filepath.Ext(file), "."))
 	img, err := g.loadImage(file, extensionStr)
 	if err != nil {
 		return nil, err
 	}
 
+	if extension.Type(extensionStr).IsConverted() {
+		return g.GetDimensionsByImageByte(img.Bytes, extension.Type(extensionStr), frame)
+	}
+
+	if isSvg(extension.Type(extensionStr)) {
+		return getSvgDimensions(img.Bytes)
+	}
+
 	imgInfo, _ := g.image.GetImageInfo(img, extension.Type(extensionStr))
 
 	if imgInfo == nil {
@@ -156,21 +421,31 @@ func (g *provider) GetDimensionsByImage(file string) (*entity.Dimensions, error)
 	return &entity.Dimensions{Width: imgInfo.Width(), Height: imgInfo.Height()}, nil
 }
 
-// GetDimensionsByImageByte is responsible for obtaining the dimensions of an image
+// GetDimensionsByImageByte is responsible for obtaining the dimensions of the frame of an image
 // If the image cannot be loaded, an error is returned
-func (g *provider) GetDimensionsByImageByte(bytes []byte, extension extension.Type) (*entity.Dimensions, error) {
-	img, err := FromBytes(bytes, extension)
+func (g *provider) GetDimensionsByImageByte(bytes []byte, extension extension.Type, frame int) (*entity.Dimensions, error) {
+	img, err := g.fromBytes(bytes, extension, frame)
 	if err != nil {
 		return nil, err
 	}
 
-	imgInfo, _ := g.image.GetImageInfo(img, extension)
+	if isSvg(img.Extension) {
+		return getSvgDimensions(img.Bytes)
+	}
+
+	imgInfo, _ := g.image.GetImageInfo(img, img.Extension)
 	if imgInfo == nil {
 		return nil, errors.New("could not read image options, maybe path/name is wrong")
 	}
 	return &entity.Dimensions{Width: imgInfo.Width(), Height: imgInfo.Height()}, nil
 }
 
+// GetDimensionsByQrCode is responsible for obtaining the dimensions of a QR code
+// If the code cannot be generated, an error is returned
+func (g *provider) GetDimensionsByQrCode(code string) (*entity.Dimensions, error) {
+	return g.code.GetQrDimensions(code)
+}
+
 func (g *provider) GenerateBytes() ([]byte, error) {
 	var buffer bytes.Buffer
 	err := g.fpdf.Output(&buffer)
@@ -186,6 +461,38 @@ func (g *provider) SetCompression(compression bool) {
 	g.fpdf.SetCompression(compression)
 }
 
+// startPage adds a page when the previous page is full, since gofpdf only adds the next page
+// when a cell which does not fit in the previous page is written.
+func (g *provider) startPage() {
+	auto, margin := g.fpdf.GetAutoPageBreak()
+	_, height := g.fpdf.GetPageSize()
+	if auto && g.fpdf.GetY() >= height-margin-pageEndTolerance {
+		g.fpdf.AddPage()
+	}
+}
+
+// fromBytes creates an image from bytes like FromBytes, the images which must be converted are converted once
+// for each content and frame, since the same image is measured and drawn many times. Only the converted png
+// is kept, which is as big as the image registered by gofpdf when it is drawn.
+func (g *provider) fromBytes(bytes []byte, ext extension.Type, frame int) (*entity.Image, error) {
+	if len(bytes) == 0 || !ext.IsConverted() {
+		return FromBytes(bytes, ext, frame)
+	}
+
+	key := convertedKey{sum: sha256.Sum256(bytes), extension: ext, frame: frame}
+	if img, ok := g.converted[key]; ok {
+		return img, nil
+	}
+
+	img, err := FromBytes(bytes, ext, frame)
+	if err != nil {
+		return nil, err
+	}
+
+	g.converted[key] = img
+	return img, nil
+}
+
 // loadImage is responsible for loading an image
 func (g *provider) loadImage(file, extensionStr string) (*entity.Image, error) {
 	image, err := g.cache.GetImage(file, extension.Type(extensionStr))
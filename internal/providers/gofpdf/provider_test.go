@@ -1,8 +1,13 @@
 package gofpdf_test
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
+	goimage "image"
+	"image/color"
+	"image/gif"
+	"image/png"
 	"testing"
 	"time"
 
@@ -11,8 +16,12 @@ import (
 	"github.com/johnfercher/maroto/v2/internal/fixture"
 	"github.com/johnfercher/maroto/v2/internal/merror"
 	"github.com/johnfercher/maroto/v2/mocks"
+	"github.com/johnfercher/maroto/v2/pkg/consts/align"
 	"github.com/johnfercher/maroto/v2/pkg/consts/extension"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontfamily"
+	"github.com/johnfercher/maroto/v2/pkg/consts/matrixcode"
 	"github.com/johnfercher/maroto/v2/pkg/consts/protection"
+	"github.com/johnfercher/maroto/v2/pkg/props"
 	"github.com/stretchr/testify/mock"
 
 	"github.com/johnfercher/maroto/v2/internal/providers/gofpdf"
@@ -52,6 +61,48 @@ func TestProvider_AddText(t *testing.T) {
 	text.AssertNumberOfCalls(t, "Add", 1)
 }
 
+func TestProvider_AddRichText(t *testing.T) {
+	// Arrange
+	spans := []entity.Span{{Value: "text", Prop: fixture.SpanProp()}}
+	cell := &entity.Cell{}
+	prop := fixture.RichTextProp()
+
+	text := mocks.NewText(t)
+	text.EXPECT().AddRich(spans, cell, &prop)
+
+	dep := &gofpdf.Dependencies{
+		Text: text,
+	}
+	sut := gofpdf.New(dep)
+
+	// Act
+	sut.AddRichText(spans, cell, &prop)
+
+	// Assert
+	text.AssertNumberOfCalls(t, "AddRich", 1)
+}
+
+func TestProvider_GetRichTextHeight(t *testing.T) {
+	// Arrange
+	spans := []entity.Span{{Value: "text", Prop: fixture.SpanProp()}}
+	prop := fixture.RichTextProp()
+
+	text := mocks.NewText(t)
+	text.EXPECT().GetRichHeight(spans, &prop, 100.0).Return(12)
+
+	dep := &gofpdf.Dependencies{
+		Text: text,
+	}
+	sut := gofpdf.New(dep)
+
+	// Act
+	height := sut.GetRichTextHeight(spans, &prop, 100)
+
+	// Assert
+	text.AssertNumberOfCalls(t, "GetRichHeight", 1)
+	assert.Equal(t, 12.0, height)
+}
+
 func TestProvider_GetTextHeight(t *testing.T) {
 	// Arrange
 	fontHeightToReturn := 10.0
@@ -93,6 +144,86 @@ func TestProvider_AddLine(t *testing.T) {
 	line.AssertNumberOfCalls(t, "Add", 1)
 }
 
+func TestProvider_AddPolygon(t *testing.T) {
+	// Arrange
+	points := []entity.Point{{X: 1, Y: 2}, {X: 3, Y: 4}, {X: 5, Y: 2}}
+	prop := fixture.ShapeProp()
+
+	shape := mocks.NewShape(t)
+	shape.EXPECT().AddPolygon(points, &prop)
+
+	dep := &gofpdf.Dependencies{
+		Shape: shape,
+	}
+	sut := gofpdf.New(dep)
+
+	// Act
+	sut.AddPolygon(points, &prop)
+
+	// Assert
+	shape.AssertNumberOfCalls(t, "AddPolygon", 1)
+}
+
+func TestProvider_AddPolyline(t *testing.T) {
+	// Arrange
+	points := []entity.Point{{X: 1, Y: 2}, {X: 3, Y: 4}}
+	prop := fixture.ShapeProp()
+
+	shape := mocks.NewShape(t)
+	shape.EXPECT().AddPolyline(points, &prop)
+
+	dep := &gofpdf.Dependencies{
+		Shape: shape,
+	}
+	sut := gofpdf.New(dep)
+
+	// Act
+	sut.AddPolyline(points, &prop)
+
+	// Assert
+	shape.AssertNumberOfCalls(t, "AddPolyline", 1)
+}
+
+func TestProvider_AddRectangle(t *testing.T) {
+	// Arrange
+	cell := &entity.Cell{}
+	prop := fixture.ShapeProp()
+
+	shape := mocks.NewShape(t)
+	shape.EXPECT().AddRectangle(cell, &prop)
+
+	dep := &gofpdf.Dependencies{
+		Shape: shape,
+	}
+	sut := gofpdf.New(dep)
+
+	// Act
+	sut.AddRectangle(cell, &prop)
+
+	// Assert
+	shape.AssertNumberOfCalls(t, "AddRectangle", 1)
+}
+
+func TestProvider_AddEllipse(t *testing.T) {
+	// Arrange
+	cell := &entity.Cell{}
+	prop := fixture.ShapeProp()
+
+	shape := mocks.NewShape(t)
+	shape.EXPECT().AddEllipse(cell, &prop)
+
+	dep := &gofpdf.Dependencies{
+		Shape: shape,
+	}
+	sut := gofpdf.New(dep)
+
+	// Act
+	sut.AddEllipse(cell, &prop)
+
+	// Assert
+	shape.AssertNumberOfCalls(t, "AddEllipse", 1)
+}
+
 func TestProvider_CreateRow(t *testing.T) {
 	// Arrange
 	height := 10.0
@@ -113,6 +244,318 @@ func TestProvider_CreateRow(t *testing.T) {
 	fpdf.AssertNumberOfCalls(t, "Ln", 1)
 }
 
+func TestProvider_OpenGrid(t *testing.T) {
+	// Arrange
+	cell := &entity.Cell{X: 10, Y: 20}
+
+	fpdf := mocks.NewFpdf(t)
+	fpdf.EXPECT().GetXY().Return(50.0, 60.0)
+	fpdf.EXPECT().GetMargins().Return(5.0, 7.0, 5.0, 7.0)
+	fpdf.EXPECT().SetXY(15.0, 27.0)
+	fpdf.EXPECT().SetXY(50.0, 60.0)
+
+	dep := &gofpdf.Dependencies{
+		Fpdf: fpdf,
+	}
+
+	sut := gofpdf.New(dep)
+
+	// Act
+	sut.OpenGrid(cell)
+	sut.CloseGrid()
+	sut.CloseGrid()
+
+	// Assert
+	fpdf.AssertNumberOfCalls(t, "SetXY", 2)
+}
+
+func TestProvider_AddBookmark(t *testing.T) {
+	t.Run("when level has no parent, should reduce the level", func(t *testing.T) {
+		// Arrange
+		cell := &entity.Cell{Y: 20}
+
+		font := mocks.NewFont(t)
+		font.EXPECT().GetFamily().Return(fontfamily.Arial)
+
+		fpdf := mocks.NewFpdf(t)
+		fpdf.EXPECT().GetMargins().Return(5.0, 7.0, 5.0, 7.0)
+		fpdf.EXPECT().Bookmark("Chapter", 0, 27.0)
+		fpdf.EXPECT().Bookmark("Section", 1, 27.0)
+
+		dep := &gofpdf.Dependencies{
+			Fpdf: fpdf,
+			Font: font,
+		}
+
+		sut := gofpdf.New(dep)
+
+		// Act
+		sut.AddBookmark("Chapter", 1, cell)
+		sut.AddBookmark("Section", 3, cell)
+
+		// Assert
+		fpdf.AssertNumberOfCalls(t, "Bookmark", 2)
+	})
+	t.Run("when font is default and title is not ascii, should encode title as utf16", func(t *testing.T) {
+		// Arrange
+		cell := &entity.Cell{}
+
+		font := mocks.NewFont(t)
+		font.EXPECT().GetFamily().Return(fontfamily.Helvetica)
+
+		fpdf := mocks.NewFpdf(t)
+		fpdf.EXPECT().GetMargins().Return(5.0, 7.0, 5.0, 7.0)
+		fpdf.EXPECT().Bookmark("\xfe\xff\x00\xe9\xd8\x3d\xdc\xd6", 0, 7.0)
+
+		dep := &gofpdf.Dependencies{
+			Fpdf: fpdf,
+			Font: font,
+		}
+
+		sut := gofpdf.New(dep)
+
+		// Act
+		sut.AddBookmark("é📖", 0, cell)
+
+		// Assert
+		fpdf.AssertNumberOfCalls(t, "Bookmark", 1)
+	})
+	t.Run("when font is utf8, should keep the title", func(t *testing.T) {
+		// Arrange
+		cell := &entity.Cell{}
+
+		font := mocks.NewFont(t)
+		font.EXPECT().GetFamily().Return("custom")
+
+		fpdf := mocks.NewFpdf(t)
+		fpdf.EXPECT().GetMargins().Return(5.0, 7.0, 5.0, 7.0)
+		fpdf.EXPECT().Bookmark("Seção", 0, 7.0)
+
+		dep := &gofpdf.Dependencies{
+			Fpdf: fpdf,
+			Font: font,
+		}
+
+		sut := gofpdf.New(dep)
+
+		// Act
+		sut.AddBookmark("Seção", 0, cell)
+
+		// Assert
+		fpdf.AssertNumberOfCalls(t, "Bookmark", 1)
+	})
+}
+
+func TestProvider_AddAnchor(t *testing.T) {
+	t.Run("when link is added before the anchor, should use the same link", func(t *testing.T) {
+		// Arrange
+		cell := &entity.Cell{X: 10, Y: 20, Width: 30, Height: 5}
+
+		fpdf := mocks.NewFpdf(t)
+		fpdf.EXPECT().GetMargins().Return(5.0, 7.0, 5.0, 7.0)
+		fpdf.EXPECT().AddLink().Return(1)
+		fpdf.EXPECT().Link(15.0, 27.0, 30.0, 5.0, 1)
+		fpdf.EXPECT().SetLink(1, 27.0, -1)
+		fpdf.EXPECT().PageNo().Return(3)
+
+		dep := &gofpdf.Dependencies{
+			Fpdf: fpdf,
+		}
+
+		sut := gofpdf.New(dep)
+
+		// Act
+		_, foundBefore := sut.GetAnchorPage("intro")
+		sut.AddInternalLink("intro", cell)
+		sut.AddAnchor("intro", cell)
+		page, found := sut.GetAnchorPage("intro")
+
+		// Assert
+		assert.False(t, foundBefore)
+		assert.True(t, found)
+		assert.Equal(t, 3, page)
+		fpdf.AssertNumberOfCalls(t, "AddLink", 1)
+	})
+}
+
+func TestProvider_GetTextWidth(t *testing.T) {
+	// Arrange
+	prop := fixture.FontProp()
+
+	text := mocks.NewText(t)
+	text.EXPECT().GetWidth("text", &prop).Return(12.0)
+
+	dep := &gofpdf.Dependencies{
+		Text: text,
+	}
+
+	sut := gofpdf.New(dep)
+
+	// Act
+	width := sut.GetTextWidth("text", &prop)
+
+	// Assert
+	assert.Equal(t, 12.0, width)
+}
+
+func TestProvider_AddWatermark(t *testing.T) {
+	t.Run("when watermark is a text, should write it rotated around the center of the cell", func(t *testing.T) {
+		// Arrange
+		cell := &entity.Cell{Width: 100, Height: 200}
+		font := fixture.FontProp()
+		watermark := entity.NewTextWatermark("DRAFT", props.Watermark{Angle: 45})
+		watermark.Prop.MakeValid(&font)
+		textProp := watermark.Prop.ToTextProp()
+
+		text := mocks.NewText(t)
+		text.EXPECT().GetWidth("DRAFT", &props.Font{Family: font.Family, Style: font.Style, Size: 80}).Return(40.0)
+		text.EXPECT().Add("DRAFT", &entity.Cell{X: 29, Y: 95, Width: 42, Height: 10}, textProp)
+
+		fontMock := mocks.NewFont(t)
+		fontMock.EXPECT().GetHeight(font.Family, font.Style, 80.0).Return(10.0)
+
+		fpdf := mocks.NewFpdf(t)
+		fpdf.EXPECT().GetAutoPageBreak().Return(true, 10.0)
+		fpdf.EXPECT().GetPageSize().Return(210.0, 297.0)
+		fpdf.EXPECT().GetY().Return(10.0)
+		fpdf.EXPECT().GetMargins().Return(5.0, 7.0, 5.0, 7.0)
+		fpdf.EXPECT().TransformBegin()
+		fpdf.EXPECT().SetAlpha(0.3, "Normal")
+		fpdf.EXPECT().TransformRotate(45.0, 55.0, 107.0)
+		fpdf.EXPECT().SetAlpha(1.0, "Normal")
+		fpdf.EXPECT().TransformEnd()
+
+		dep := &gofpdf.Dependencies{
+			Fpdf: fpdf,
+			Font: fontMock,
+			Text: text,
+		}
+
+		sut := gofpdf.New(dep)
+
+		// Act
+		sut.AddWatermark(watermark, cell)
+
+		// Assert
+		text.AssertNumberOfCalls(t, "Add", 1)
+		fpdf.AssertNumberOfCalls(t, "TransformRotate", 1)
+		fpdf.AssertNotCalled(t, "AddPage")
+	})
+	t.Run("when watermark is placed at the right top, should rotate it around the center of the corner", func(t *testing.T) {
+		// Arrange
+		cell := &entity.Cell{Width: 100, Height: 200}
+		font := fixture.FontProp()
+		watermark := entity.NewTextWatermark("PAID", props.Watermark{Place: props.RightTop})
+		watermark.Prop.MakeValid(&font)
+
+		text := mocks.NewText(t)
+		text.EXPECT().GetWidth("PAID", mock.Anything).Return(40.0)
+		text.EXPECT().Add("PAID", &entity.Cell{X: 59, Y: 0, Width: 42, Height: 10}, mock.Anything)
+
+		fontMock := mocks.NewFont(t)
+		fontMock.EXPECT().GetHeight(font.Family, font.Style, 80.0).Return(10.0)
+
+		fpdf := mocks.NewFpdf(t)
+		fpdf.EXPECT().GetAutoPageBreak().Return(true, 10.0)
+		fpdf.EXPECT().GetPageSize().Return(210.0, 297.0)
+		fpdf.EXPECT().GetY().Return(10.0)
+		fpdf.EXPECT().GetMargins().Return(5.0, 7.0, 5.0, 7.0)
+		fpdf.EXPECT().TransformBegin()
+		fpdf.EXPECT().SetAlpha(mock.Anything, "Normal")
+		fpdf.EXPECT().TransformRotate(0.0, 85.0, 12.0)
+		fpdf.EXPECT().TransformEnd()
+
+		dep := &gofpdf.Dependencies{
+			Fpdf: fpdf,
+			Font: fontMock,
+			Text: text,
+		}
+
+		sut := gofpdf.New(dep)
+
+		// Act
+		sut.AddWatermark(watermark, cell)
+
+		// Assert
+		fpdf.AssertNumberOfCalls(t, "TransformRotate", 1)
+	})
+	t.Run("when background watermark is drawn after a full page, should start the next page", func(t *testing.T) {
+		// Arrange
+		cell := &entity.Cell{Width: 100, Height: 200}
+		font := fixture.FontProp()
+		watermark := entity.NewTextWatermark("DRAFT")
+		watermark.Prop.MakeValid(&font)
+
+		text := mocks.NewText(t)
+		text.EXPECT().GetWidth("DRAFT", mock.Anything).Return(40.0)
+		text.EXPECT().Add("DRAFT", mock.Anything, mock.Anything)
+
+		fontMock := mocks.NewFont(t)
+		fontMock.EXPECT().GetHeight(font.Family, font.Style, 80.0).Return(10.0)
+
+		fpdf := mocks.NewFpdf(t)
+		fpdf.EXPECT().GetAutoPageBreak().Return(true, 10.0)
+		fpdf.EXPECT().GetPageSize().Return(210.0, 297.0)
+		fpdf.EXPECT().GetY().Return(286.9999999999)
+		fpdf.EXPECT().AddPage()
+		fpdf.EXPECT().GetMargins().Return(5.0, 7.0, 5.0, 7.0)
+		fpdf.EXPECT().TransformBegin()
+		fpdf.EXPECT().SetAlpha(mock.Anything, "Normal")
+		fpdf.EXPECT().TransformRotate(mock.Anything, mock.Anything, mock.Anything)
+		fpdf.EXPECT().TransformEnd()
+
+		dep := &gofpdf.Dependencies{
+			Fpdf: fpdf,
+			Font: fontMock,
+			Text: text,
+		}
+
+		sut := gofpdf.New(dep)
+
+		// Act
+		sut.AddWatermark(watermark, cell)
+
+		// Assert
+		fpdf.AssertNumberOfCalls(t, "AddPage", 1)
+	})
+	t.Run("when watermark is in the foreground, should draw it in the current page", func(t *testing.T) {
+		// Arrange
+		cell := &entity.Cell{Width: 100, Height: 200}
+		font := fixture.FontProp()
+		watermark := entity.NewTextWatermark("DRAFT", props.Watermark{Foreground: true})
+		watermark.Prop.MakeValid(&font)
+
+		text := mocks.NewText(t)
+		text.EXPECT().GetWidth("DRAFT", mock.Anything).Return(40.0)
+		text.EXPECT().Add("DRAFT", mock.Anything, mock.Anything)
+
+		fontMock := mocks.NewFont(t)
+		fontMock.EXPECT().GetHeight(font.Family, font.Style, 80.0).Return(10.0)
+
+		fpdf := mocks.NewFpdf(t)
+		fpdf.EXPECT().GetMargins().Return(5.0, 7.0, 5.0, 7.0)
+		fpdf.EXPECT().TransformBegin()
+		fpdf.EXPECT().SetAlpha(mock.Anything, "Normal")
+		fpdf.EXPECT().TransformRotate(mock.Anything, mock.Anything, mock.Anything)
+		fpdf.EXPECT().TransformEnd()
+
+		dep := &gofpdf.Dependencies{
+			Fpdf: fpdf,
+			Font: fontMock,
+			Text: text,
+		}
+
+		sut := gofpdf.New(dep)
+
+		// Act
+		sut.AddWatermark(watermark, cell)
+
+		// Assert
+		fpdf.AssertNotCalled(t, "GetY")
+		fpdf.AssertNotCalled(t, "AddPage")
+	})
+}
+
 func TestProvider_CreateCol(t *testing.T) {
 	// Arrange
 	width := 10.0
@@ -365,6 +808,38 @@ func TestProvider_AddImageFromBytes(t *testing.T) {
 		// Act
 		sut.AddImageFromBytes(img.Bytes, cell, &prop, img.Extension)
 
+		// Assert
+		image.AssertNumberOfCalls(t, "Add", 1)
+	})
+	t.Run("when image is converted, should add it as png", func(t *testing.T) {
+		// Arrange
+		var gifBytes bytes.Buffer
+		_ = gif.Encode(&gifBytes, goimage.NewGray(goimage.Rect(0, 0, 1, 1)), nil)
+		prop := fixture.RectProp()
+		cell := &entity.Cell{}
+
+		cfg := &entity.Config{
+			Margins: &entity.Margins{
+				Left:   10,
+				Top:    10,
+				Right:  10,
+				Bottom: 10,
+			},
+		}
+
+		image := mocks.NewImage(t)
+		image.EXPECT().Add(mock.Anything, cell, cfg.Margins, &prop, extension.Png, false).Return(nil)
+
+		dep := &gofpdf.Dependencies{
+			Image: image,
+			Cfg:   cfg,
+		}
+
+		sut := gofpdf.New(dep)
+
+		// Act
+		sut.AddImageFromBytes(gifBytes.Bytes(), cell, &prop, extension.Gif)
+
 		// Assert
 		image.AssertNumberOfCalls(t, "Add", 1)
 	})
@@ -494,7 +969,7 @@ func TestProvider_GetDimensionsByImage(t *testing.T) {
 		sut := gofpdf.New(dep)
 
 		// Act
-		dimensions, err := sut.GetDimensionsByImage("docs/assets/images/biplane.jpg")
+		dimensions, err := sut.GetDimensionsByImage("docs/assets/images/biplane.jpg", 0)
 
 		// Assert
 		cache.AssertNumberOfCalls(t, "GetImage", 1)
@@ -522,7 +997,7 @@ func TestProvider_GetDimensionsByImage(t *testing.T) {
 		sut := gofpdf.New(dep)
 
 		// Act
-		dimensions, err := sut.GetDimensionsByImage("docs/assets/images/biplane.jpg")
+		dimensions, err := sut.GetDimensionsByImage("docs/assets/images/biplane.jpg", 0)
 
 		// Assert
 		cache.AssertNumberOfCalls(t, "GetImage", 1)
@@ -541,7 +1016,7 @@ func TestProvider_GetDimensionsByImageByte(t *testing.T) {
 		sut := gofpdf.New(dep)
 
 		// Act
-		dimensions, err := sut.GetDimensionsByImageByte([]byte{1, 2, 3}, "jj")
+		dimensions, err := sut.GetDimensionsByImageByte([]byte{1, 2, 3}, "jj", 0)
 
 		// Assert
 		assert.Nil(t, dimensions)
@@ -563,13 +1038,367 @@ func TestProvider_GetDimensionsByImageByte(t *testing.T) {
 		sut := gofpdf.New(dep)
 
 		// Act
-		dimensions, err := sut.GetDimensionsByImageByte(img.Bytes, extension.Png)
+		dimensions, err := sut.GetDimensionsByImageByte(img.Bytes, extension.Png, 0)
 
 		// Assert
 		image.AssertNumberOfCalls(t, "GetImageInfo", 1)
 		assert.Nil(t, err)
 		assert.NotNil(t, dimensions)
 	})
+
+	t.Run("when svg bytes are sent, should return the viewBox dimension", func(t *testing.T) {
+		// Arrange
+		dep := &gofpdf.Dependencies{}
+
+		sut := gofpdf.New(dep)
+
+		// Act
+		dimensions, err := sut.GetDimensionsByImageByte([]byte(`<svg viewBox="0 0 300 200"></svg>`), extension.Svg, 0)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, &entity.Dimensions{Width: 300, Height: 200}, dimensions)
+	})
+
+	t.Run("when frame is sent, should measure the frame", func(t *testing.T) {
+		// Arrange
+		data := newGrayTiff(10, 200)
+		isSecondFrame := func(img *entity.Image) bool {
+			decoded, err := png.Decode(bytes.NewReader(img.Bytes))
+			return err == nil && decoded.At(0, 0) == color.RGBA{R: 200, G: 200, B: 200, A: 255}
+		}
+
+		image := mocks.NewImage(t)
+		image.EXPECT().GetImageInfo(mock.MatchedBy(isSecondFrame), extension.Png).Return(&gpdf.ImageInfoType{}, uuid.UUID{})
+
+		dep := &gofpdf.Dependencies{
+			Image: image,
+		}
+
+		sut := gofpdf.New(dep)
+
+		// Act
+		dimensions, err := sut.GetDimensionsByImageByte(data, extension.Tif, 1)
+
+		// Assert
+		image.AssertNumberOfCalls(t, "GetImageInfo", 1)
+		assert.Nil(t, err)
+		assert.NotNil(t, dimensions)
+	})
+
+	t.Run("when converted image is measured and drawn, should convert it once", func(t *testing.T) {
+		// Arrange
+		var gifBytes bytes.Buffer
+		_ = gif.Encode(&gifBytes, goimage.NewGray(goimage.Rect(0, 0, 1, 1)), nil)
+		prop := fixture.RectProp()
+		cell := &entity.Cell{}
+		cfg := &entity.Config{
+			Margins: &entity.Margins{},
+		}
+
+		var measured, drawn *entity.Image
+		image := mocks.NewImage(t)
+		image.EXPECT().GetImageInfo(mock.Anything, extension.Png).Run(func(img *entity.Image, _ extension.Type) {
+			measured = img
+		}).Return(&gpdf.ImageInfoType{}, uuid.UUID{})
+		image.EXPECT().Add(mock.Anything, cell, cfg.Margins, &prop, extension.Png, false).Run(
+			func(img *entity.Image, _ *entity.Cell, _ *entity.Margins, _ *props.Rect, _ extension.Type, _ bool) {
+				drawn = img
+			}).Return(nil)
+
+		dep := &gofpdf.Dependencies{
+			Image: image,
+			Cfg:   cfg,
+		}
+
+		sut := gofpdf.New(dep)
+
+		// Act
+		_, _ = sut.GetDimensionsByImageByte(gifBytes.Bytes(), extension.Gif, 0)
+		_, _ = sut.GetDimensionsByImageByte(gifBytes.Bytes(), extension.Gif, 0)
+		sut.AddImageFromBytes(gifBytes.Bytes(), cell, &prop, extension.Gif)
+
+		// Assert
+		assert.NotNil(t, measured)
+		assert.Same(t, measured, drawn)
+	})
+
+	t.Run("when bytes are reused for another image, should convert the other image", func(t *testing.T) {
+		// Arrange
+		var wide, tall bytes.Buffer
+		_ = gif.Encode(&wide, goimage.NewGray(goimage.Rect(0, 0, 2, 1)), nil)
+		_ = gif.Encode(&tall, goimage.NewGray(goimage.Rect(0, 0, 1, 2)), nil)
+		buffer := append([]byte{}, wide.Bytes()...)
+
+		var measured []*entity.Image
+		image := mocks.NewImage(t)
+		image.EXPECT().GetImageInfo(mock.Anything, extension.Png).Run(func(img *entity.Image, _ extension.Type) {
+			measured = append(measured, img)
+		}).Return(&gpdf.ImageInfoType{}, uuid.UUID{})
+
+		sut := gofpdf.New(&gofpdf.Dependencies{Image: image})
+
+		// Act
+		_, _ = sut.GetDimensionsByImageByte(buffer, extension.Gif, 0)
+		copy(buffer, tall.Bytes())
+		_, _ = sut.GetDimensionsByImageByte(buffer, extension.Gif, 0)
+
+		// Assert
+		assert.Equal(t, len(wide.Bytes()), len(tall.Bytes()))
+		assert.Len(t, measured, 2)
+		assert.NotEqual(t, measured[0].Bytes, measured[1].Bytes)
+	})
+}
+
+func TestProvider_AddQrCode(t *testing.T) {
+	t.Run("when code cannot be generated, should apply message error", func(t *testing.T) {
+		// Arrange
+		prop := fixture.RectProp()
+		cell := &entity.Cell{}
+		cfg := &entity.Config{
+			Margins: &entity.Margins{},
+		}
+
+		code := mocks.NewCode(t)
+		code.EXPECT().AddQr("", cell, cfg.Margins, &prop).Return(errors.New("anyError"))
+
+		text := mocks.NewText(t)
+		text.EXPECT().Add("could not generate qrcode", cell, merror.DefaultErrorText)
+
+		dep := &gofpdf.Dependencies{
+			Code: code,
+			Text: text,
+			Cfg:  cfg,
+		}
+
+		sut := gofpdf.New(dep)
+
+		// Act
+		sut.AddQrCode("", cell, &prop)
+
+		// Assert
+		code.AssertNumberOfCalls(t, "AddQr", 1)
+		text.AssertNumberOfCalls(t, "Add", 1)
+	})
+	t.Run("when code can be generated, should not apply message error", func(t *testing.T) {
+		// Arrange
+		prop := fixture.RectProp()
+		cell := &entity.Cell{}
+		cfg := &entity.Config{
+			Margins: &entity.Margins{},
+		}
+
+		code := mocks.NewCode(t)
+		code.EXPECT().AddQr("code", cell, cfg.Margins, &prop).Return(nil)
+
+		dep := &gofpdf.Dependencies{
+			Code: code,
+			Cfg:  cfg,
+		}
+
+		sut := gofpdf.New(dep)
+
+		// Act
+		sut.AddQrCode("code", cell, &prop)
+
+		// Assert
+		code.AssertNumberOfCalls(t, "AddQr", 1)
+	})
+}
+
+func TestProvider_AddBarCode(t *testing.T) {
+	t.Run("when code cannot be generated, should apply message error", func(t *testing.T) {
+		// Arrange
+		prop := fixture.BarcodeProp()
+		prop.HumanReadable = false
+		font := fixture.FontProp()
+		cell := &entity.Cell{}
+		cfg := &entity.Config{
+			Margins:     &entity.Margins{},
+			DefaultFont: &font,
+		}
+
+		code := mocks.NewCode(t)
+		code.EXPECT().AddBar("", cell, cfg.Margins, &prop).Return(nil, errors.New("anyError"))
+
+		text := mocks.NewText(t)
+		text.EXPECT().Add("could not generate barcode: anyError", cell, merror.DefaultErrorText)
+
+		dep := &gofpdf.Dependencies{
+			Code: code,
+			Text: text,
+			Cfg:  cfg,
+		}
+
+		sut := gofpdf.New(dep)
+
+		// Act
+		sut.AddBarCode("", cell, &prop)
+
+		// Assert
+		code.AssertNumberOfCalls(t, "AddBar", 1)
+		text.AssertNumberOfCalls(t, "Add", 1)
+	})
+	t.Run("when there is no caption, should only draw the bars", func(t *testing.T) {
+		// Arrange
+		prop := fixture.BarcodeProp()
+		prop.HumanReadable = false
+		font := fixture.FontProp()
+		cell := &entity.Cell{Width: 100, Height: 50}
+		cfg := &entity.Config{
+			Margins:     &entity.Margins{},
+			DefaultFont: &font,
+		}
+
+		code := mocks.NewCode(t)
+		code.EXPECT().AddBar("code", cell, cfg.Margins, &prop).Return(&entity.Cell{Width: 100, Height: 20}, nil)
+
+		dep := &gofpdf.Dependencies{
+			Code: code,
+			Cfg:  cfg,
+		}
+
+		sut := gofpdf.New(dep)
+
+		// Act
+		sut.AddBarCode("code", cell, &prop)
+
+		// Assert
+		code.AssertNumberOfCalls(t, "AddBar", 1)
+	})
+	t.Run("when there is caption, should draw the caption below the bars", func(t *testing.T) {
+		// Arrange
+		prop := fixture.BarcodeProp()
+		font := fixture.FontProp()
+		cell := &entity.Cell{X: 5, Y: 5, Width: 100, Height: 50}
+		cfg := &entity.Config{
+			Margins:     &entity.Margins{},
+			DefaultFont: &font,
+		}
+		captionProp := font.ToTextProp(align.Center, 0, 0)
+
+		fontMock := mocks.NewFont(t)
+		fontMock.EXPECT().GetHeight(captionProp.Family, captionProp.Style, captionProp.Size).Return(4.0)
+
+		barsCell := &entity.Cell{X: 5, Y: 5, Width: 100, Height: 46}
+		code := mocks.NewCode(t)
+		code.EXPECT().AddBar("code", barsCell, cfg.Margins, &prop).Return(&entity.Cell{X: 10, Y: 15, Width: 90, Height: 20}, nil)
+
+		text := mocks.NewText(t)
+		text.EXPECT().Add("code", &entity.Cell{X: 10, Y: 35, Width: 90, Height: 4}, captionProp)
+
+		dep := &gofpdf.Dependencies{
+			Code: code,
+			Text: text,
+			Font: fontMock,
+			Cfg:  cfg,
+		}
+
+		sut := gofpdf.New(dep)
+
+		// Act
+		sut.AddBarCode("code", cell, &prop)
+
+		// Assert
+		code.AssertNumberOfCalls(t, "AddBar", 1)
+		text.AssertNumberOfCalls(t, "Add", 1)
+	})
+}
+
+func TestProvider_AddMatrixCode(t *testing.T) {
+	t.Run("when code cannot be generated, should apply message error", func(t *testing.T) {
+		// Arrange
+		prop := fixture.RectProp()
+		cell := &entity.Cell{}
+		cfg := &entity.Config{
+			Margins: &entity.Margins{},
+		}
+
+		code := mocks.NewCode(t)
+		code.EXPECT().AddMatrix("", matrixcode.PDF417, cell, cfg.Margins, &prop).Return(errors.New("anyError"))
+
+		text := mocks.NewText(t)
+		text.EXPECT().Add("could not generate pdf417: anyError", cell, merror.DefaultErrorText)
+
+		dep := &gofpdf.Dependencies{
+			Code: code,
+			Text: text,
+			Cfg:  cfg,
+		}
+
+		sut := gofpdf.New(dep)
+
+		// Act
+		sut.AddMatrixCode("", matrixcode.PDF417, cell, &prop)
+
+		// Assert
+		code.AssertNumberOfCalls(t, "AddMatrix", 1)
+		text.AssertNumberOfCalls(t, "Add", 1)
+	})
+	t.Run("when code can be generated, should not apply message error", func(t *testing.T) {
+		// Arrange
+		prop := fixture.RectProp()
+		cell := &entity.Cell{}
+		cfg := &entity.Config{
+			Margins: &entity.Margins{},
+		}
+
+		code := mocks.NewCode(t)
+		code.EXPECT().AddMatrix("code", matrixcode.DataMatrix, cell, cfg.Margins, &prop).Return(nil)
+
+		dep := &gofpdf.Dependencies{
+			Code: code,
+			Cfg:  cfg,
+		}
+
+		sut := gofpdf.New(dep)
+
+		// Act
+		sut.AddMatrixCode("code", matrixcode.DataMatrix, cell, &prop)
+
+		// Assert
+		code.AssertNumberOfCalls(t, "AddMatrix", 1)
+	})
+}
+
+func TestProvider_GetDimensionsByMatrixCode(t *testing.T) {
+	// Arrange
+	code := mocks.NewCode(t)
+	code.EXPECT().GetMatrixDimensions("code", matrixcode.DataMatrix).Return(&entity.Dimensions{Width: 10, Height: 10}, nil)
+
+	dep := &gofpdf.Dependencies{
+		Code: code,
+	}
+
+	sut := gofpdf.New(dep)
+
+	// Act
+	dimensions, err := sut.GetDimensionsByMatrixCode("code", matrixcode.DataMatrix)
+
+	// Assert
+	code.AssertNumberOfCalls(t, "GetMatrixDimensions", 1)
+	assert.Nil(t, err)
+	assert.Equal(t, &entity.Dimensions{Width: 10, Height: 10}, dimensions)
+}
+
+func TestProvider_GetDimensionsByQrCode(t *testing.T) {
+	// Arrange
+	code := mocks.NewCode(t)
+	code.EXPECT().GetQrDimensions("code").Return(&entity.Dimensions{Width: 21, Height: 21}, nil)
+
+	dep := &gofpdf.Dependencies{
+		Code: code,
+	}
+
+	sut := gofpdf.New(dep)
+
+	// Act
+	dimensions, err := sut.GetDimensionsByQrCode("code")
+
+	// Assert
+	code.AssertNumberOfCalls(t, "GetQrDimensions", 1)
+	assert.Nil(t, err)
+	assert.Equal(t, &entity.Dimensions{Width: 21, Height: 21}, dimensions)
 }
 
 /*func TestProvider_AddImageFromFile(t *testing.T) {
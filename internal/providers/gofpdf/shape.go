@@ -0,0 +1,145 @@
+package gofpdf
+
+import (
+	"github.com/jung-kurt/gofpdf"
+
+	"github.com/johnfercher/maroto/v2/internal/providers/gofpdf/gofpdfwrapper"
+	"github.com/johnfercher/maroto/v2/pkg/consts/linestyle"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+type shape struct {
+	pdf              gofpdfwrapper.Fpdf
+	defaultFillColor *props.Color
+	defaultDrawColor *props.Color
+	defaultThickness float64
+}
+
+func NewShape(pdf gofpdfwrapper.Fpdf) *shape {
+	return &shape{
+		pdf:              pdf,
+		defaultFillColor: &props.WhiteColor,
+		defaultDrawColor: &props.BlackColor,
+		defaultThickness: linestyle.DefaultLineThickness,
+	}
+}
+
+// AddPolygon draws a closed polygon, a shape without fill and border color is not drawn.
+func (s *shape) AddPolygon(points []entity.Point, prop *props.Shape) {
+	style := s.getStyle(prop)
+	if len(points) < 2 || style == "" {
+		return
+	}
+
+	left, top, _, _ := s.pdf.GetMargins()
+
+	pdfPoints := make([]gofpdf.PointType, len(points))
+	for i, point := range points {
+		pdfPoints[i] = gofpdf.PointType{X: left + point.X, Y: top + point.Y}
+	}
+
+	s.setStyle(prop)
+	s.pdf.Polygon(pdfPoints, style)
+	s.resetStyle(prop)
+}
+
+// AddPolyline draws an open path through the points with the border of the shape, the fill color is ignored.
+func (s *shape) AddPolyline(points []entity.Point, prop *props.Shape) {
+	if len(points) < 2 || prop.BorderColor == nil {
+		return
+	}
+
+	left, top, _, _ := s.pdf.GetMargins()
+	line := &props.Shape{BorderColor: prop.BorderColor, BorderThickness: prop.BorderThickness, LineStyle: prop.LineStyle}
+
+	s.setStyle(line)
+	s.pdf.MoveTo(left+points[0].X, top+points[0].Y)
+	for _, point := range points[1:] {
+		s.pdf.LineTo(left+point.X, top+point.Y)
+	}
+	s.pdf.DrawPath("D")
+	s.resetStyle(line)
+}
+
+// AddRectangle draws a rectangle filling the cell, with rounded corners when the shape has a corner radius.
+func (s *shape) AddRectangle(cell *entity.Cell, prop *props.Shape) {
+	style := s.getStyle(prop)
+	if style == "" {
+		return
+	}
+
+	left, top, _, _ := s.pdf.GetMargins()
+	x, y := left+cell.X, top+cell.Y
+
+	s.setStyle(prop)
+	if radius := min(prop.CornerRadius, cell.Width/2, cell.Height/2); radius > 0 {
+		s.pdf.RoundedRect(x, y, cell.Width, cell.Height, radius, "1234", style)
+	} else {
+		s.pdf.Rect(x, y, cell.Width, cell.Height, style)
+	}
+	s.resetStyle(prop)
+}
+
+// AddEllipse draws the ellipse inscribed in the cell, which is a circle when the cell is a square.
+func (s *shape) AddEllipse(cell *entity.Cell, prop *props.Shape) {
+	style := s.getStyle(prop)
+	if style == "" {
+		return
+	}
+
+	left, top, _, _ := s.pdf.GetMargins()
+	x, y := left+cell.X+cell.Width/2, top+cell.Y+cell.Height/2
+
+	s.setStyle(prop)
+	if cell.Width == cell.Height {
+		s.pdf.Circle(x, y, cell.Width/2, style)
+	} else {
+		s.pdf.Ellipse(x, y, cell.Width/2, cell.Height/2, 0, style)
+	}
+	s.resetStyle(prop)
+}
+
+func (s *shape) getStyle(prop *props.Shape) string {
+	style := ""
+
+	if prop.FillColor != nil {
+		style += "F"
+	}
+
+	if prop.BorderColor != nil {
+		style += "D"
+	}
+
+	return style
+}
+
+func (s *shape) setStyle(prop *props.Shape) {
+	if prop.FillColor != nil {
+		s.pdf.SetFillColor(prop.FillColor.Red, prop.FillColor.Green, prop.FillColor.Blue)
+	}
+
+	if prop.BorderColor != nil {
+		s.pdf.SetDrawColor(prop.BorderColor.Red, prop.BorderColor.Green, prop.BorderColor.Blue)
+		s.pdf.SetLineWidth(prop.BorderThickness)
+
+		if prop.LineStyle == linestyle.Dashed {
+			s.pdf.SetDashPattern([]float64{1, 1}, 0)
+		}
+	}
+}
+
+func (s *shape) resetStyle(prop *props.Shape) {
+	if prop.FillColor != nil {
+		s.pdf.SetFillColor(s.defaultFillColor.Red, s.defaultFillColor.Green, s.defaultFillColor.Blue)
+	}
+
+	if prop.BorderColor != nil {
+		s.pdf.SetDrawColor(s.defaultDrawColor.Red, s.defaultDrawColor.Green, s.defaultDrawColor.Blue)
+		s.pdf.SetLineWidth(s.defaultThickness)
+
+		if prop.LineStyle == linestyle.Dashed {
+			s.pdf.SetDashPattern([]float64{1, 0}, 0)
+		}
+	}
+}
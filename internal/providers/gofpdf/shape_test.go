@@ -0,0 +1,242 @@
+package gofpdf_test
+
+import (
+	"fmt"
+	"testing"
+
+	gpdf "github.com/jung-kurt/gofpdf"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/internal/providers/gofpdf"
+	"github.com/johnfercher/maroto/v2/mocks"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+func TestNewShape(t *testing.T) {
+	// Act
+	sut := gofpdf.NewShape(nil)
+
+	// Assert
+	assert.NotNil(t, sut)
+	assert.Equal(t, "*gofpdf.shape", fmt.Sprintf("%T", sut))
+}
+
+func TestShape_AddPolygon(t *testing.T) {
+	points := []entity.Point{{X: 1, Y: 2}, {X: 3, Y: 4}, {X: 5, Y: 2}}
+
+	t.Run("when shape has no fill and border color, should not draw", func(t *testing.T) {
+		// Arrange
+		prop := props.Shape{}
+		prop.MakeValid()
+
+		pdf := mocks.NewFpdf(t)
+		sut := gofpdf.NewShape(pdf)
+
+		// Act
+		sut.AddPolygon(points, &prop)
+
+		// Assert
+		pdf.AssertNotCalled(t, "Polygon")
+	})
+	t.Run("when shape has fill color, should fill polygon inside margins", func(t *testing.T) {
+		// Arrange
+		prop := props.Shape{FillColor: &props.RedColor}
+		prop.MakeValid()
+
+		pdf := mocks.NewFpdf(t)
+		pdf.EXPECT().GetMargins().Return(10, 20, 10, 20)
+		pdf.EXPECT().SetFillColor(255, 0, 0)
+		pdf.EXPECT().Polygon([]gpdf.PointType{{X: 11, Y: 22}, {X: 13, Y: 24}, {X: 15, Y: 22}}, "F")
+		pdf.EXPECT().SetFillColor(255, 255, 255)
+		sut := gofpdf.NewShape(pdf)
+
+		// Act
+		sut.AddPolygon(points, &prop)
+
+		// Assert
+		pdf.AssertNumberOfCalls(t, "Polygon", 1)
+		pdf.AssertNumberOfCalls(t, "SetFillColor", 2)
+	})
+	t.Run("when shape has fill and dashed border, should fill and draw polygon", func(t *testing.T) {
+		// Arrange
+		prop := fixture.ShapeProp()
+
+		pdf := mocks.NewFpdf(t)
+		pdf.EXPECT().GetMargins().Return(10, 20, 10, 20)
+		pdf.EXPECT().SetFillColor(100, 50, 200)
+		pdf.EXPECT().SetDrawColor(100, 50, 200)
+		pdf.EXPECT().SetLineWidth(0.5)
+		pdf.EXPECT().SetDashPattern([]float64{1, 1}, 0.0)
+		pdf.EXPECT().Polygon([]gpdf.PointType{{X: 11, Y: 22}, {X: 13, Y: 24}, {X: 15, Y: 22}}, "FD")
+		pdf.EXPECT().SetFillColor(255, 255, 255)
+		pdf.EXPECT().SetDrawColor(0, 0, 0)
+		pdf.EXPECT().SetLineWidth(0.2)
+		pdf.EXPECT().SetDashPattern([]float64{1, 0}, 0.0)
+		sut := gofpdf.NewShape(pdf)
+
+		// Act
+		sut.AddPolygon(points, &prop)
+
+		// Assert
+		pdf.AssertNumberOfCalls(t, "Polygon", 1)
+		pdf.AssertNumberOfCalls(t, "SetDrawColor", 2)
+		pdf.AssertNumberOfCalls(t, "SetDashPattern", 2)
+	})
+}
+
+func TestShape_AddPolyline(t *testing.T) {
+	points := []entity.Point{{X: 1, Y: 2}, {X: 3, Y: 4}, {X: 5, Y: 2}}
+
+	t.Run("when shape has no border color, should not draw", func(t *testing.T) {
+		// Arrange
+		prop := props.Shape{FillColor: &props.RedColor}
+		prop.MakeValid()
+
+		pdf := mocks.NewFpdf(t)
+		sut := gofpdf.NewShape(pdf)
+
+		// Act
+		sut.AddPolyline(points, &prop)
+
+		// Assert
+		pdf.AssertNotCalled(t, "DrawPath")
+	})
+	t.Run("when shape has border color, should draw open path inside margins and ignore fill", func(t *testing.T) {
+		// Arrange
+		prop := props.Shape{FillColor: &props.RedColor, BorderColor: &props.RedColor, BorderThickness: 0.5}
+		prop.MakeValid()
+
+		pdf := mocks.NewFpdf(t)
+		pdf.EXPECT().GetMargins().Return(10, 20, 10, 20)
+		pdf.EXPECT().SetDrawColor(255, 0, 0)
+		pdf.EXPECT().SetLineWidth(0.5)
+		pdf.EXPECT().MoveTo(11.0, 22.0)
+		pdf.EXPECT().LineTo(13.0, 24.0)
+		pdf.EXPECT().LineTo(15.0, 22.0)
+		pdf.EXPECT().DrawPath("D")
+		pdf.EXPECT().SetDrawColor(0, 0, 0)
+		pdf.EXPECT().SetLineWidth(0.2)
+		sut := gofpdf.NewShape(pdf)
+
+		// Act
+		sut.AddPolyline(points, &prop)
+
+		// Assert
+		pdf.AssertNumberOfCalls(t, "LineTo", 2)
+		pdf.AssertNotCalled(t, "SetFillColor", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestShape_AddRectangle(t *testing.T) {
+	cell := &entity.Cell{X: 1, Y: 2, Width: 20, Height: 6}
+
+	t.Run("when shape has no fill and border color, should not draw", func(t *testing.T) {
+		// Arrange
+		prop := props.Shape{}
+		prop.MakeValid()
+
+		pdf := mocks.NewFpdf(t)
+		sut := gofpdf.NewShape(pdf)
+
+		// Act
+		sut.AddRectangle(cell, &prop)
+
+		// Assert
+		pdf.AssertNotCalled(t, "Rect")
+	})
+	t.Run("when shape has no corner radius, should draw rect inside margins", func(t *testing.T) {
+		// Arrange
+		prop := props.Shape{FillColor: &props.RedColor}
+		prop.MakeValid()
+
+		pdf := mocks.NewFpdf(t)
+		pdf.EXPECT().GetMargins().Return(10, 20, 10, 20)
+		pdf.EXPECT().SetFillColor(255, 0, 0)
+		pdf.EXPECT().Rect(11.0, 22.0, 20.0, 6.0, "F")
+		pdf.EXPECT().SetFillColor(255, 255, 255)
+		sut := gofpdf.NewShape(pdf)
+
+		// Act
+		sut.AddRectangle(cell, &prop)
+
+		// Assert
+		pdf.AssertNumberOfCalls(t, "Rect", 1)
+	})
+	t.Run("when corner radius is greater than the rect, should limit to half of the smaller side", func(t *testing.T) {
+		// Arrange
+		prop := props.Shape{BorderColor: &props.RedColor, CornerRadius: 5}
+		prop.MakeValid()
+
+		pdf := mocks.NewFpdf(t)
+		pdf.EXPECT().GetMargins().Return(10, 20, 10, 20)
+		pdf.EXPECT().SetDrawColor(255, 0, 0)
+		pdf.EXPECT().SetLineWidth(0.2)
+		pdf.EXPECT().RoundedRect(11.0, 22.0, 20.0, 6.0, 3.0, "1234", "D")
+		pdf.EXPECT().SetDrawColor(0, 0, 0)
+		sut := gofpdf.NewShape(pdf)
+
+		// Act
+		sut.AddRectangle(cell, &prop)
+
+		// Assert
+		pdf.AssertNumberOfCalls(t, "RoundedRect", 1)
+		pdf.AssertNumberOfCalls(t, "SetLineWidth", 2)
+	})
+}
+
+func TestShape_AddEllipse(t *testing.T) {
+	t.Run("when shape has no fill and border color, should not draw", func(t *testing.T) {
+		// Arrange
+		prop := props.Shape{}
+		prop.MakeValid()
+
+		pdf := mocks.NewFpdf(t)
+		sut := gofpdf.NewShape(pdf)
+
+		// Act
+		sut.AddEllipse(&entity.Cell{Width: 10, Height: 10}, &prop)
+
+		// Assert
+		pdf.AssertNotCalled(t, "Circle")
+		pdf.AssertNotCalled(t, "Ellipse")
+	})
+	t.Run("when cell is a square, should draw circle inside margins", func(t *testing.T) {
+		// Arrange
+		prop := props.Shape{FillColor: &props.RedColor}
+		prop.MakeValid()
+
+		pdf := mocks.NewFpdf(t)
+		pdf.EXPECT().GetMargins().Return(10, 20, 10, 20)
+		pdf.EXPECT().SetFillColor(255, 0, 0)
+		pdf.EXPECT().Circle(16.0, 27.0, 5.0, "F")
+		pdf.EXPECT().SetFillColor(255, 255, 255)
+		sut := gofpdf.NewShape(pdf)
+
+		// Act
+		sut.AddEllipse(&entity.Cell{X: 1, Y: 2, Width: 10, Height: 10}, &prop)
+
+		// Assert
+		pdf.AssertNumberOfCalls(t, "Circle", 1)
+	})
+	t.Run("when cell is not a square, should draw ellipse inside margins", func(t *testing.T) {
+		// Arrange
+		prop := props.Shape{FillColor: &props.RedColor}
+		prop.MakeValid()
+
+		pdf := mocks.NewFpdf(t)
+		pdf.EXPECT().GetMargins().Return(10, 20, 10, 20)
+		pdf.EXPECT().SetFillColor(255, 0, 0)
+		pdf.EXPECT().Ellipse(21.0, 25.0, 10.0, 3.0, 0.0, "F")
+		pdf.EXPECT().SetFillColor(255, 255, 255)
+		sut := gofpdf.NewShape(pdf)
+
+		// Act
+		sut.AddEllipse(&entity.Cell{X: 1, Y: 2, Width: 20, Height: 6}, &prop)
+
+		// Assert
+		pdf.AssertNumberOfCalls(t, "Ellipse", 1)
+	})
+}
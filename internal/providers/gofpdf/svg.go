@@ -0,0 +1,97 @@
+package gofpdf
+
+import (
+	"github.com/johnfercher/maroto/v2/internal/svg"
+	"github.com/johnfercher/maroto/v2/pkg/consts/extension"
+	"github.com/johnfercher/maroto/v2/pkg/consts/linestyle"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+func isSvg(ext extension.Type) bool {
+	return ext == extension.Svg
+}
+
+// getSvgDimensions returns the dimensions of the viewBox of a SVG.
+func getSvgDimensions(bytes []byte) (*entity.Dimensions, error) {
+	img, err := svg.Parse(bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return img.GetDimensions(), nil
+}
+
+// addSvg draws the shapes of a SVG as vectors, the viewBox is placed in the cell like an image.
+func (s *image) addSvg(img *entity.Image, cell *entity.Cell, margins *entity.Margins, prop *props.Rect) error {
+	parsed, err := svg.Parse(img.Bytes)
+	if err != nil {
+		return err
+	}
+
+	dimensions := s.math.Resize(parsed.GetDimensions(), cell.GetDimensions(), prop.Percent, prop.JustReferenceWidth)
+
+	rectCell := &entity.Cell{X: prop.Left, Y: prop.Top, Width: dimensions.Width, Height: dimensions.Height}
+	if prop.Center {
+		rectCell = s.math.GetInnerCenterCell(dimensions, cell.GetDimensions())
+	}
+
+	scale := rectCell.Width / parsed.ViewBox.Width
+	x := cell.X + rectCell.X + margins.Left - parsed.ViewBox.X*scale
+	y := cell.Y + rectCell.Y + margins.Top - parsed.ViewBox.Y*scale
+	toPdf := func(p svg.Point) (float64, float64) {
+		return x + p.X*scale, y + p.Y*scale
+	}
+
+	// content outside the viewBox is not visible, as in browsers
+	s.pdf.ClipRect(cell.X+rectCell.X+margins.Left, cell.Y+rectCell.Y+margins.Top, rectCell.Width, rectCell.Height, false)
+	defer s.pdf.ClipEnd()
+
+	drawer := NewShape(s.pdf)
+	for _, svgShape := range parsed.Shapes {
+		shapeProp := &props.Shape{
+			FillColor:       svgShape.Fill,
+			BorderColor:     svgShape.Stroke,
+			BorderThickness: svgShape.StrokeWidth * scale,
+			LineStyle:       linestyle.Solid,
+		}
+
+		style := drawer.getStyle(shapeProp)
+		if svgShape.EvenOdd && svgShape.Fill != nil {
+			style += "*"
+		}
+
+		drawer.setStyle(shapeProp)
+		if svgShape.Opacity < 1 {
+			s.pdf.SetAlpha(svgShape.Opacity, "Normal")
+		}
+
+		for _, segment := range svgShape.Segments {
+			s.addSegment(segment, toPdf)
+		}
+		s.pdf.DrawPath(style)
+
+		if svgShape.Opacity < 1 {
+			s.pdf.SetAlpha(1, "Normal")
+		}
+		drawer.resetStyle(shapeProp)
+	}
+
+	return nil
+}
+
+func (s *image) addSegment(segment svg.Segment, toPdf func(svg.Point) (float64, float64)) {
+	switch segment.Command {
+	case svg.MoveTo:
+		s.pdf.MoveTo(toPdf(segment.Points[0]))
+	case svg.LineTo:
+		s.pdf.LineTo(toPdf(segment.Points[0]))
+	case svg.CurveTo:
+		cx0, cy0 := toPdf(segment.Points[0])
+		cx1, cy1 := toPdf(segment.Points[1])
+		px, py := toPdf(segment.Points[2])
+		s.pdf.CurveBezierCubicTo(cx0, cy0, cx1, cy1, px, py)
+	case svg.Close:
+		s.pdf.ClosePath()
+	}
+}
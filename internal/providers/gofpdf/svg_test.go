@@ -0,0 +1,90 @@
+package gofpdf_test
+
+import (
+	"testing"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/internal/math"
+	"github.com/johnfercher/maroto/v2/internal/providers/gofpdf"
+	"github.com/johnfercher/maroto/v2/mocks"
+	"github.com/johnfercher/maroto/v2/pkg/consts/extension"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImage_Add_Svg(t *testing.T) {
+	t.Run("when svg is invalid, should return error", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		margins := fixture.MarginsEntity()
+		rect := fixture.RectProp()
+		img := &entity.Image{Bytes: []byte("<svg"), Extension: extension.Svg}
+
+		image := gofpdf.NewImage(mocks.NewFpdf(t), math.New())
+
+		// Act
+		err := image.Add(img, &cell, &margins, &rect, extension.Svg, true)
+
+		// Assert
+		assert.NotNil(t, err)
+	})
+	t.Run("when svg is valid, should draw the shapes as paths", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		margins := fixture.MarginsEntity()
+		rect := fixture.RectProp()
+		data := `<svg viewBox="0 0 100 50"><rect width="100" height="50" fill="red" opacity="0.5"/></svg>`
+		img := &entity.Image{Bytes: []byte(data), Extension: extension.Svg}
+
+		pdf := mocks.NewFpdf(t)
+		pdf.EXPECT().ClipRect(30.0, 35.0, 98.0, 49.0, false)
+		pdf.EXPECT().SetFillColor(255, 0, 0)
+		pdf.EXPECT().SetAlpha(0.5, "Normal")
+		pdf.EXPECT().MoveTo(30.0, 35.0)
+		pdf.EXPECT().LineTo(128.0, 35.0)
+		pdf.EXPECT().LineTo(128.0, 84.0)
+		pdf.EXPECT().LineTo(30.0, 84.0)
+		pdf.EXPECT().ClosePath()
+		pdf.EXPECT().DrawPath("F")
+		pdf.EXPECT().SetAlpha(1.0, "Normal")
+		pdf.EXPECT().SetFillColor(255, 255, 255)
+		pdf.EXPECT().ClipEnd()
+
+		image := gofpdf.NewImage(pdf, math.New())
+
+		// Act
+		err := image.Add(img, &cell, &margins, &rect, extension.Svg, true)
+
+		// Assert
+		assert.Nil(t, err)
+		pdf.AssertNumberOfCalls(t, "LineTo", 3)
+		pdf.AssertNumberOfCalls(t, "SetFillColor", 2)
+	})
+	t.Run("when fill rule is evenodd, should draw with the even-odd style", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		margins := fixture.MarginsEntity()
+		rect := fixture.RectProp()
+		data := `<svg viewBox="0 0 100 50"><path d="M0 0 L10 0 L10 10 Z" fill-rule="evenodd"/></svg>`
+		img := &entity.Image{Bytes: []byte(data), Extension: extension.Svg}
+
+		pdf := mocks.NewFpdf(t)
+		pdf.EXPECT().ClipRect(30.0, 35.0, 98.0, 49.0, false)
+		pdf.EXPECT().SetFillColor(0, 0, 0)
+		pdf.EXPECT().MoveTo(30.0, 35.0)
+		pdf.EXPECT().LineTo(39.8, 35.0)
+		pdf.EXPECT().LineTo(39.8, 44.8)
+		pdf.EXPECT().ClosePath()
+		pdf.EXPECT().DrawPath("F*")
+		pdf.EXPECT().SetFillColor(255, 255, 255)
+		pdf.EXPECT().ClipEnd()
+
+		image := gofpdf.NewImage(pdf, math.New())
+
+		// Act
+		err := image.Add(img, &cell, &margins, &rect, extension.Svg, true)
+
+		// Assert
+		assert.Nil(t, err)
+	})
+}
@@ -2,18 +2,49 @@ package gofpdf
 
 import (
 	"fmt"
+	"math"
 	"strings"
 	"unicode"
+	"unicode/utf8"
 
 	"github.com/johnfercher/maroto/v2/internal/providers/gofpdf/gofpdfwrapper"
 	"github.com/johnfercher/maroto/v2/pkg/consts/align"
 	"github.com/johnfercher/maroto/v2/pkg/consts/breakline"
-	"github.com/johnfercher/maroto/v2/pkg/consts/fontfamily"
+	"github.com/johnfercher/maroto/v2/pkg/consts/overflow"
 	"github.com/johnfercher/maroto/v2/pkg/core"
 	"github.com/johnfercher/maroto/v2/pkg/core/entity"
 	"github.com/johnfercher/maroto/v2/pkg/props"
 )
 
+// richFragment is a piece of a span without spaces.
+type richFragment struct {
+	text  string
+	prop  *props.Span
+	width float64
+}
+
+// richWord is a sequence of fragments without spaces between them.
+type richWord struct {
+	fragments  []richFragment
+	width      float64
+	spaceWidth float64
+}
+
+// richSegment is a sequence of words between line breaks, prop is the
+// font used to measure the segment when it is an empty line.
+type richSegment struct {
+	words []richWord
+	prop  *props.Span
+}
+
+// richLine is a line of a rich text, ascent and descent are the space
+// it occupies above and below its baseline.
+type richLine struct {
+	words   []richWord
+	ascent  float64
+	descent float64
+}
+
 type text struct {
 	pdf  gofpdfwrapper.Fpdf
 	math core.Math
@@ -31,6 +62,11 @@ func NewText(pdf gofpdfwrapper.Fpdf, math core.Math, font core.Font) *text {
 
 // Add a text inside a cell.
 func (s *text) Add(text string, cell *entity.Cell, textProp *props.Text) {
+	if textProp.Rotation != 0 {
+		s.addRotated(text, cell, textProp)
+		return
+	}
+
 	s.font.SetFont(textProp.Family, textProp.Style, textProp.Size)
 	fontHeight := s.font.GetHeight(textProp.Family, textProp.Style, textProp.Size)
 
@@ -60,18 +96,26 @@ func (s *text) Add(text string, cell *entity.Cell, textProp *props.Text) {
 	}
 
 	// override style if hyperlink is set
-	if textProp.Hyperlink != nil {
+	if textProp.Hyperlink != nil || textProp.InternalLink != nil {
 		s.font.SetColor(&props.BlueColor)
 	}
 
 	y += fontHeight
+	limit := getLinesLimit(textProp, cell.Height-textProp.Top, fontHeight)
 
 	// Apply Unicode before calc spaces
-	unicodeText := s.textToUnicode(text, textProp)
+	unicodeText := s.textToUnicode(text, textProp.Family)
 	stringWidth := s.pdf.GetStringWidth(unicodeText)
 
 	// If should add one line
 	if stringWidth < width {
+		if limit == 0 {
+			if textProp.Color != nil {
+				s.font.SetColor(originalColor)
+			}
+			return
+		}
+
 		s.addLine(textProp, x, width, y, stringWidth, unicodeText)
 		if textProp.Color != nil {
 			s.font.SetColor(originalColor)
@@ -88,6 +132,13 @@ func (s *text) Add(text string, cell *entity.Cell, textProp *props.Text) {
 		lines = s.getLinesBreakingLineWithDash(unicodeText, width)
 	}
 
+	if limit >= 0 && len(lines) > limit {
+		lines = lines[:limit]
+		if textProp.Overflow == overflow.Ellipsis && limit > 0 {
+			lines[limit-1] = s.addEllipsis(lines[limit-1], textProp.Family, width)
+		}
+	}
+
 	accumulateOffsetY := 0.0
 
 	for index, line := range lines {
@@ -102,11 +153,76 @@ func (s *text) Add(text string, cell *entity.Cell, textProp *props.Text) {
 	}
 }
 
+// getLinesLimit returns the quantity of lines of a text which are written in a cell of the height,
+// or -1 when all lines are written.
+func getLinesLimit(textProp *props.Text, height, fontHeight float64) int {
+	limit := -1
+	if textProp.MaxLines > 0 {
+		limit = textProp.MaxLines
+	}
+
+	if textProp.Overflow == overflow.Clip || textProp.Overflow == overflow.Ellipsis {
+		// the float error of the height of a row which fits the lines must not hide the last line
+		fit := int(math.Floor((height+textProp.VerticalPadding)/(fontHeight+textProp.VerticalPadding) + 1e-9))
+		if limit < 0 || fit < limit {
+			limit = max(fit, 0)
+		}
+	}
+
+	return limit
+}
+
+// addEllipsis ends a line with an ellipsis, removing the last characters of the line until it fits the width.
+func (s *text) addEllipsis(line, family string, width float64) string {
+	ellipsis := s.textToUnicode("…", family)
+
+	line = strings.TrimRight(line, " -")
+	for line != "" && s.pdf.GetStringWidth(line+ellipsis) >= width {
+		_, size := utf8.DecodeLastRuneInString(line)
+		line = strings.TrimRight(line[:len(line)-size], " ")
+	}
+
+	return line + ellipsis
+}
+
+// addRotated writes a text inside a cell rotated around the center of the cell,
+// the hyperlink covers the box which contains the rotated cell.
+func (s *text) addRotated(text string, cell *entity.Cell, textProp *props.Text) {
+	left, top, _, _ := s.pdf.GetMargins()
+	centerX, centerY := left+cell.X+cell.Width/2, top+cell.Y+cell.Height/2
+
+	prop := *textProp
+	prop.Rotation = 0
+	prop.Hyperlink = nil
+	if textProp.Hyperlink != nil {
+		prop.Color = &props.BlueColor
+	}
+
+	s.pdf.TransformBegin()
+	s.pdf.TransformRotate(textProp.Rotation, centerX, centerY)
+	s.Add(text, cell, &prop)
+	s.pdf.TransformEnd()
+
+	if textProp.Hyperlink != nil {
+		radians := textProp.Rotation * math.Pi / 180
+		sin, cos := math.Abs(math.Sin(radians)), math.Abs(math.Cos(radians))
+		width := cell.Width*cos + cell.Height*sin
+		height := cell.Width*sin + cell.Height*cos
+		s.pdf.LinkString(centerX-width/2, centerY-height/2, width, height, *textProp.Hyperlink)
+	}
+}
+
+// GetWidth retrieve the width of a text written in a single line.
+func (s *text) GetWidth(text string, prop *props.Font) float64 {
+	s.font.SetFont(prop.Family, prop.Style, prop.Size)
+	return s.pdf.GetStringWidth(s.textToUnicode(text, prop.Family))
+}
+
 // GetLinesQuantity retrieve the quantity of lines which a text will occupy to avoid that text to extrapolate a cell.
 func (s *text) GetLinesQuantity(text string, textProp *props.Text, colWidth float64) int {
 	s.font.SetFont(textProp.Family, textProp.Style, textProp.Size)
 
-	textTranslated := s.textToUnicode(text, textProp)
+	textTranslated := s.textToUnicode(text, textProp.Family)
 
 	if textProp.BreakLineStrategy == breakline.DashStrategy {
 		return len(s.getLinesBreakingLineWithDash(text, colWidth))
@@ -115,22 +231,236 @@ func (s *text) GetLinesQuantity(text string, textProp *props.Text, colWidth floa
 	}
 }
 
+// AddRich adds a paragraph composed by spans inside a cell, each span keeps its own font,
+// color, hyperlink and baseline shift while the words wrap together. A line break
+// inside a span value starts a new line.
+func (s *text) AddRich(spans []entity.Span, cell *entity.Cell, prop *props.RichText) {
+	if prop.Top > cell.Height {
+		prop.Top = cell.Height
+	}
+
+	if prop.Left > cell.Width {
+		prop.Left = cell.Width
+	}
+
+	if prop.Right > cell.Width {
+		prop.Right = cell.Width
+	}
+
+	width := cell.Width - prop.Left - prop.Right
+	if width < 0 {
+		width = 0
+	}
+
+	left, top, _, _ := s.pdf.GetMargins()
+	x := cell.X + prop.Left + left
+	y := cell.Y + prop.Top + top
+
+	originalColor := s.font.GetColor()
+
+	for _, line := range s.getRichLines(spans, width) {
+		s.addRichLine(line, prop.Align, x, width, y+line.ascent, originalColor)
+		y += line.ascent + line.descent + prop.VerticalPadding
+	}
+
+	s.font.SetColor(originalColor)
+}
+
+// GetRichHeight retrieve the height which a paragraph composed by spans will occupy,
+// each line is as high as its biggest span.
+func (s *text) GetRichHeight(spans []entity.Span, prop *props.RichText, colWidth float64) float64 {
+	lines := s.getRichLines(spans, colWidth)
+	if len(lines) == 0 {
+		return 0
+	}
+
+	height := float64(len(lines)-1) * prop.VerticalPadding
+	for _, line := range lines {
+		height += line.ascent + line.descent
+	}
+
+	return height
+}
+
+func (s *text) getRichLines(spans []entity.Span, colWidth float64) []richLine {
+	var lines []richLine
+	for _, segment := range s.getRichSegments(spans) {
+		if len(segment.words) == 0 {
+			fontHeight := s.font.GetHeight(segment.prop.Family, segment.prop.Style, segment.prop.Size)
+			lines = append(lines, richLine{ascent: fontHeight})
+			continue
+		}
+
+		widths := make([]float64, len(segment.words))
+		for i, word := range segment.words {
+			widths[i] = word.width + word.spaceWidth
+		}
+
+		for _, indexes := range breakLineFromSpace(widths, colWidth) {
+			if len(indexes) == 0 {
+				continue
+			}
+
+			line := richLine{}
+			for _, index := range indexes {
+				line.words = append(line.words, segment.words[index])
+				for _, fragment := range segment.words[index].fragments {
+					fontHeight := s.font.GetHeight(fragment.prop.Family, fragment.prop.Style, fragment.prop.Size)
+					line.ascent = max(line.ascent, fontHeight+max(fragment.prop.BaselineShift, 0))
+					line.descent = max(line.descent, -fragment.prop.BaselineShift)
+				}
+			}
+
+			lines = append(lines, line)
+		}
+	}
+
+	return lines
+}
+
+// getRichSegments splits the spans by line breaks and then by spaces, pieces of different
+// spans without a space between them are kept in the same word. A line break at the
+// end of the spans doesn't create an empty line.
+func (s *text) getRichSegments(spans []entity.Span) []richSegment {
+	var segments []richSegment
+	segment := richSegment{}
+	word := richWord{}
+
+	endWord := func() {
+		if len(word.fragments) > 0 {
+			word.spaceWidth = s.pdf.GetStringWidth(" ")
+			segment.words = append(segment.words, word)
+		}
+		word = richWord{}
+	}
+
+	for i := range spans {
+		prop := &spans[i].Prop
+		s.font.SetFont(prop.Family, prop.Style, prop.Size)
+
+		if segment.prop == nil {
+			segment.prop = prop
+		}
+
+		for j, content := range strings.Split(s.textToUnicode(spans[i].Value, prop.Family), "\n") {
+			if j > 0 {
+				endWord()
+				segments = append(segments, segment)
+				segment = richSegment{prop: prop}
+			}
+
+			for k, part := range strings.Split(content, " ") {
+				if k > 0 {
+					endWord()
+				}
+
+				if part == "" {
+					continue
+				}
+
+				width := s.pdf.GetStringWidth(part)
+				word.fragments = append(word.fragments, richFragment{text: part, prop: prop, width: width})
+				word.width += width
+			}
+		}
+	}
+
+	endWord()
+	if len(segment.words) > 0 {
+		segments = append(segments, segment)
+	}
+
+	return segments
+}
+
+func (s *text) addRichLine(line richLine, alignType align.Type, x, colWidth, baseline float64, color *props.Color) {
+	lineWidth := 0.0
+	for i, word := range line.words {
+		lineWidth += word.width
+		if i < len(line.words)-1 {
+			lineWidth += word.spaceWidth
+		}
+	}
+
+	extraSpace := 0.0
+	switch alignType {
+	case align.Right:
+		x += colWidth - lineWidth
+	case align.Center:
+		x += (colWidth - lineWidth) / 2
+	case align.Justify:
+		if len(line.words) > 1 {
+			extraSpace = (colWidth - lineWidth) / float64(len(line.words)-1)
+		}
+	}
+
+	for _, word := range line.words {
+		for _, fragment := range word.fragments {
+			s.addRichFragment(fragment, x, baseline, color)
+			x += fragment.width
+		}
+		x += word.spaceWidth + extraSpace
+	}
+}
+
+func (s *text) addRichFragment(fragment richFragment, x, baseline float64, color *props.Color) {
+	prop := fragment.prop
+	s.font.SetFont(prop.Family, prop.Style, prop.Size)
+
+	if prop.Color != nil {
+		color = prop.Color
+	}
+
+	// override style if hyperlink is set
+	if prop.Hyperlink != nil {
+		color = &props.BlueColor
+	}
+
+	s.font.SetColor(color)
+
+	y := baseline - prop.BaselineShift
+	s.pdf.Text(x, y, fragment.text)
+
+	if prop.Hyperlink != nil {
+		fontHeight := s.font.GetHeight(prop.Family, prop.Style, prop.Size)
+		s.pdf.LinkString(x, y-fontHeight, fragment.width, fontHeight, *prop.Hyperlink)
+	}
+}
+
 func (s *text) getLinesBreakingLineFromSpace(words []string, colWidth float64) []string {
+	widths := make([]float64, len(words))
+	for i, word := range words {
+		widths[i] = s.pdf.GetStringWidth(word + " ")
+	}
+
+	lines := []string{}
+	for _, indexes := range breakLineFromSpace(widths, colWidth) {
+		line := ""
+		for _, index := range indexes {
+			line += words[index] + " "
+		}
+		lines = append(lines, line)
+	}
+
+	return lines
+}
+
+// breakLineFromSpace groups words in lines that fit in colWidth, the width of each word
+// must include its trailing space. It returns the indexes of the words of each line.
+func breakLineFromSpace(widths []float64, colWidth float64) [][]int {
 	currentlySize := 0.0
 	actualLine := 0
 
-	lines := []string{}
-	lines = append(lines, "")
+	lines := [][]int{{}}
 
-	for _, word := range words {
-		if s.pdf.GetStringWidth(word+" ")+currentlySize < colWidth {
-			lines[actualLine] = lines[actualLine] + word + " "
-			currentlySize += s.pdf.GetStringWidth(word + " ")
+	for index, width := range widths {
+		if width+currentlySize < colWidth {
+			lines[actualLine] = append(lines[actualLine], index)
+			currentlySize += width
 		} else {
-			lines = append(lines, "")
+			lines = append(lines, []int{index})
 			actualLine++
-			lines[actualLine] = lines[actualLine] + word + " "
-			currentlySize = s.pdf.GetStringWidth(word + " ")
+			currentlySize = width
 		}
 	}
 
@@ -228,12 +558,8 @@ func (s *text) addLine(textProp *props.Text, xColOffset, colWidth, yColOffset, t
 	s.pdf.Text(dx+xColOffset+left, yColOffset+top, text)
 }
 
-func (s *text) textToUnicode(txt string, props *props.Text) string {
-	if props.Family == fontfamily.Arial ||
-		props.Family == fontfamily.Helvetica ||
-		props.Family == fontfamily.Symbol ||
-		props.Family == fontfamily.ZapBats ||
-		props.Family == fontfamily.Courier {
+func (s *text) textToUnicode(txt string, family string) string {
+	if isDefaultFamily(family) {
 		translator := s.pdf.UnicodeTranslatorFromDescriptor("")
 		return translator(txt)
 	}
@@ -5,13 +5,17 @@ import (
 	"testing"
 
 	"github.com/johnfercher/maroto/v2/internal/providers/gofpdf"
+	"github.com/johnfercher/maroto/v2/pkg/consts/align"
 	"github.com/johnfercher/maroto/v2/pkg/consts/breakline"
 	"github.com/johnfercher/maroto/v2/pkg/consts/fontfamily"
 	"github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
+	"github.com/johnfercher/maroto/v2/pkg/consts/overflow"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
 	"github.com/johnfercher/maroto/v2/pkg/props"
 
 	"github.com/johnfercher/maroto/v2/mocks"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func TestNewText(t *testing.T) {
@@ -60,3 +64,310 @@ func TestGetLinesheight(t *testing.T) {
 		assert.Equal(t, 2, height)
 	})
 }
+
+func TestText_GetWidth(t *testing.T) {
+	t.Run("should return the width of the text with the font", func(t *testing.T) {
+		// Arrange
+		prop := &props.Font{Family: fontfamily.Arial, Size: 10, Style: fontstyle.Normal}
+
+		font := mocks.NewFont(t)
+		font.EXPECT().SetFont(prop.Family, prop.Style, prop.Size)
+
+		pdf := mocks.NewFpdf(t)
+		pdf.EXPECT().UnicodeTranslatorFromDescriptor("").Return(func(s string) string { return s })
+		pdf.EXPECT().GetStringWidth("text").Return(7)
+
+		text := gofpdf.NewText(pdf, mocks.NewMath(t), font)
+
+		// Act
+		width := text.GetWidth("text", prop)
+
+		// Assert
+		assert.Equal(t, 7.0, width)
+	})
+}
+
+func TestText_GetRichHeight(t *testing.T) {
+	t.Run("when spans have different sizes and shifts, each line should be as high as its biggest span", func(t *testing.T) {
+		// Arrange
+		spans := []entity.Span{
+			{Value: "big ", Prop: props.Span{Family: fontfamily.Arial, Size: 10}},
+			{Value: "sup", Prop: props.Span{Family: fontfamily.Arial, Size: 5, BaselineShift: 3}},
+			{Value: " small", Prop: props.Span{Family: fontfamily.Arial, Size: 5, BaselineShift: -1}},
+		}
+
+		font := mocks.NewFont(t)
+		font.EXPECT().SetFont(mock.Anything, mock.Anything, mock.Anything)
+		font.EXPECT().GetHeight(fontfamily.Arial, fontstyle.Type(""), 10.0).Return(4)
+		font.EXPECT().GetHeight(fontfamily.Arial, fontstyle.Type(""), 5.0).Return(2)
+
+		pdf := mocks.NewFpdf(t)
+		pdf.EXPECT().GetStringWidth(" ").Return(1)
+		pdf.EXPECT().GetStringWidth(mock.Anything).Return(5)
+		pdf.EXPECT().UnicodeTranslatorFromDescriptor("").Return(func(s string) string { return s })
+
+		sut := gofpdf.NewText(pdf, mocks.NewMath(t), font)
+
+		// Act
+		height := sut.GetRichHeight(spans, &props.RichText{VerticalPadding: 1}, 13)
+
+		// Assert
+		assert.Equal(t, 9.0, height)
+	})
+	t.Run("when spans have line breaks, should start new lines", func(t *testing.T) {
+		// Arrange
+		spans := []entity.Span{
+			{Value: "a\n\nb", Prop: props.Span{Family: fontfamily.Arial, Size: 10}},
+			{Value: " c\n", Prop: props.Span{Family: fontfamily.Arial, Size: 5}},
+		}
+
+		font := mocks.NewFont(t)
+		font.EXPECT().SetFont(mock.Anything, mock.Anything, mock.Anything)
+		font.EXPECT().GetHeight(fontfamily.Arial, fontstyle.Type(""), 10.0).Return(4)
+		font.EXPECT().GetHeight(fontfamily.Arial, fontstyle.Type(""), 5.0).Return(2)
+
+		pdf := mocks.NewFpdf(t)
+		pdf.EXPECT().GetStringWidth(mock.Anything).Return(1)
+		pdf.EXPECT().UnicodeTranslatorFromDescriptor("").Return(func(s string) string { return s })
+
+		sut := gofpdf.NewText(pdf, mocks.NewMath(t), font)
+
+		// Act
+		height := sut.GetRichHeight(spans, &props.RichText{}, 100)
+
+		// Assert
+		assert.Equal(t, 12.0, height)
+	})
+	t.Run("when spans are empty, should return zero", func(t *testing.T) {
+		// Arrange
+		sut := gofpdf.NewText(mocks.NewFpdf(t), mocks.NewMath(t), mocks.NewFont(t))
+
+		// Act
+		height := sut.GetRichHeight(nil, &props.RichText{VerticalPadding: 1}, 13)
+
+		// Assert
+		assert.Zero(t, height)
+	})
+}
+
+func TestText_AddRich(t *testing.T) {
+	t.Run("when span has hyperlink, should write it aligned and add a link", func(t *testing.T) {
+		// Arrange
+		link := "https://github.com/johnfercher/maroto"
+		spans := []entity.Span{
+			{Value: "link", Prop: props.Span{Family: fontfamily.Arial, Size: 10, Hyperlink: &link}},
+		}
+		cell := &entity.Cell{X: 10, Y: 15, Width: 100, Height: 20}
+		color := &props.Color{}
+
+		font := mocks.NewFont(t)
+		font.EXPECT().SetFont(fontfamily.Arial, fontstyle.Type(""), 10.0)
+		font.EXPECT().GetHeight(fontfamily.Arial, fontstyle.Type(""), 10.0).Return(4)
+		font.EXPECT().GetColor().Return(color)
+		font.EXPECT().SetColor(&props.BlueColor)
+		font.EXPECT().SetColor(color)
+
+		pdf := mocks.NewFpdf(t)
+		pdf.EXPECT().GetMargins().Return(10, 10, 10, 10)
+		pdf.EXPECT().GetStringWidth("link").Return(8)
+		pdf.EXPECT().GetStringWidth(" ").Return(1)
+		pdf.EXPECT().UnicodeTranslatorFromDescriptor("").Return(func(s string) string { return s })
+		pdf.EXPECT().Text(66.0, 29.0, "link")
+		pdf.EXPECT().LinkString(66.0, 25.0, 8.0, 4.0, link)
+
+		sut := gofpdf.NewText(pdf, mocks.NewMath(t), font)
+
+		// Act
+		sut.AddRich(spans, cell, &props.RichText{Align: align.Center})
+
+		// Assert
+		pdf.AssertNumberOfCalls(t, "Text", 1)
+		pdf.AssertNumberOfCalls(t, "LinkString", 1)
+		font.AssertNumberOfCalls(t, "SetColor", 2)
+	})
+	t.Run("when spans have line breaks, should write the words after them in new lines", func(t *testing.T) {
+		// Arrange
+		spans := []entity.Span{
+			{Value: "first\n\nthird", Prop: props.Span{Family: fontfamily.Arial, Size: 10}},
+			{Value: " end\n", Prop: props.Span{Family: fontfamily.Arial, Size: 10}},
+		}
+		cell := &entity.Cell{X: 10, Y: 15, Width: 100, Height: 20}
+		color := &props.Color{}
+
+		font := mocks.NewFont(t)
+		font.EXPECT().SetFont(fontfamily.Arial, fontstyle.Type(""), 10.0)
+		font.EXPECT().GetHeight(fontfamily.Arial, fontstyle.Type(""), 10.0).Return(4)
+		font.EXPECT().GetColor().Return(color)
+		font.EXPECT().SetColor(color)
+
+		pdf := mocks.NewFpdf(t)
+		pdf.EXPECT().GetMargins().Return(10, 10, 10, 10)
+		pdf.EXPECT().GetStringWidth(mock.Anything).Return(5)
+		pdf.EXPECT().UnicodeTranslatorFromDescriptor("").Return(func(s string) string { return s })
+		pdf.EXPECT().Text(20.0, 29.0, "first")
+		pdf.EXPECT().Text(20.0, 37.0, "third")
+		pdf.EXPECT().Text(30.0, 37.0, "end")
+
+		sut := gofpdf.NewText(pdf, mocks.NewMath(t), font)
+
+		// Act
+		sut.AddRich(spans, cell, &props.RichText{Align: align.Left})
+
+		// Assert
+		pdf.AssertNumberOfCalls(t, "Text", 3)
+	})
+}
+
+func TestText_Add(t *testing.T) {
+	t.Run("when text is rotated, should write it rotated around the center of the cell", func(t *testing.T) {
+		// Arrange
+		cell := &entity.Cell{X: 10, Y: 15, Width: 30, Height: 4}
+		prop := &props.Text{Family: fontfamily.Arial, Size: 10, Align: align.Left, Rotation: 90}
+		color := &props.Color{}
+
+		font := mocks.NewFont(t)
+		font.EXPECT().SetFont(fontfamily.Arial, fontstyle.Type(""), 10.0)
+		font.EXPECT().GetHeight(fontfamily.Arial, fontstyle.Type(""), 10.0).Return(4)
+		font.EXPECT().GetColor().Return(color)
+
+		pdf := mocks.NewFpdf(t)
+		pdf.EXPECT().GetMargins().Return(10, 10, 10, 10)
+		pdf.EXPECT().TransformBegin()
+		pdf.EXPECT().TransformRotate(90.0, 35.0, 27.0)
+		pdf.EXPECT().UnicodeTranslatorFromDescriptor("").Return(func(s string) string { return s })
+		pdf.EXPECT().GetStringWidth("up").Return(8)
+		pdf.EXPECT().Text(20.0, 29.0, "up")
+		pdf.EXPECT().TransformEnd()
+
+		sut := gofpdf.NewText(pdf, mocks.NewMath(t), font)
+
+		// Act
+		sut.Add("up", cell, prop)
+
+		// Assert
+		pdf.AssertNumberOfCalls(t, "TransformRotate", 1)
+		pdf.AssertNumberOfCalls(t, "Text", 1)
+		assert.Equal(t, 90.0, prop.Rotation)
+	})
+	t.Run("when rotated text has hyperlink, should add the link over the rotated cell", func(t *testing.T) {
+		// Arrange
+		link := "https://github.com/johnfercher/maroto"
+		cell := &entity.Cell{X: 10, Y: 15, Width: 30, Height: 4}
+		prop := &props.Text{Family: fontfamily.Arial, Size: 10, Align: align.Left, Rotation: 90, Hyperlink: &link}
+		color := &props.Color{}
+
+		font := mocks.NewFont(t)
+		font.EXPECT().SetFont(fontfamily.Arial, fontstyle.Type(""), 10.0)
+		font.EXPECT().GetHeight(fontfamily.Arial, fontstyle.Type(""), 10.0).Return(4)
+		font.EXPECT().GetColor().Return(color)
+		font.EXPECT().SetColor(&props.BlueColor)
+		font.EXPECT().SetColor(color)
+
+		pdf := mocks.NewFpdf(t)
+		pdf.EXPECT().GetMargins().Return(10, 10, 10, 10)
+		pdf.EXPECT().TransformBegin()
+		pdf.EXPECT().TransformRotate(90.0, 35.0, 27.0)
+		pdf.EXPECT().UnicodeTranslatorFromDescriptor("").Return(func(s string) string { return s })
+		pdf.EXPECT().GetStringWidth("up").Return(8)
+		pdf.EXPECT().Text(20.0, 29.0, "up")
+		pdf.EXPECT().TransformEnd()
+		pdf.EXPECT().LinkString(mock.Anything, mock.Anything, mock.Anything, mock.Anything, link)
+
+		sut := gofpdf.NewText(pdf, mocks.NewMath(t), font)
+
+		// Act
+		sut.Add("up", cell, prop)
+
+		// Assert
+		pdf.AssertNumberOfCalls(t, "LinkString", 1)
+		args := pdf.Calls[len(pdf.Calls)-1].Arguments
+		assert.InDelta(t, 33.0, args.Get(0), 1e-9)
+		assert.InDelta(t, 12.0, args.Get(1), 1e-9)
+		assert.InDelta(t, 4.0, args.Get(2), 1e-9)
+		assert.InDelta(t, 30.0, args.Get(3), 1e-9)
+	})
+}
+
+func TestText_Add_Overflow(t *testing.T) {
+	t.Run("when text has more lines than max lines with ellipsis, should end the last line with an ellipsis", func(t *testing.T) {
+		// Arrange
+		cell := &entity.Cell{Width: 11, Height: 100}
+		prop := &props.Text{
+			Family: fontfamily.Arial, Size: 10, Align: align.Left, BreakLineStrategy: breakline.EmptySpaceStrategy,
+			MaxLines: 1, Overflow: overflow.Ellipsis,
+		}
+
+		font := mocks.NewFont(t)
+		font.EXPECT().SetFont(fontfamily.Arial, fontstyle.Type(""), 10.0)
+		font.EXPECT().GetHeight(fontfamily.Arial, fontstyle.Type(""), 10.0).Return(4)
+		font.EXPECT().GetColor().Return(&props.Color{})
+
+		pdf := mocks.NewFpdf(t)
+		pdf.EXPECT().GetMargins().Return(0, 0, 0, 0)
+		pdf.EXPECT().UnicodeTranslatorFromDescriptor("").Return(func(s string) string { return s })
+		pdf.EXPECT().GetStringWidth("text text text").Return(15)
+		pdf.EXPECT().GetStringWidth("text ").Return(5)
+		pdf.EXPECT().GetStringWidth("text text…").Return(11)
+		pdf.EXPECT().GetStringWidth("text tex…").Return(10)
+		pdf.EXPECT().Text(0.0, 4.0, "text tex…")
+
+		sut := gofpdf.NewText(pdf, mocks.NewMath(t), font)
+
+		// Act
+		sut.Add("text text text", cell, prop)
+
+		// Assert
+		pdf.AssertNumberOfCalls(t, "Text", 1)
+	})
+	t.Run("when text has more lines than the cell with clip, should write only the lines which fit", func(t *testing.T) {
+		// Arrange
+		cell := &entity.Cell{Width: 11, Height: 9}
+		prop := &props.Text{
+			Family: fontfamily.Arial, Size: 10, Align: align.Left, BreakLineStrategy: breakline.EmptySpaceStrategy,
+			VerticalPadding: 1, Overflow: overflow.Clip,
+		}
+
+		font := mocks.NewFont(t)
+		font.EXPECT().SetFont(fontfamily.Arial, fontstyle.Type(""), 10.0)
+		font.EXPECT().GetHeight(fontfamily.Arial, fontstyle.Type(""), 10.0).Return(4)
+		font.EXPECT().GetColor().Return(&props.Color{})
+
+		pdf := mocks.NewFpdf(t)
+		pdf.EXPECT().GetMargins().Return(0, 0, 0, 0)
+		pdf.EXPECT().UnicodeTranslatorFromDescriptor("").Return(func(s string) string { return s })
+		pdf.EXPECT().GetStringWidth("a b c d e f").Return(21)
+		pdf.EXPECT().GetStringWidth(mock.Anything).Return(5)
+		pdf.EXPECT().Text(0.0, 4.0, "a b ")
+		pdf.EXPECT().Text(0.0, 9.0, "c d ")
+
+		sut := gofpdf.NewText(pdf, mocks.NewMath(t), font)
+
+		// Act
+		sut.Add("a b c d e f", cell, prop)
+
+		// Assert
+		pdf.AssertNumberOfCalls(t, "Text", 2)
+	})
+	t.Run("when a single line doesn't fit the cell with clip, should not write it", func(t *testing.T) {
+		// Arrange
+		cell := &entity.Cell{Width: 11, Height: 3}
+		prop := &props.Text{Family: fontfamily.Arial, Size: 10, Align: align.Left, Overflow: overflow.Clip}
+
+		font := mocks.NewFont(t)
+		font.EXPECT().SetFont(fontfamily.Arial, fontstyle.Type(""), 10.0)
+		font.EXPECT().GetHeight(fontfamily.Arial, fontstyle.Type(""), 10.0).Return(4)
+		font.EXPECT().GetColor().Return(&props.Color{})
+
+		pdf := mocks.NewFpdf(t)
+		pdf.EXPECT().UnicodeTranslatorFromDescriptor("").Return(func(s string) string { return s })
+		pdf.EXPECT().GetStringWidth("text").Return(4)
+
+		sut := gofpdf.NewText(pdf, mocks.NewMath(t), font)
+
+		// Act
+		sut.Add("text", cell, prop)
+
+		// Assert
+		pdf.AssertNotCalled(t, "Text")
+	})
+}
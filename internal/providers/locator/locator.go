@@ -0,0 +1,220 @@
+// Package locator implements a provider which draws nothing, used to find the pages where the
+// anchors of the document are rendered before the document is generated, the headings listed
+// by the tables of contents, and the footnotes referred by a row before it is added to a page.
+package locator
+
+import (
+	"sort"
+
+	"github.com/johnfercher/maroto/v2/pkg/consts/extension"
+	"github.com/johnfercher/maroto/v2/pkg/consts/matrixcode"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+// Locator is a core.Provider which only records the page of the anchors and the headings, the sizes of the
+// components are measured by the provider which generates the document, so the pages are
+// rendered with the same layout.
+type Locator struct {
+	provider   core.Provider
+	page       int
+	anchors    map[string]int
+	references map[string]bool
+	footnotes  []*entity.Footnote
+	headings   []*entity.Heading
+}
+
+// New is responsible to create a Locator which measures with the provider.
+func New(provider core.Provider) *Locator {
+	return &Locator{
+		provider:   provider,
+		anchors:    make(map[string]int),
+		references: make(map[string]bool),
+	}
+}
+
+// SetPage sets the number of the page being rendered.
+func (l *Locator) SetPage(page int) {
+	l.page = page
+}
+
+// GetAnchors returns the page of every anchor rendered.
+func (l *Locator) GetAnchors() map[string]int {
+	return l.anchors
+}
+
+// AddAnchors records the anchors and the headings found by another Locator in the current page.
+func (l *Locator) AddAnchors(other *Locator) {
+	for name := range other.anchors {
+		l.anchors[name] = l.page
+	}
+
+	for _, heading := range other.headings {
+		l.AddHeading(heading)
+	}
+}
+
+// GetFootnotes returns the footnotes referred by the components rendered, in the order they are referred.
+func (l *Locator) GetFootnotes() []*entity.Footnote {
+	return l.footnotes
+}
+
+// GetUnknownAnchors returns the sorted names of the anchors referred which were not rendered.
+func (l *Locator) GetUnknownAnchors() []string {
+	var unknown []string
+	for name := range l.references {
+		if _, ok := l.anchors[name]; !ok {
+			unknown = append(unknown, name)
+		}
+	}
+
+	sort.Strings(unknown)
+	return unknown
+}
+
+// Resolve returns the provider which generates the document, knowing the page of every anchor
+// even before it is rendered.
+func (l *Locator) Resolve() core.Provider {
+	return &resolved{
+		Provider: l.provider,
+		anchors:  l.anchors,
+		headings: l.headings,
+	}
+}
+
+// AddAnchor records the page of the anchor.
+func (l *Locator) AddAnchor(name string, _ *entity.Cell) {
+	l.anchors[name] = l.page
+}
+
+// AddInternalLink records the reference to the anchor.
+func (l *Locator) AddInternalLink(name string, _ *entity.Cell) {
+	l.references[name] = true
+}
+
+// GetAnchorPage records the reference to the anchor and returns its page when it was already rendered.
+func (l *Locator) GetAnchorPage(name string) (int, bool) {
+	l.references[name] = true
+	page, ok := l.anchors[name]
+	return page, ok
+}
+
+// AddFootnote records the footnote.
+func (l *Locator) AddFootnote(footnote *entity.Footnote) {
+	l.footnotes = append(l.footnotes, footnote)
+}
+
+// AddHeading records the heading in the order it is rendered, a heading already recorded is kept
+// in its place, so the headings known before the document is built again keep their order.
+func (l *Locator) AddHeading(heading *entity.Heading) {
+	for _, known := range l.headings {
+		if known.Anchor == heading.Anchor {
+			return
+		}
+	}
+
+	l.headings = append(l.headings, heading)
+}
+
+// GetHeadings returns the headings rendered, in the order they are rendered.
+func (l *Locator) GetHeadings() []*entity.Heading {
+	return l.headings
+}
+
+func (l *Locator) GetFontHeight(prop *props.Font) float64 {
+	return l.provider.GetFontHeight(prop)
+}
+
+func (l *Locator) GetTextWidth(text string, prop *props.Font) float64 {
+	return l.provider.GetTextWidth(text, prop)
+}
+
+func (l *Locator) GetLinesQuantity(text string, textProp *props.Text, colWidth float64) int {
+	return l.provider.GetLinesQuantity(text, textProp, colWidth)
+}
+
+func (l *Locator) GetRichTextHeight(spans []entity.Span, prop *props.RichText, colWidth float64) float64 {
+	return l.provider.GetRichTextHeight(spans, prop, colWidth)
+}
+
+func (l *Locator) GetDimensionsByImageByte(bytes []byte, extension extension.Type, frame int) (*entity.Dimensions, error) {
+	return l.provider.GetDimensionsByImageByte(bytes, extension, frame)
+}
+
+func (l *Locator) GetDimensionsByImage(file string, frame int) (*entity.Dimensions, error) {
+	return l.provider.GetDimensionsByImage(file, frame)
+}
+
+func (l *Locator) GetDimensionsByQrCode(code string) (*entity.Dimensions, error) {
+	return l.provider.GetDimensionsByQrCode(code)
+}
+
+func (l *Locator) GetDimensionsByMatrixCode(code string, symbology matrixcode.Symbology) (*entity.Dimensions, error) {
+	return l.provider.GetDimensionsByMatrixCode(code, symbology)
+}
+
+func (l *Locator) CreateRow(float64) {}
+
+func (l *Locator) CreateCol(float64, float64, *entity.Config, *props.Cell) {}
+
+func (l *Locator) OpenGrid(*entity.Cell) {}
+
+func (l *Locator) CloseGrid() {}
+
+func (l *Locator) AddLine(*entity.Cell, *props.Line) {}
+
+func (l *Locator) AddPolygon([]entity.Point, *props.Shape) {}
+
+func (l *Locator) AddPolyline([]entity.Point, *props.Shape) {}
+
+func (l *Locator) AddRectangle(*entity.Cell, *props.Shape) {}
+
+func (l *Locator) AddEllipse(*entity.Cell, *props.Shape) {}
+
+func (l *Locator) AddText(string, *entity.Cell, *props.Text) {}
+
+func (l *Locator) AddRichText([]entity.Span, *entity.Cell, *props.RichText) {}
+
+func (l *Locator) AddImageFromFile(string, *entity.Cell, *props.Rect) {}
+
+func (l *Locator) AddImageFromBytes([]byte, *entity.Cell, *props.Rect, extension.Type) {}
+
+func (l *Locator) AddBackgroundImageFromBytes([]byte, *entity.Cell, *props.Rect, extension.Type) {}
+
+func (l *Locator) AddQrCode(string, *entity.Cell, *props.Rect) {}
+
+func (l *Locator) AddBarCode(string, *entity.Cell, *props.Barcode) {}
+
+func (l *Locator) AddMatrixCode(string, matrixcode.Symbology, *entity.Cell, *props.Rect) {}
+
+func (l *Locator) AddBookmark(string, int, *entity.Cell) {}
+
+func (l *Locator) AddWatermark(*entity.Watermark, *entity.Cell) {}
+
+func (l *Locator) GenerateBytes() ([]byte, error) {
+	return nil, nil
+}
+
+func (l *Locator) SetProtection(*entity.Protection) {}
+
+func (l *Locator) SetCompression(bool) {}
+
+func (l *Locator) SetMetadata(*entity.Metadata) {}
+
+// resolved is the provider which generates the document, answering the pages of the anchors
+// and the headings found by the Locator.
+type resolved struct {
+	core.Provider
+	anchors  map[string]int
+	headings []*entity.Heading
+}
+
+func (r *resolved) GetAnchorPage(name string) (int, bool) {
+	page, ok := r.anchors[name]
+	return page, ok
+}
+
+func (r *resolved) GetHeadings() []*entity.Heading {
+	return r.headings
+}
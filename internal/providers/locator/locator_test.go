@@ -0,0 +1,192 @@
+package locator_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/internal/providers/locator"
+	"github.com/johnfercher/maroto/v2/mocks"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+)
+
+func TestNew(t *testing.T) {
+	// Act
+	sut := locator.New(mocks.NewProvider(t))
+
+	// Assert
+	assert.NotNil(t, sut)
+	assert.Equal(t, "*locator.Locator", fmt.Sprintf("%T", sut))
+}
+
+func TestLocator_AddAnchor(t *testing.T) {
+	t.Run("should record the page where the anchor is rendered", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		sut := locator.New(mocks.NewProvider(t))
+
+		// Act
+		sut.SetPage(1)
+		sut.AddAnchor("intro", &cell)
+		_, foundBefore := sut.GetAnchorPage("scope")
+		sut.SetPage(3)
+		sut.AddAnchor("scope", &cell)
+		page, found := sut.GetAnchorPage("scope")
+
+		// Assert
+		assert.False(t, foundBefore)
+		assert.True(t, found)
+		assert.Equal(t, 3, page)
+		assert.Equal(t, map[string]int{"intro": 1, "scope": 3}, sut.GetAnchors())
+	})
+}
+
+func TestLocator_AddAnchors(t *testing.T) {
+	t.Run("should record the anchors of the other locator in the current page", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		other := locator.New(mocks.NewProvider(t))
+		other.AddAnchor("intro", &cell)
+		sut := locator.New(mocks.NewProvider(t))
+
+		// Act
+		sut.SetPage(4)
+		sut.AddAnchors(other)
+
+		// Assert
+		assert.Equal(t, map[string]int{"intro": 4}, sut.GetAnchors())
+	})
+	t.Run("should record the headings of the other locator after the known ones", func(t *testing.T) {
+		// Arrange
+		intro := &entity.Heading{Title: "Introduction", Anchor: "intro"}
+		scope := &entity.Heading{Title: "Scope", Anchor: "scope"}
+		other := locator.New(mocks.NewProvider(t))
+		other.AddHeading(intro)
+		other.AddHeading(scope)
+		sut := locator.New(mocks.NewProvider(t))
+		sut.AddHeading(scope)
+
+		// Act
+		sut.AddAnchors(other)
+
+		// Assert
+		assert.Equal(t, []*entity.Heading{scope, intro}, sut.GetHeadings())
+	})
+}
+
+func TestLocator_AddHeading(t *testing.T) {
+	t.Run("should record the headings once in the order they are rendered", func(t *testing.T) {
+		// Arrange
+		intro := &entity.Heading{Title: "Introduction", Anchor: "intro"}
+		scope := &entity.Heading{Title: "Scope", Level: 1, Anchor: "scope"}
+		sut := locator.New(mocks.NewProvider(t))
+
+		// Act
+		sut.AddHeading(intro)
+		sut.AddHeading(scope)
+		sut.AddHeading(&entity.Heading{Title: "Introduction", Anchor: "intro"})
+
+		// Assert
+		assert.Equal(t, []*entity.Heading{intro, scope}, sut.GetHeadings())
+	})
+}
+
+func TestLocator_AddFootnote(t *testing.T) {
+	t.Run("should record the footnotes in the order they are referred", func(t *testing.T) {
+		// Arrange
+		first := &entity.Footnote{Value: "first"}
+		second := &entity.Footnote{Value: "second"}
+		sut := locator.New(mocks.NewProvider(t))
+
+		// Act
+		sut.AddFootnote(first)
+		sut.AddFootnote(second)
+
+		// Assert
+		assert.Equal(t, []*entity.Footnote{first, second}, sut.GetFootnotes())
+	})
+}
+
+func TestLocator_Render(t *testing.T) {
+	t.Run("should measure with the provider and draw nothing", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		font := fixture.FontProp()
+		text := fixture.TextProp()
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().GetFontHeight(&font).Return(5.0)
+		provider.EXPECT().GetTextWidth("text", &font).Return(7.0)
+		provider.EXPECT().GetLinesQuantity("text", &text, 10.0).Return(2)
+
+		sut := locator.New(provider)
+
+		// Act
+		sut.CreateCol(10, 10, &entity.Config{}, nil)
+		sut.AddText("text", &cell, &text)
+		sut.AddInternalLink("intro", &cell)
+		height := sut.GetFontHeight(&font)
+		width := sut.GetTextWidth("text", &font)
+		lines := sut.GetLinesQuantity("text", &text, 10)
+
+		// Assert
+		assert.Equal(t, 5.0, height)
+		assert.Equal(t, 7.0, width)
+		assert.Equal(t, 2, lines)
+	})
+}
+
+func TestLocator_Resolve(t *testing.T) {
+	t.Run("should return the provider which knows the page of every anchor", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().AddAnchor("intro", &cell)
+
+		sut := locator.New(provider)
+		sut.SetPage(2)
+		sut.AddAnchor("intro", &cell)
+
+		// Act
+		resolved := sut.Resolve()
+		page, found := resolved.GetAnchorPage("intro")
+		resolved.AddAnchor("intro", &cell)
+
+		// Assert
+		assert.True(t, found)
+		assert.Equal(t, 2, page)
+		provider.AssertNumberOfCalls(t, "AddAnchor", 1)
+	})
+	t.Run("should return the provider which knows the headings", func(t *testing.T) {
+		// Arrange
+		heading := &entity.Heading{Title: "Introduction", Anchor: "intro"}
+		sut := locator.New(mocks.NewProvider(t))
+		sut.AddHeading(heading)
+
+		// Act
+		resolved := sut.Resolve()
+
+		// Assert
+		assert.Equal(t, []*entity.Heading{heading}, resolved.GetHeadings())
+	})
+}
+
+func TestLocator_GetUnknownAnchors(t *testing.T) {
+	t.Run("should return the anchors referred which were not rendered", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		sut := locator.New(mocks.NewProvider(t))
+
+		// Act
+		sut.AddInternalLink("summary", &cell)
+		sut.GetAnchorPage("scope")
+		sut.AddInternalLink("details", &cell)
+		sut.AddAnchor("summary", &cell)
+
+		// Assert
+		assert.Equal(t, []string{"details", "scope"}, sut.GetUnknownAnchors())
+	})
+}
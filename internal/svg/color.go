@@ -0,0 +1,136 @@
+package svg
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+var namedColors = map[string]props.Color{
+	"black":   {Red: 0, Green: 0, Blue: 0},
+	"white":   {Red: 255, Green: 255, Blue: 255},
+	"red":     {Red: 255, Green: 0, Blue: 0},
+	"green":   {Red: 0, Green: 128, Blue: 0},
+	"lime":    {Red: 0, Green: 255, Blue: 0},
+	"blue":    {Red: 0, Green: 0, Blue: 255},
+	"yellow":  {Red: 255, Green: 255, Blue: 0},
+	"cyan":    {Red: 0, Green: 255, Blue: 255},
+	"aqua":    {Red: 0, Green: 255, Blue: 255},
+	"magenta": {Red: 255, Green: 0, Blue: 255},
+	"fuchsia": {Red: 255, Green: 0, Blue: 255},
+	"gray":    {Red: 128, Green: 128, Blue: 128},
+	"grey":    {Red: 128, Green: 128, Blue: 128},
+	"silver":  {Red: 192, Green: 192, Blue: 192},
+	"maroon":  {Red: 128, Green: 0, Blue: 0},
+	"olive":   {Red: 128, Green: 128, Blue: 0},
+	"navy":    {Red: 0, Green: 0, Blue: 128},
+	"purple":  {Red: 128, Green: 0, Blue: 128},
+	"teal":    {Red: 0, Green: 128, Blue: 128},
+	"orange":  {Red: 255, Green: 165, Blue: 0},
+}
+
+// parseColor parses a color like #rgb, #rrggbb, rgb(r, g, b), rgba(r, g, b, a) or a color name,
+// it returns the color and its alpha.
+func parseColor(value string) (props.Color, float64, bool) {
+	value = strings.ToLower(strings.TrimSpace(value))
+
+	if color, ok := namedColors[value]; ok {
+		return color, 1, true
+	}
+
+	if strings.HasPrefix(value, "#") {
+		return parseHexColor(value[1:])
+	}
+
+	for _, prefix := range []string{"rgb(", "rgba("} {
+		if strings.HasPrefix(value, prefix) && strings.HasSuffix(value, ")") {
+			return parseRGBColor(value[len(prefix) : len(value)-1])
+		}
+	}
+
+	return props.Color{}, 0, false
+}
+
+func parseHexColor(hex string) (props.Color, float64, bool) {
+	if len(hex) == 3 || len(hex) == 4 {
+		expanded := make([]byte, 0, len(hex)*2)
+		for i := 0; i < len(hex); i++ {
+			expanded = append(expanded, hex[i], hex[i])
+		}
+		hex = string(expanded)
+	}
+
+	if len(hex) == 6 {
+		hex += "ff"
+	}
+
+	if len(hex) != 8 {
+		return props.Color{}, 0, false
+	}
+
+	n, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil {
+		return props.Color{}, 0, false
+	}
+
+	color := props.Color{Red: int(n >> 24 & 0xff), Green: int(n >> 16 & 0xff), Blue: int(n >> 8 & 0xff)}
+	return color, float64(n&0xff) / 255, true
+}
+
+func parseRGBColor(value string) (props.Color, float64, bool) {
+	parts := strings.Split(value, ",")
+	if len(parts) != 3 && len(parts) != 4 {
+		return props.Color{}, 0, false
+	}
+
+	channels := make([]float64, len(parts))
+	for i, part := range parts {
+		n, ok := parseChannel(part, i == 3)
+		if !ok {
+			return props.Color{}, 0, false
+		}
+		channels[i] = n
+	}
+
+	alpha := 1.0
+	if len(channels) == 4 {
+		alpha = channels[3]
+	}
+
+	color := props.Color{Red: int(channels[0]), Green: int(channels[1]), Blue: int(channels[2])}
+	return color, alpha, true
+}
+
+// parseChannel parses a color channel from 0 to 255, or the alpha from 0 to 1, both may be a percentage.
+func parseChannel(value string, alpha bool) (float64, bool) {
+	value = strings.TrimSpace(value)
+	percent := strings.HasSuffix(value, "%")
+
+	n, err := strconv.ParseFloat(strings.TrimSuffix(value, "%"), 64)
+	if err != nil {
+		return 0, false
+	}
+
+	highest := 255.0
+	if alpha {
+		highest = 1
+	}
+
+	if percent {
+		n = n * highest / 100
+	}
+
+	n = math.Min(math.Max(n, 0), highest)
+	if !alpha {
+		n = math.Round(n)
+	}
+
+	return n, true
+}
+
+// parseOpacity parses an opacity from 0 to 1, or a percentage.
+func parseOpacity(value string) (float64, bool) {
+	return parseChannel(value, true)
+}
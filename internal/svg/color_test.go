@@ -0,0 +1,44 @@
+package svg
+
+import (
+	"testing"
+
+	"github.com/johnfercher/maroto/v2/pkg/props"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseColor(t *testing.T) {
+	cases := []struct {
+		value string
+		color props.Color
+		alpha float64
+	}{
+		{value: "Red", color: props.Color{Red: 255}, alpha: 1},
+		{value: "#0f8", color: props.Color{Green: 255, Blue: 136}, alpha: 1},
+		{value: "#102030", color: props.Color{Red: 16, Green: 32, Blue: 48}, alpha: 1},
+		{value: "#10203000", color: props.Color{Red: 16, Green: 32, Blue: 48}, alpha: 0},
+		{value: "rgb(10, 20, 300)", color: props.Color{Red: 10, Green: 20, Blue: 255}, alpha: 1},
+		{value: "rgb(100%, 0%, 50%)", color: props.Color{Red: 255, Blue: 128}, alpha: 1},
+		{value: "rgba(10, 20, 30, 0.5)", color: props.Color{Red: 10, Green: 20, Blue: 30}, alpha: 0.5},
+	}
+
+	for _, c := range cases {
+		t.Run(c.value, func(t *testing.T) {
+			// Act
+			color, alpha, ok := parseColor(c.value)
+
+			// Assert
+			assert.True(t, ok)
+			assert.Equal(t, c.color, color)
+			assert.Equal(t, c.alpha, alpha)
+		})
+	}
+
+	t.Run("when color is invalid, should return false", func(t *testing.T) {
+		// Act
+		_, _, ok := parseColor("#12345")
+
+		// Assert
+		assert.False(t, ok)
+	})
+}
@@ -0,0 +1,427 @@
+package svg
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// scanner reads the numbers and commands of a path, numbers may be separated by spaces, commas or signs.
+type scanner struct {
+	value string
+	pos   int
+}
+
+func (s *scanner) skipSeparators() {
+	for s.pos < len(s.value) {
+		switch s.value[s.pos] {
+		case ' ', '\t', '\r', '\n', ',':
+			s.pos++
+		default:
+			return
+		}
+	}
+}
+
+func (s *scanner) done() bool {
+	s.skipSeparators()
+	return s.pos >= len(s.value)
+}
+
+// hasNumber returns if the next token is a number, instead of a command.
+func (s *scanner) hasNumber() bool {
+	if s.done() {
+		return false
+	}
+
+	c := s.value[s.pos]
+	return c == '-' || c == '+' || c == '.' || (c >= '0' && c <= '9')
+}
+
+func (s *scanner) number() (float64, error) {
+	s.skipSeparators()
+	start := s.pos
+
+	if s.pos < len(s.value) && (s.value[s.pos] == '-' || s.value[s.pos] == '+') {
+		s.pos++
+	}
+
+	dot, exponent := false, false
+	for ; s.pos < len(s.value); s.pos++ {
+		c := s.value[s.pos]
+		switch {
+		case c >= '0' && c <= '9':
+		case c == '.' && !dot && !exponent:
+			dot = true
+		case (c == 'e' || c == 'E') && !exponent && s.pos > start:
+			exponent = true
+			if s.pos+1 < len(s.value) && (s.value[s.pos+1] == '-' || s.value[s.pos+1] == '+') {
+				s.pos++
+			}
+		default:
+			return s.parse(start)
+		}
+	}
+
+	return s.parse(start)
+}
+
+func (s *scanner) parse(start int) (float64, error) {
+	n, err := strconv.ParseFloat(s.value[start:s.pos], 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid number at %d of %q", start, s.value)
+	}
+
+	return n, nil
+}
+
+// flag reads an arc flag, which is a single digit that may not be separated from the next number.
+func (s *scanner) flag() (bool, error) {
+	s.skipSeparators()
+	if s.pos >= len(s.value) || (s.value[s.pos] != '0' && s.value[s.pos] != '1') {
+		return false, fmt.Errorf("invalid flag at %d of %q", s.pos, s.value)
+	}
+
+	s.pos++
+	return s.value[s.pos-1] == '1', nil
+}
+
+func (s *scanner) numbers(count int) ([]float64, error) {
+	numbers := make([]float64, count)
+	for i := range numbers {
+		n, err := s.number()
+		if err != nil {
+			return nil, err
+		}
+		numbers[i] = n
+	}
+
+	return numbers, nil
+}
+
+// parseNumbers parses a list of numbers, like the points of a polygon.
+func parseNumbers(value string) ([]float64, error) {
+	s := &scanner{value: value}
+
+	var numbers []float64
+	for !s.done() {
+		n, err := s.number()
+		if err != nil {
+			return nil, err
+		}
+		numbers = append(numbers, n)
+	}
+
+	return numbers, nil
+}
+
+// pathBuilder creates the segments of a path with absolute coordinates.
+type pathBuilder struct {
+	segments []Segment
+	current  Point
+	start    Point
+	// control is the last control point, used by the smooth curves.
+	control  Point
+	previous byte
+}
+
+func (b *pathBuilder) moveTo(p Point) {
+	b.segments = append(b.segments, Segment{Command: MoveTo, Points: []Point{p}})
+	b.current, b.start = p, p
+}
+
+func (b *pathBuilder) lineTo(p Point) {
+	b.segments = append(b.segments, Segment{Command: LineTo, Points: []Point{p}})
+	b.current = p
+}
+
+func (b *pathBuilder) curveTo(c1, c2, p Point) {
+	b.segments = append(b.segments, Segment{Command: CurveTo, Points: []Point{c1, c2, p}})
+	b.current = p
+}
+
+func (b *pathBuilder) quadTo(q, p Point) {
+	c1 := Point{X: b.current.X + 2.0/3*(q.X-b.current.X), Y: b.current.Y + 2.0/3*(q.Y-b.current.Y)}
+	c2 := Point{X: p.X + 2.0/3*(q.X-p.X), Y: p.Y + 2.0/3*(q.Y-p.Y)}
+	b.curveTo(c1, c2, p)
+}
+
+func (b *pathBuilder) close() {
+	b.segments = append(b.segments, Segment{Command: Close})
+	b.current = b.start
+}
+
+// arcTo adds an elliptical arc as cubic curves, following the endpoint parameterization of the SVG spec.
+func (b *pathBuilder) arcTo(rx, ry, rotation float64, large, sweep bool, p Point) {
+	from := b.current
+	if rx == 0 || ry == 0 {
+		b.lineTo(p)
+		return
+	}
+
+	if from == p {
+		return
+	}
+
+	rx, ry = math.Abs(rx), math.Abs(ry)
+	phi := rotation * math.Pi / 180
+	sinPhi, cosPhi := math.Sin(phi), math.Cos(phi)
+
+	dx, dy := (from.X-p.X)/2, (from.Y-p.Y)/2
+	x1 := cosPhi*dx + sinPhi*dy
+	y1 := -sinPhi*dx + cosPhi*dy
+
+	// radii too small to reach the end point are scaled up
+	if lambda := x1*x1/(rx*rx) + y1*y1/(ry*ry); lambda > 1 {
+		rx, ry = rx*math.Sqrt(lambda), ry*math.Sqrt(lambda)
+	}
+
+	numerator := rx*rx*ry*ry - rx*rx*y1*y1 - ry*ry*x1*x1
+	factor := math.Sqrt(math.Max(numerator, 0) / (rx*rx*y1*y1 + ry*ry*x1*x1))
+	if large == sweep {
+		factor = -factor
+	}
+
+	cx1, cy1 := factor*rx*y1/ry, -factor*ry*x1/rx
+	center := Point{
+		X: cosPhi*cx1 - sinPhi*cy1 + (from.X+p.X)/2,
+		Y: sinPhi*cx1 + cosPhi*cy1 + (from.Y+p.Y)/2,
+	}
+
+	start := math.Atan2((y1-cy1)/ry, (x1-cx1)/rx)
+	delta := math.Atan2((-y1-cy1)/ry, (-x1-cx1)/rx) - start
+	if sweep && delta < 0 {
+		delta += 2 * math.Pi
+	} else if !sweep && delta > 0 {
+		delta -= 2 * math.Pi
+	}
+
+	b.ellipseArc(center, rx, ry, phi, start, delta)
+	b.current = p
+}
+
+// ellipseArc adds cubic curves of at most a quarter of the ellipse, the angles are in radians.
+func (b *pathBuilder) ellipseArc(center Point, rx, ry, phi, start, delta float64) {
+	steps := int(math.Ceil(math.Abs(delta) / (math.Pi / 2)))
+	step := delta / float64(steps)
+	// distance of the control points for an arc of the step angle
+	alpha := 4.0 / 3 * math.Tan(step/4)
+
+	sinPhi, cosPhi := math.Sin(phi), math.Cos(phi)
+	point := func(angle, radiusX, radiusY float64) Point {
+		x, y := radiusX*math.Cos(angle), radiusY*math.Sin(angle)
+		return Point{X: center.X + cosPhi*x - sinPhi*y, Y: center.Y + sinPhi*x + cosPhi*y}
+	}
+	derivative := func(angle float64) Point {
+		x, y := -rx*math.Sin(angle), ry*math.Cos(angle)
+		return Point{X: cosPhi*x - sinPhi*y, Y: sinPhi*x + cosPhi*y}
+	}
+
+	for i := 0; i < steps; i++ {
+		a1 := start + float64(i)*step
+		a2 := a1 + step
+		p1, p2 := point(a1, rx, ry), point(a2, rx, ry)
+		d1, d2 := derivative(a1), derivative(a2)
+		b.curveTo(
+			Point{X: p1.X + alpha*d1.X, Y: p1.Y + alpha*d1.Y},
+			Point{X: p2.X - alpha*d2.X, Y: p2.Y - alpha*d2.Y},
+			p2,
+		)
+	}
+}
+
+// parsePath parses the d attribute of a path, a path with error is drawn until the error, as browsers do.
+func parsePath(d string) ([]Segment, error) {
+	s := &scanner{value: d}
+	b := &pathBuilder{}
+
+	var command byte
+	for !s.done() {
+		if !s.hasNumber() {
+			command = s.value[s.pos]
+			s.pos++
+		} else if command == 'M' {
+			// pairs after a move are lines
+			command = 'L'
+		} else if command == 'm' {
+			command = 'l'
+		}
+
+		if len(b.segments) == 0 && command != 'M' && command != 'm' {
+			return nil, fmt.Errorf("path must start with a move in %q", d)
+		}
+
+		if err := b.addCommand(s, command); err != nil {
+			return b.segments, err
+		}
+
+		b.previous = command
+	}
+
+	return b.segments, nil
+}
+
+func (b *pathBuilder) addCommand(s *scanner, command byte) error {
+	relative := command >= 'a'
+	offset := func(p Point) Point {
+		if relative {
+			return Point{X: b.current.X + p.X, Y: b.current.Y + p.Y}
+		}
+		return p
+	}
+
+	switch command {
+	case 'M', 'm':
+		n, err := s.numbers(2)
+		if err != nil {
+			return err
+		}
+		b.moveTo(offset(Point{X: n[0], Y: n[1]}))
+	case 'L', 'l':
+		n, err := s.numbers(2)
+		if err != nil {
+			return err
+		}
+		b.lineTo(offset(Point{X: n[0], Y: n[1]}))
+	case 'H', 'h':
+		n, err := s.number()
+		if err != nil {
+			return err
+		}
+		if relative {
+			n += b.current.X
+		}
+		b.lineTo(Point{X: n, Y: b.current.Y})
+	case 'V', 'v':
+		n, err := s.number()
+		if err != nil {
+			return err
+		}
+		if relative {
+			n += b.current.Y
+		}
+		b.lineTo(Point{X: b.current.X, Y: n})
+	case 'C', 'c':
+		n, err := s.numbers(6)
+		if err != nil {
+			return err
+		}
+		c1, c2, p := offset(Point{X: n[0], Y: n[1]}), offset(Point{X: n[2], Y: n[3]}), offset(Point{X: n[4], Y: n[5]})
+		b.curveTo(c1, c2, p)
+		b.control = c2
+	case 'S', 's':
+		n, err := s.numbers(4)
+		if err != nil {
+			return err
+		}
+		c1 := b.reflect("CcSs")
+		c2, p := offset(Point{X: n[0], Y: n[1]}), offset(Point{X: n[2], Y: n[3]})
+		b.curveTo(c1, c2, p)
+		b.control = c2
+	case 'Q', 'q':
+		n, err := s.numbers(4)
+		if err != nil {
+			return err
+		}
+		q, p := offset(Point{X: n[0], Y: n[1]}), offset(Point{X: n[2], Y: n[3]})
+		b.quadTo(q, p)
+		b.control = q
+	case 'T', 't':
+		n, err := s.numbers(2)
+		if err != nil {
+			return err
+		}
+		q := b.reflect("QqTt")
+		b.quadTo(q, offset(Point{X: n[0], Y: n[1]}))
+		b.control = q
+	case 'A', 'a':
+		return b.addArc(s, offset)
+	case 'Z', 'z':
+		b.close()
+		if s.hasNumber() {
+			return fmt.Errorf("invalid number after close at %d of %q", s.pos, s.value)
+		}
+	default:
+		return fmt.Errorf("invalid path command %q", command)
+	}
+
+	return nil
+}
+
+func (b *pathBuilder) addArc(s *scanner, offset func(Point) Point) error {
+	radii, err := s.numbers(3)
+	if err != nil {
+		return err
+	}
+
+	large, err := s.flag()
+	if err != nil {
+		return err
+	}
+
+	sweep, err := s.flag()
+	if err != nil {
+		return err
+	}
+
+	n, err := s.numbers(2)
+	if err != nil {
+		return err
+	}
+
+	b.arcTo(radii[0], radii[1], radii[2], large, sweep, offset(Point{X: n[0], Y: n[1]}))
+	return nil
+}
+
+// reflect returns the reflection of the last control point when the previous command is one of the commands,
+// otherwise the current point.
+func (b *pathBuilder) reflect(commands string) Point {
+	for i := 0; i < len(commands); i++ {
+		if b.previous == commands[i] {
+			return Point{X: 2*b.current.X - b.control.X, Y: 2*b.current.Y - b.control.Y}
+		}
+	}
+
+	return b.current
+}
+
+// ellipse returns the segments of an ellipse.
+func ellipse(cx, cy, rx, ry float64) []Segment {
+	b := &pathBuilder{}
+	b.moveTo(Point{X: cx + rx, Y: cy})
+	b.ellipseArc(Point{X: cx, Y: cy}, rx, ry, 0, 0, 2*math.Pi)
+	b.close()
+
+	return b.segments
+}
+
+// rectangle returns the segments of a rectangle, with rounded corners when rx and ry are greater than zero.
+func rectangle(x, y, width, height, rx, ry float64) []Segment {
+	b := &pathBuilder{}
+	if rx <= 0 || ry <= 0 {
+		b.moveTo(Point{X: x, Y: y})
+		b.lineTo(Point{X: x + width, Y: y})
+		b.lineTo(Point{X: x + width, Y: y + height})
+		b.lineTo(Point{X: x, Y: y + height})
+		b.close()
+		return b.segments
+	}
+
+	rx, ry = math.Min(rx, width/2), math.Min(ry, height/2)
+	corner := func(cx, cy, start float64) {
+		b.ellipseArc(Point{X: cx, Y: cy}, rx, ry, 0, start, math.Pi/2)
+	}
+
+	b.moveTo(Point{X: x + rx, Y: y})
+	b.lineTo(Point{X: x + width - rx, Y: y})
+	corner(x+width-rx, y+ry, -math.Pi/2)
+	b.lineTo(Point{X: x + width, Y: y + height - ry})
+	corner(x+width-rx, y+height-ry, 0)
+	b.lineTo(Point{X: x + rx, Y: y + height})
+	corner(x+rx, y+height-ry, math.Pi/2)
+	b.lineTo(Point{X: x, Y: y + ry})
+	corner(x+rx, y+ry, math.Pi)
+	b.close()
+
+	return b.segments
+}
@@ -0,0 +1,98 @@
+package svg
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseNumbers(t *testing.T) {
+	t.Run("when numbers are not separated, should split by sign and decimal point", func(t *testing.T) {
+		// Act
+		numbers, err := parseNumbers("1.5.5-1-2e1,3")
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, []float64{1.5, 0.5, -1, -20, 3}, numbers)
+	})
+	t.Run("when value has an invalid number, should return error", func(t *testing.T) {
+		// Act
+		_, err := parseNumbers("1 a")
+
+		// Assert
+		assert.NotNil(t, err)
+	})
+}
+
+func TestParsePath(t *testing.T) {
+	t.Run("when path has relative and implicit commands, should return absolute segments", func(t *testing.T) {
+		// Act
+		segments, err := parsePath("m10 10 10 0 v10 H10z")
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, []Segment{
+			{Command: MoveTo, Points: []Point{{X: 10, Y: 10}}},
+			{Command: LineTo, Points: []Point{{X: 20, Y: 10}}},
+			{Command: LineTo, Points: []Point{{X: 20, Y: 20}}},
+			{Command: LineTo, Points: []Point{{X: 10, Y: 20}}},
+			{Command: Close},
+		}, segments)
+	})
+	t.Run("when path has smooth curve, should reflect the last control point", func(t *testing.T) {
+		// Act
+		segments, err := parsePath("M0 0 C0 10 10 10 10 0 S20 -10 20 0")
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Len(t, segments, 3)
+		assert.Equal(t, []Point{{X: 10, Y: -10}, {X: 20, Y: -10}, {X: 20, Y: 0}}, segments[2].Points)
+	})
+	t.Run("when path has quadratic curve, should convert to cubic curve", func(t *testing.T) {
+		// Act
+		segments, err := parsePath("M0 0 Q15 15 30 0")
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, []Point{{X: 10, Y: 10}, {X: 20, Y: 10}, {X: 30, Y: 0}}, segments[1].Points)
+	})
+	t.Run("when path has arc with flags without separators, should convert to curves", func(t *testing.T) {
+		// Act
+		segments, err := parsePath("M0 0a10 10 0 0110 10")
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Len(t, segments, 2)
+		assert.Equal(t, CurveTo, segments[1].Command)
+		last := segments[1].Points[2]
+		assert.InDelta(t, 10, last.X, 1e-9)
+		assert.InDelta(t, 10, last.Y, 1e-9)
+	})
+	t.Run("when path has half circle arc, should split in curves of at most 90 degrees", func(t *testing.T) {
+		// Act
+		segments, err := parsePath("M0 0 A10 10 0 0 1 20 0")
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Len(t, segments, 3)
+		middle := segments[1].Points[2]
+		assert.InDelta(t, 10, middle.X, 1e-9)
+		assert.InDelta(t, 10, math.Abs(middle.Y), 1e-9)
+	})
+	t.Run("when path has a number after close, should return the segments before the error", func(t *testing.T) {
+		// Act
+		segments, err := parsePath("M0 0 L10 0 Z 5")
+
+		// Assert
+		assert.NotNil(t, err)
+		assert.Len(t, segments, 3)
+	})
+	t.Run("when path does not start with move, should return error", func(t *testing.T) {
+		// Act
+		_, err := parsePath("L10 0")
+
+		// Assert
+		assert.NotNil(t, err)
+	})
+}
@@ -0,0 +1,470 @@
+// Package svg implements the parsing of SVG images into vector shapes.
+//
+// Paths, rectangles, circles, ellipses, lines, polylines and polygons are supported, with fills,
+// strokes, opacities and transforms. Gradients are drawn with the average color of their stops,
+// texts, clips and masks are ignored.
+package svg
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+// Command is the operation of a path segment.
+type Command byte
+
+const (
+	// MoveTo starts a sub path at the point.
+	MoveTo Command = 'M'
+	// LineTo draws a line to the point.
+	LineTo Command = 'L'
+	// CurveTo draws a cubic curve with two control points to the last point.
+	CurveTo Command = 'C'
+	// Close draws a line to the start of the sub path.
+	Close Command = 'Z'
+)
+
+// Point is a position in the coordinates of the viewBox.
+type Point struct {
+	X float64
+	Y float64
+}
+
+// Segment is a part of a path, with absolute coordinates.
+type Segment struct {
+	Command Command
+	Points  []Point
+}
+
+// Shape is a path with its paint, with transformations already applied.
+type Shape struct {
+	Segments []Segment
+	// Fill is the color of the fill, when nil the shape is not filled.
+	Fill *props.Color
+	// Stroke is the color of the stroke, when nil the shape is not stroked.
+	Stroke      *props.Color
+	StrokeWidth float64
+	// EvenOdd define that the fill uses the even-odd rule, instead of the nonzero rule.
+	EvenOdd bool
+	// Opacity is the opacity of the shape, from 0 to 1, the fill opacity is used when the
+	// shape is filled and stroked.
+	Opacity float64
+}
+
+// ViewBox is the area of the coordinates which is drawn.
+type ViewBox struct {
+	X      float64
+	Y      float64
+	Width  float64
+	Height float64
+}
+
+// Image is a parsed SVG.
+type Image struct {
+	ViewBox ViewBox
+	Shapes  []Shape
+}
+
+// style is the paint of an element, inherited by its children.
+type style struct {
+	fill        *props.Color
+	fillAlpha   float64
+	stroke      *props.Color
+	strokeAlpha float64
+	strokeWidth float64
+	evenOdd     bool
+	// opacity is the product of the opacities of the element and its ancestors.
+	opacity   float64
+	transform matrix
+}
+
+// defaultStyle is the style of the root element before its attributes.
+var defaultStyle = style{
+	fill:        &props.BlackColor,
+	fillAlpha:   1,
+	strokeAlpha: 1,
+	strokeWidth: 1,
+	opacity:     1,
+	transform:   identity,
+}
+
+// skipped are the elements which content is not drawn.
+var skipped = map[string]bool{
+	"defs": true, "clipPath": true, "mask": true, "symbol": true, "marker": true, "pattern": true,
+	"linearGradient": true, "radialGradient": true, "text": true, "title": true, "desc": true,
+	"metadata": true, "style": true, "script": true, "foreignObject": true,
+}
+
+// GetDimensions returns the dimensions of the viewBox, which are used as the proportion of the image.
+func (i *Image) GetDimensions() *entity.Dimensions {
+	return &entity.Dimensions{Width: i.ViewBox.Width, Height: i.ViewBox.Height}
+}
+
+// Parse parses an SVG document, the size comes from the viewBox or from the width and height of the root.
+func Parse(data []byte) (*Image, error) {
+	gradients, err := getGradients(data)
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	img := &Image{}
+	styles := []style{}
+
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid svg: %w", err)
+		}
+
+		switch element := token.(type) {
+		case xml.StartElement:
+			attributes := getAttributes(element)
+
+			if len(styles) == 0 {
+				if element.Name.Local != "svg" {
+					return nil, errors.New("invalid svg: root element is not svg")
+				}
+
+				if img.ViewBox, err = getViewBox(attributes); err != nil {
+					return nil, err
+				}
+
+				styles = append(styles, defaultStyle.inherit(attributes, gradients))
+				continue
+			}
+
+			if skipped[element.Name.Local] {
+				if err := decoder.Skip(); err != nil {
+					return nil, fmt.Errorf("invalid svg: %w", err)
+				}
+				continue
+			}
+
+			current := styles[len(styles)-1].inherit(attributes, gradients)
+			styles = append(styles, current)
+
+			if shape, ok := getShape(element.Name.Local, attributes, current); ok {
+				img.Shapes = append(img.Shapes, shape)
+			}
+		case xml.EndElement:
+			styles = styles[:len(styles)-1]
+		}
+	}
+
+	if len(styles) > 0 || img.ViewBox.Width <= 0 {
+		return nil, errors.New("invalid svg: missing svg element")
+	}
+
+	return img, nil
+}
+
+func getAttributes(element xml.StartElement) map[string]string {
+	attributes := make(map[string]string, len(element.Attr))
+	for _, attr := range element.Attr {
+		attributes[attr.Name.Local] = attr.Value
+	}
+
+	// the style attribute has priority over the presentation attributes
+	for _, declaration := range strings.Split(attributes["style"], ";") {
+		name, value, ok := strings.Cut(declaration, ":")
+		if ok {
+			attributes[strings.TrimSpace(name)] = strings.TrimSpace(value)
+		}
+	}
+
+	return attributes
+}
+
+func getViewBox(attributes map[string]string) (ViewBox, error) {
+	if value, ok := attributes["viewBox"]; ok {
+		numbers, err := parseNumbers(value)
+		if err != nil || len(numbers) != 4 || numbers[2] <= 0 || numbers[3] <= 0 {
+			return ViewBox{}, fmt.Errorf("invalid svg: invalid viewBox %q", value)
+		}
+
+		return ViewBox{X: numbers[0], Y: numbers[1], Width: numbers[2], Height: numbers[3]}, nil
+	}
+
+	width, widthOk := parseLength(attributes["width"])
+	height, heightOk := parseLength(attributes["height"])
+	if !widthOk || !heightOk || width <= 0 || height <= 0 {
+		return ViewBox{}, errors.New("invalid svg: svg without viewBox must have width and height")
+	}
+
+	return ViewBox{Width: width, Height: height}, nil
+}
+
+// inherit returns the style of a child element.
+func (s style) inherit(attributes map[string]string, gradients map[string]props.Color) style {
+	if value, ok := attributes["fill"]; ok {
+		s.fill, s.fillAlpha = getPaint(value, s.fill, s.fillAlpha, gradients)
+	}
+
+	if value, ok := attributes["stroke"]; ok {
+		s.stroke, s.strokeAlpha = getPaint(value, s.stroke, s.strokeAlpha, gradients)
+	}
+
+	if opacity, ok := parseOpacity(attributes["fill-opacity"]); ok {
+		s.fillAlpha *= opacity
+	}
+
+	if opacity, ok := parseOpacity(attributes["stroke-opacity"]); ok {
+		s.strokeAlpha *= opacity
+	}
+
+	if opacity, ok := parseOpacity(attributes["opacity"]); ok {
+		s.opacity *= opacity
+	}
+
+	if width, ok := parseLength(attributes["stroke-width"]); ok {
+		s.strokeWidth = width
+	}
+
+	if value, ok := attributes["fill-rule"]; ok {
+		s.evenOdd = value == "evenodd"
+	}
+
+	if value, ok := attributes["transform"]; ok {
+		if m, err := parseTransform(value); err == nil {
+			s.transform = s.transform.multiply(m)
+		}
+	}
+
+	return s
+}
+
+// getPaint returns the color and alpha of a fill or stroke, an unknown paint keeps the inherited color.
+func getPaint(value string, inherited *props.Color, alpha float64, gradients map[string]props.Color) (*props.Color, float64) {
+	value = strings.TrimSpace(value)
+
+	switch {
+	case value == "none" || value == "transparent":
+		return nil, 1
+	case value == "currentColor":
+		return &props.BlackColor, 1
+	case strings.HasPrefix(value, "url("):
+		end := strings.Index(value, ")")
+		if end < 0 {
+			return inherited, alpha
+		}
+
+		id := strings.Trim(strings.TrimSpace(value[4:end]), `"'`)
+		if color, ok := gradients[strings.TrimPrefix(id, "#")]; ok {
+			return &color, 1
+		}
+
+		// the fallback color after the reference, like url(#missing) red
+		if color, colorAlpha, ok := parseColor(value[end+1:]); ok {
+			return &color, colorAlpha
+		}
+
+		return nil, 1
+	}
+
+	if color, colorAlpha, ok := parseColor(value); ok {
+		return &color, colorAlpha
+	}
+
+	return inherited, alpha
+}
+
+// getShape returns the shape of a drawing element, with the transformation applied.
+func getShape(name string, attributes map[string]string, s style) (Shape, bool) {
+	segments := getSegments(name, attributes)
+	if len(segments) == 0 || (s.fill == nil && s.stroke == nil) {
+		return Shape{}, false
+	}
+
+	for i, segment := range segments {
+		points := make([]Point, len(segment.Points))
+		for j, point := range segment.Points {
+			points[j] = s.transform.apply(point)
+		}
+		segments[i].Points = points
+	}
+
+	shape := Shape{
+		Segments: segments,
+		Fill:     s.fill,
+		Stroke:   s.stroke,
+		EvenOdd:  s.evenOdd,
+	}
+
+	// lines have no area to fill
+	if name == "line" || name == "polyline" {
+		shape.Fill = nil
+	}
+
+	if shape.Stroke != nil {
+		shape.StrokeWidth = s.strokeWidth * s.transform.scale()
+		if shape.StrokeWidth <= 0 {
+			shape.Stroke = nil
+		}
+	}
+
+	shape.Opacity = s.opacity * s.strokeAlpha
+	if shape.Fill != nil {
+		shape.Opacity = s.opacity * s.fillAlpha
+	}
+
+	return shape, (shape.Fill != nil || shape.Stroke != nil) && shape.Opacity > 0
+}
+
+func getSegments(name string, attributes map[string]string) []Segment {
+	number := func(key string) float64 {
+		n, _ := parseLength(attributes[key])
+		return n
+	}
+
+	switch name {
+	case "path":
+		// a path with error is drawn until the error
+		segments, _ := parsePath(attributes["d"])
+		return segments
+	case "rect":
+		width, height := number("width"), number("height")
+		if width <= 0 || height <= 0 {
+			return nil
+		}
+
+		rx, rxOk := parseLength(attributes["rx"])
+		ry, ryOk := parseLength(attributes["ry"])
+		if !rxOk {
+			rx = ry
+		}
+		if !ryOk {
+			ry = rx
+		}
+
+		return rectangle(number("x"), number("y"), width, height, rx, ry)
+	case "circle":
+		r := number("r")
+		if r <= 0 {
+			return nil
+		}
+
+		return ellipse(number("cx"), number("cy"), r, r)
+	case "ellipse":
+		rx, ry := number("rx"), number("ry")
+		if rx <= 0 || ry <= 0 {
+			return nil
+		}
+
+		return ellipse(number("cx"), number("cy"), rx, ry)
+	case "line":
+		return []Segment{
+			{Command: MoveTo, Points: []Point{{X: number("x1"), Y: number("y1")}}},
+			{Command: LineTo, Points: []Point{{X: number("x2"), Y: number("y2")}}},
+		}
+	case "polyline", "polygon":
+		return getPolySegments(attributes["points"], name == "polygon")
+	default:
+		return nil
+	}
+}
+
+func getPolySegments(value string, closed bool) []Segment {
+	numbers, _ := parseNumbers(value)
+	if len(numbers) < 4 {
+		return nil
+	}
+
+	segments := []Segment{{Command: MoveTo, Points: []Point{{X: numbers[0], Y: numbers[1]}}}}
+	for i := 2; i+1 < len(numbers); i += 2 {
+		segments = append(segments, Segment{Command: LineTo, Points: []Point{{X: numbers[i], Y: numbers[i+1]}}})
+	}
+
+	if closed {
+		segments = append(segments, Segment{Command: Close})
+	}
+
+	return segments
+}
+
+// units are the sizes of the length units in user units, which are pixels.
+var units = map[string]float64{
+	"px": 1, "pt": 96.0 / 72, "pc": 16, "mm": 96 / 25.4, "cm": 96 / 2.54, "in": 96, "em": 16,
+}
+
+// parseLength parses a length with an optional unit, percentages are not supported.
+func parseLength(value string) (float64, bool) {
+	value = strings.TrimSpace(value)
+	if value == "" || strings.HasSuffix(value, "%") {
+		return 0, false
+	}
+
+	scale := 1.0
+	if len(value) > 2 {
+		if unit, ok := units[value[len(value)-2:]]; ok {
+			scale = unit
+			value = value[:len(value)-2]
+		}
+	}
+
+	n, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+	if err != nil || math.IsNaN(n) || math.IsInf(n, 0) {
+		return 0, false
+	}
+
+	return n * scale, true
+}
+
+// getGradients returns the average color of the stops of each gradient, by id.
+func getGradients(data []byte) (map[string]props.Color, error) {
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	gradients := make(map[string]props.Color)
+
+	id := ""
+	var stops []props.Color
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			return gradients, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid svg: %w", err)
+		}
+
+		switch element := token.(type) {
+		case xml.StartElement:
+			attributes := getAttributes(element)
+			switch element.Name.Local {
+			case "linearGradient", "radialGradient":
+				id, stops = attributes["id"], nil
+			case "stop":
+				if color, _, ok := parseColor(attributes["stop-color"]); ok {
+					stops = append(stops, color)
+				} else if _, ok := attributes["stop-color"]; !ok {
+					stops = append(stops, props.BlackColor)
+				}
+			}
+		case xml.EndElement:
+			if (element.Name.Local == "linearGradient" || element.Name.Local == "radialGradient") && id != "" && len(stops) > 0 {
+				gradients[id] = averageColor(stops)
+			}
+		}
+	}
+}
+
+func averageColor(colors []props.Color) props.Color {
+	red, green, blue := 0, 0, 0
+	for _, color := range colors {
+		red, green, blue = red+color.Red, green+color.Green, blue+color.Blue
+	}
+
+	n := len(colors)
+	return props.Color{Red: (red + n/2) / n, Green: (green + n/2) / n, Blue: (blue + n/2) / n}
+}
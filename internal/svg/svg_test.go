@@ -0,0 +1,145 @@
+package svg_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/johnfercher/maroto/v2/internal/svg"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	t.Run("when data is not xml, should return error", func(t *testing.T) {
+		// Act
+		img, err := svg.Parse([]byte("<svg"))
+
+		// Assert
+		assert.Nil(t, img)
+		assert.NotNil(t, err)
+	})
+	t.Run("when root is not svg, should return error", func(t *testing.T) {
+		// Act
+		img, err := svg.Parse([]byte(`<html width="10" height="10"></html>`))
+
+		// Assert
+		assert.Nil(t, img)
+		assert.NotNil(t, err)
+	})
+	t.Run("when svg has no viewBox and no size, should return error", func(t *testing.T) {
+		// Act
+		img, err := svg.Parse([]byte(`<svg></svg>`))
+
+		// Assert
+		assert.Nil(t, img)
+		assert.NotNil(t, err)
+	})
+	t.Run("when svg has viewBox, should use it as dimensions", func(t *testing.T) {
+		// Act
+		img, err := svg.Parse([]byte(`<svg width="10mm" height="5mm" viewBox="0 0 200 100"></svg>`))
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, svg.ViewBox{Width: 200, Height: 100}, img.ViewBox)
+		assert.Equal(t, &entity.Dimensions{Width: 200, Height: 100}, img.GetDimensions())
+	})
+	t.Run("when svg has only size, should use it as viewBox", func(t *testing.T) {
+		// Act
+		img, err := svg.Parse([]byte(`<svg width="1in" height="48px"></svg>`))
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, svg.ViewBox{Width: 96, Height: 48}, img.ViewBox)
+	})
+	t.Run("when element is inside group, should inherit paint and transform", func(t *testing.T) {
+		// Arrange
+		data := `<svg viewBox="0 0 100 100">
+			<g fill="red" stroke="#00f" stroke-width="2" transform="translate(10 10) scale(2)">
+				<rect x="0" y="0" width="10" height="5" style="fill: green"/>
+			</g>
+		</svg>`
+
+		// Act
+		img, err := svg.Parse([]byte(data))
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Len(t, img.Shapes, 1)
+		shape := img.Shapes[0]
+		assert.Equal(t, &props.Color{Red: 0, Green: 128, Blue: 0}, shape.Fill)
+		assert.Equal(t, &props.Color{Red: 0, Green: 0, Blue: 255}, shape.Stroke)
+		assert.Equal(t, 4.0, shape.StrokeWidth)
+		assert.Equal(t, 1.0, shape.Opacity)
+		assert.Equal(t, svg.Segment{Command: svg.MoveTo, Points: []svg.Point{{X: 10, Y: 10}}}, shape.Segments[0])
+		assert.Equal(t, svg.Segment{Command: svg.LineTo, Points: []svg.Point{{X: 30, Y: 10}}}, shape.Segments[1])
+	})
+	t.Run("when element is a line, should not be filled", func(t *testing.T) {
+		// Arrange
+		data := `<svg viewBox="0 0 100 100"><line x1="0" y1="0" x2="10" y2="10" stroke="black"/></svg>`
+
+		// Act
+		img, err := svg.Parse([]byte(data))
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Len(t, img.Shapes, 1)
+		assert.Nil(t, img.Shapes[0].Fill)
+		assert.Equal(t, &props.BlackColor, img.Shapes[0].Stroke)
+	})
+	t.Run("when element has no paint, should be ignored", func(t *testing.T) {
+		// Arrange
+		data := `<svg viewBox="0 0 100 100"><circle cx="5" cy="5" r="5" fill="none"/><line x1="0" y1="0" x2="1" y2="1"/></svg>`
+
+		// Act
+		img, err := svg.Parse([]byte(data))
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Empty(t, img.Shapes)
+	})
+	t.Run("when element has opacities, should multiply them", func(t *testing.T) {
+		// Arrange
+		data := `<svg viewBox="0 0 100 100"><g opacity="0.5">
+			<rect width="10" height="10" fill="rgba(0, 0, 0, 0.5)" fill-opacity="50%"/>
+		</g></svg>`
+
+		// Act
+		img, err := svg.Parse([]byte(data))
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Len(t, img.Shapes, 1)
+		assert.Equal(t, 0.125, img.Shapes[0].Opacity)
+	})
+	t.Run("when fill is a gradient, should use the average color of the stops", func(t *testing.T) {
+		// Arrange
+		data := `<svg viewBox="0 0 100 100">
+			<defs><linearGradient id="g"><stop stop-color="#000"/><stop stop-color="#fff"/></linearGradient></defs>
+			<rect width="10" height="10" fill="url(#g)"/>
+			<rect width="10" height="10" fill="url(#missing) red"/>
+		</svg>`
+
+		// Act
+		img, err := svg.Parse([]byte(data))
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Len(t, img.Shapes, 2)
+		assert.Equal(t, &props.Color{Red: 128, Green: 128, Blue: 128}, img.Shapes[0].Fill)
+		assert.Equal(t, &props.RedColor, img.Shapes[1].Fill)
+	})
+	t.Run("when svg is the repository background, should parse the shapes", func(t *testing.T) {
+		// Arrange
+		data, _ := os.ReadFile("../../docs/assets/images/background.svg")
+
+		// Act
+		img, err := svg.Parse(data)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, 1920.0, img.ViewBox.Width)
+		assert.Equal(t, 1080.0, img.ViewBox.Height)
+		assert.NotEmpty(t, img.Shapes)
+	})
+}
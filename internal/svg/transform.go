@@ -0,0 +1,100 @@
+package svg
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// matrix is an affine transformation, a point is transformed to (a*x + c*y + e, b*x + d*y + f).
+type matrix struct {
+	a, b, c, d, e, f float64
+}
+
+var identity = matrix{a: 1, d: 1}
+
+// multiply returns the transformation which applies n and then m.
+func (m matrix) multiply(n matrix) matrix {
+	return matrix{
+		a: m.a*n.a + m.c*n.b,
+		b: m.b*n.a + m.d*n.b,
+		c: m.a*n.c + m.c*n.d,
+		d: m.b*n.c + m.d*n.d,
+		e: m.a*n.e + m.c*n.f + m.e,
+		f: m.b*n.e + m.d*n.f + m.f,
+	}
+}
+
+func (m matrix) apply(p Point) Point {
+	return Point{
+		X: m.a*p.X + m.c*p.Y + m.e,
+		Y: m.b*p.X + m.d*p.Y + m.f,
+	}
+}
+
+// scale returns how much the transformation scales lengths, used with stroke widths.
+func (m matrix) scale() float64 {
+	return math.Sqrt(math.Abs(m.a*m.d - m.b*m.c))
+}
+
+// parseTransform parses a transform attribute, like "translate(10, 20) rotate(45)".
+func parseTransform(value string) (matrix, error) {
+	result := identity
+
+	for value = strings.TrimSpace(value); value != ""; value = strings.TrimLeft(value, " \t\r\n,") {
+		open := strings.Index(value, "(")
+		closing := strings.Index(value, ")")
+		if open < 0 || closing < open {
+			return identity, fmt.Errorf("invalid transform %q", value)
+		}
+
+		name := strings.TrimSpace(value[:open])
+		args, err := parseNumbers(value[open+1 : closing])
+		if err != nil {
+			return identity, err
+		}
+
+		m, err := getTransform(name, args)
+		if err != nil {
+			return identity, err
+		}
+
+		result = result.multiply(m)
+		value = value[closing+1:]
+	}
+
+	return result, nil
+}
+
+func getTransform(name string, args []float64) (matrix, error) {
+	switch {
+	case name == "matrix" && len(args) == 6:
+		return matrix{a: args[0], b: args[1], c: args[2], d: args[3], e: args[4], f: args[5]}, nil
+	case name == "translate" && len(args) == 1:
+		return matrix{a: 1, d: 1, e: args[0]}, nil
+	case name == "translate" && len(args) == 2:
+		return matrix{a: 1, d: 1, e: args[0], f: args[1]}, nil
+	case name == "scale" && len(args) == 1:
+		return matrix{a: args[0], d: args[0]}, nil
+	case name == "scale" && len(args) == 2:
+		return matrix{a: args[0], d: args[1]}, nil
+	case name == "rotate" && (len(args) == 1 || len(args) == 3):
+		angle := args[0] * math.Pi / 180
+		sin, cos := math.Sin(angle), math.Cos(angle)
+		rotation := matrix{a: cos, b: sin, c: -sin, d: cos}
+		if len(args) == 1 {
+			return rotation, nil
+		}
+
+		// rotate(angle, cx, cy) rotates around the point (cx, cy)
+		to := matrix{a: 1, d: 1, e: args[1], f: args[2]}
+		back := matrix{a: 1, d: 1, e: -args[1], f: -args[2]}
+		return to.multiply(rotation).multiply(back), nil
+	case name == "skewX" && len(args) == 1:
+		return matrix{a: 1, c: math.Tan(args[0] * math.Pi / 180), d: 1}, nil
+	case name == "skewY" && len(args) == 1:
+		return matrix{a: 1, b: math.Tan(args[0] * math.Pi / 180), d: 1}, nil
+	default:
+		return identity, fmt.Errorf("invalid transform %s with %d arguments", name, len(args))
+	}
+}
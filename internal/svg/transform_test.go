@@ -0,0 +1,46 @@
+package svg
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseTransform(t *testing.T) {
+	t.Run("when transform is a list, should apply the last one first", func(t *testing.T) {
+		// Act
+		m, err := parseTransform("translate(10, 20) scale(2)")
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, Point{X: 12, Y: 24}, m.apply(Point{X: 1, Y: 2}))
+	})
+	t.Run("when transform rotates around a point, should keep the point", func(t *testing.T) {
+		// Act
+		m, err := parseTransform("rotate(90 10 10)")
+
+		// Assert
+		assert.Nil(t, err)
+		center := m.apply(Point{X: 10, Y: 10})
+		assert.InDelta(t, 10, center.X, 1e-9)
+		assert.InDelta(t, 10, center.Y, 1e-9)
+		rotated := m.apply(Point{X: 20, Y: 10})
+		assert.InDelta(t, 10, rotated.X, 1e-9)
+		assert.InDelta(t, 20, rotated.Y, 1e-9)
+	})
+	t.Run("when transform scales, should scale lengths", func(t *testing.T) {
+		// Act
+		m, err := parseTransform("scale(2, 8)")
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, 4.0, m.scale())
+	})
+	t.Run("when transform is unknown, should return error", func(t *testing.T) {
+		// Act
+		_, err := parseTransform("perspective(10)")
+
+		// Assert
+		assert.NotNil(t, err)
+	})
+}
@@ -2,23 +2,32 @@ package maroto
 
 import (
 	"errors"
+	"fmt"
 	"math"
+	"strings"
 
 	"github.com/johnfercher/maroto/v2/internal/cache"
 
 	"github.com/johnfercher/maroto/v2/internal/providers/gofpdf"
+	"github.com/johnfercher/maroto/v2/internal/providers/locator"
 
 	"github.com/johnfercher/maroto/v2/pkg/core/entity"
 
 	"github.com/johnfercher/go-tree/node"
 
 	"github.com/johnfercher/maroto/v2/pkg/components/col"
+	"github.com/johnfercher/maroto/v2/pkg/components/footnote"
+	"github.com/johnfercher/maroto/v2/pkg/components/line"
 	"github.com/johnfercher/maroto/v2/pkg/components/page"
 	"github.com/johnfercher/maroto/v2/pkg/components/row"
 	"github.com/johnfercher/maroto/v2/pkg/config"
 	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/props"
 )
 
+// footnoteSeparatorHeight is the height of the row with the line above the footnotes of a page.
+const footnoteSeparatorHeight = 4.0
+
 type Maroto struct {
 	config   *entity.Config
 	provider core.Provider
@@ -33,6 +42,19 @@ type Maroto struct {
 	headerHeight  float64
 	footerHeight  float64
 	currentHeight float64
+	footnotes     []*entity.Footnote
+	footnoteCount int
+	layout        *locator.Locator
+	// watermark is the watermark of the page whose rows are being added,
+	// pageWatermark is the watermark of the page being built.
+	watermark     *entity.Watermark
+	pageWatermark *entity.Watermark
+	// steps are the additions to the document, repeated when the document is built again,
+	// expanded tells that rows were created from the headings known when they were added,
+	// and stale tells that headings were added after those rows.
+	steps    []func()
+	expanded bool
+	stale    bool
 }
 
 // GetCurrentConfig is responsible for returning the current settings from the file
@@ -58,6 +80,7 @@ func New(cfgs ...*entity.Config) core.Maroto {
 		}),
 		cache:  cache,
 		config: cfg,
+		layout: locator.New(provider),
 	}
 
 	return m
@@ -67,14 +90,25 @@ func New(cfgs ...*entity.Config) core.Maroto {
 // By adding a page directly, the current cursor will reset and the
 // new page will appear as the next. If the page provided have
 // more rows than the maximum useful area of a page, maroto will split
-// that page in more than one.
+// that page in more than one, all of them with the watermark of the page.
+// When a table of contents is added before its headings, the rows of the
+// pages are measured and added again when the document is built, so the
+// components must render the same way every time they are added.
 func (m *Maroto) AddPages(pages ...core.Page) {
+	m.record(func() {
+		m.addPages(pages...)
+	})
+}
+
+func (m *Maroto) addPages(pages ...core.Page) {
 	for _, page := range pages {
 		if m.currentHeight != m.headerHeight {
 			m.fillPageToAddNew()
 			m.addHeader()
 		}
+		m.watermark = page.GetWatermark()
 		m.addRows(page.GetRows()...)
+		m.watermark = nil
 	}
 }
 
@@ -82,28 +116,38 @@ func (m *Maroto) AddPages(pages ...core.Page) {
 // By adding a row, if the row will extrapolate the useful area of a page,
 // maroto will automatically add a new page. Maroto use the information of
 // PageSize, PageMargin, FooterSize and HeaderSize to calculate the useful
-// area of a page.
+// area of a page. When a table of contents is added before its headings,
+// the rows are measured and added again when the document is built, so
+// the components must render the same way every time they are added.
 func (m *Maroto) AddRows(rows ...core.Row) {
-	m.addRows(rows...)
+	m.record(func() {
+		m.addRows(rows...)
+	})
 }
 
 // AddRow is responsible for add one row in the current document.
 // By adding a row, if the row will extrapolate the useful area of a page,
 // maroto will automatically add a new page. Maroto use the information of
 // PageSize, PageMargin, FooterSize and HeaderSize to calculate the useful
-// area of a page.
+// area of a page. Like in AddRows, the row may be added again when the
+// document is built.
 func (m *Maroto) AddRow(rowHeight float64, cols ...core.Col) core.Row {
 	r := row.New(rowHeight).Add(cols...)
-	m.addRow(r)
+	m.record(func() {
+		m.addRow(r)
+	})
 	return r
 }
 
 // AddAutoRow is responsible for adding a line with automatic height to the
 // current document.
-// The row height will be calculated based on its content.
+// The row height will be calculated based on its content. Like in AddRows,
+// the row may be added again when the document is built.
 func (m *Maroto) AddAutoRow(cols ...core.Col) core.Row {
 	r := row.New().Add(cols...)
-	m.addRow(r)
+	m.record(func() {
+		m.addRow(r)
+	})
 	return r
 }
 
@@ -124,12 +168,14 @@ func (m *Maroto) RegisterHeader(rows ...core.Row) error {
 		return errors.New("header height is greater than page useful area")
 	}
 
-	m.headerHeight = height
-	m.header = rows
+	m.record(func() {
+		m.headerHeight = height
+		m.header = rows
 
-	for _, headerRow := range rows {
-		m.addRow(headerRow)
-	}
+		for _, headerRow := range rows {
+			m.addRow(headerRow)
+		}
+	})
 
 	return nil
 }
@@ -144,8 +190,10 @@ func (m *Maroto) RegisterFooter(rows ...core.Row) error {
 		return errors.New("footer height is greater than page useful area")
 	}
 
-	m.footerHeight = height
-	m.footer = rows
+	m.record(func() {
+		m.footerHeight = height
+		m.footer = rows
+	})
 	return nil
 }
 
@@ -153,6 +201,7 @@ func (m *Maroto) RegisterFooter(rows ...core.Row) error {
 // the usage of all other Maroto methods, and generate the PDF document.
 func (m *Maroto) Generate() (core.Document, error) {
 	m.fillPageToAddNew()
+	m.rebuild()
 	m.setConfig()
 
 	return m.generate()
@@ -162,6 +211,7 @@ func (m *Maroto) Generate() (core.Document, error) {
 // on unit tests cases.
 func (m *Maroto) GetStructure() *node.Node[core.Structure] {
 	m.fillPageToAddNew()
+	m.rebuild()
 
 	str := core.Structure{
 		Type:    "maroto",
@@ -184,6 +234,13 @@ func (m *Maroto) addRows(rows ...core.Row) {
 }
 
 func (m *Maroto) addRow(r core.Row) {
+	if expandable, ok := r.(core.ExpandableRow); ok {
+		m.expanded = true
+		expandable.SetConfig(m.config)
+		m.addRows(expandable.Expand(m.layout.Resolve())...)
+		return
+	}
+
 	if len(r.GetColumns()) == 0 {
 		r.Add(col.New())
 	}
@@ -191,25 +248,82 @@ func (m *Maroto) addRow(r core.Row) {
 	maxHeight := m.cell.Height
 
 	r.SetConfig(m.config)
-	rowHeight := r.GetHeight(m.provider, &m.cell)
-	sumHeight := rowHeight + m.currentHeight + m.footerHeight
+	located := m.locateRow(r)
+	footnotes := located.GetFootnotes()
+	m.numberFootnotes(footnotes)
+
+	// the anchors of the rows already added are known, so the row is measured with their pages
+	rowHeight := r.GetHeight(m.layout.Resolve(), &m.cell)
+	pageFootnotes := append(append([]*entity.Footnote{}, m.footnotes...), footnotes...)
+	sumHeight := rowHeight + m.currentHeight + m.footerHeight + m.getRowsHeight(m.getFootnoteRows(pageFootnotes)...)
 
 	// Row smaller than the remain space on page
 	if sumHeight <= maxHeight {
 		m.currentHeight += rowHeight
 		m.rows = append(m.rows, r)
+		m.addFootnotes(footnotes)
+		m.addAnchors(located)
+		m.addWatermark()
 		return
 	}
 
+	// The header of a group of rows goes to the new page with its row
+	m.removeRowHeader(r)
+
 	// As row will extrapolate page, we will add empty space
 	// on the page to force a new page
 	m.fillPageToAddNew()
 
 	m.addHeader()
+	m.addRowHeader(r)
 
 	// AddRows row on the new page
+	m.numberFootnotes(footnotes)
 	m.currentHeight += rowHeight
 	m.rows = append(m.rows, r)
+	m.addFootnotes(footnotes)
+	m.addAnchors(located)
+	m.addWatermark()
+}
+
+// addWatermark keeps the watermark of the page whose rows are being added for the page being built.
+func (m *Maroto) addWatermark() {
+	if m.watermark != nil {
+		m.pageWatermark = m.watermark
+	}
+}
+
+// record adds something to the document and keeps it, so the document can be built again.
+func (m *Maroto) record(step func()) {
+	m.steps = append(m.steps, step)
+	step()
+}
+
+// rebuild builds the pages again when headings were added after rows created from the headings
+// of the document, like a table of contents, since those headings were unknown when the rows were
+// added. The pages are built knowing every heading, so the rows are created for all of them, and
+// they are built once, the document is not built again while no heading is added.
+func (m *Maroto) rebuild() {
+	if !m.stale {
+		return
+	}
+
+	headings := m.layout.GetHeadings()
+
+	m.pages, m.rows, m.header, m.footer, m.footnotes = nil, nil, nil, nil, nil
+	m.headerHeight, m.footerHeight, m.currentHeight, m.footnoteCount = 0, 0, 0, 0
+	m.watermark, m.pageWatermark = nil, nil
+	m.layout = locator.New(m.provider)
+	for _, heading := range headings {
+		m.layout.AddHeading(heading)
+	}
+
+	m.expanded, m.stale = false, false
+	for _, step := range m.steps {
+		step()
+	}
+
+	m.fillPageToAddNew()
 }
 
 func (m *Maroto) addHeader() {
@@ -219,8 +333,112 @@ func (m *Maroto) addHeader() {
 	}
 }
 
+// addRowHeader repeats the header of a group of rows, like a table,
+// when one of its rows is moved to a new page.
+func (m *Maroto) addRowHeader(r core.Row) {
+	headeredRow, ok := r.(core.HeaderedRow)
+	if !ok {
+		return
+	}
+
+	for _, headerRow := range headeredRow.GetHeader() {
+		headerRow.SetConfig(m.config)
+		m.currentHeight += headerRow.GetHeight(m.provider, &m.cell)
+		m.rows = append(m.rows, headerRow)
+	}
+}
+
+// removeRowHeader removes the header of a group of rows from the end of the page when the first
+// row of the group is moved to a new page, so the header is not left alone at the bottom of the page.
+// The header is kept when it is the only content of the page, since the new page would be the same.
+func (m *Maroto) removeRowHeader(r core.Row) {
+	headeredRow, ok := r.(core.HeaderedRow)
+	if !ok {
+		return
+	}
+
+	header := headeredRow.GetHeader()
+	start := len(m.rows) - len(header)
+	if len(header) == 0 || start <= len(m.header) {
+		return
+	}
+
+	for i, headerRow := range header {
+		if m.rows[start+i] != headerRow {
+			return
+		}
+	}
+
+	for _, headerRow := range header {
+		m.currentHeight -= headerRow.GetHeight(m.provider, &m.cell)
+	}
+	m.rows = m.rows[:start]
+}
+
+// locateRow renders the cols of a row without drawing them to find the anchors and the headings
+// they add and the footnotes they refer.
+func (m *Maroto) locateRow(r core.Row) *locator.Locator {
+	located := locator.New(m.provider)
+	for _, c := range r.GetColumns() {
+		c.Render(located, m.cell, false)
+	}
+
+	return located
+}
+
+// addAnchors records the anchors and the headings of a row added to the current page.
+func (m *Maroto) addAnchors(located *locator.Locator) {
+	known := len(m.layout.GetHeadings())
+	m.layout.SetPage(len(m.pages) + 1)
+	m.layout.AddAnchors(located)
+
+	if m.expanded && len(m.layout.GetHeadings()) > known {
+		m.stale = true
+	}
+}
+
+// numberFootnotes gives the numbers to the footnotes of a row added to the current page.
+func (m *Maroto) numberFootnotes(footnotes []*entity.Footnote) {
+	first := m.footnoteCount
+	if m.config.Footnote != nil && m.config.Footnote.Numbering == props.PerPage {
+		first = len(m.footnotes)
+	}
+
+	for i, note := range footnotes {
+		note.Number = first + i + 1
+	}
+}
+
+func (m *Maroto) addFootnotes(footnotes []*entity.Footnote) {
+	m.footnotes = append(m.footnotes, footnotes...)
+	m.footnoteCount += len(footnotes)
+}
+
+// getFootnoteRows returns the rows written above the footer of a page, a separator line
+// followed by the footnotes of the page.
+func (m *Maroto) getFootnoteRows(footnotes []*entity.Footnote) []core.Row {
+	if len(footnotes) == 0 {
+		return nil
+	}
+
+	separator := line.NewCol(max(m.config.MaxGridSize/3, 1), props.Line{SizePercent: 100, OffsetPercent: 50})
+	rows := []core.Row{row.New(footnoteSeparatorHeight).Add(separator)}
+
+	var ps []props.Footnote
+	if m.config.Footnote != nil {
+		ps = append(ps, *m.config.Footnote)
+	}
+
+	for _, note := range footnotes {
+		rows = append(rows, footnote.NewNoteRow(note, ps...))
+	}
+
+	return rows
+}
+
 func (m *Maroto) fillPageToAddNew() {
-	space := m.cell.Height - m.currentHeight - m.footerHeight
+	footnoteRows := m.getFootnoteRows(m.footnotes)
+	space := m.cell.Height - m.currentHeight - m.footerHeight - m.getRowsHeight(footnoteRows...)
 
 	// Truncate space to 9 decimal places to avoid rounding errors
 	space = math.Floor(space*math.Pow10(9)) / math.Pow10(9)
@@ -230,6 +448,7 @@ func (m *Maroto) fillPageToAddNew() {
 	spaceRow.Add(c)
 
 	m.rows = append(m.rows, spaceRow)
+	m.rows = append(m.rows, footnoteRows...)
 	m.rows = append(m.rows, m.footer...)
 
 	var p core.Page
@@ -239,12 +458,18 @@ func (m *Maroto) fillPageToAddNew() {
 		p = page.New()
 	}
 
+	if m.pageWatermark != nil {
+		p.WithWatermark(m.pageWatermark)
+	}
+
 	p.SetConfig(m.config)
 	p.Add(m.rows...)
 
 	m.pages = append(m.pages, p)
 	m.rows = nil
 	m.currentHeight = 0
+	m.footnotes = nil
+	m.pageWatermark = nil
 }
 
 func (m *Maroto) setConfig() {
@@ -256,9 +481,13 @@ func (m *Maroto) setConfig() {
 
 func (m *Maroto) generate() (core.Document, error) {
 	innerCtx := m.cell.Copy()
+	provider, err := m.locate()
+	if err != nil {
+		return nil, err
+	}
 
 	for _, page := range m.pages {
-		page.Render(m.provider, innerCtx)
+		page.Render(provider, innerCtx)
 	}
 
 	documentBytes, err := m.provider.GenerateBytes()
@@ -269,6 +498,24 @@ func (m *Maroto) generate() (core.Document, error) {
 	return core.NewPDF(documentBytes), nil
 }
 
+// locate renders the pages without drawing them to find the page of every anchor, the returned
+// provider knows the pages of the anchors, so they can be referred before they are rendered.
+func (m *Maroto) locate() (core.Provider, error) {
+	innerCtx := m.cell.Copy()
+	locator := locator.New(m.provider)
+
+	for i, page := range m.pages {
+		locator.SetPage(i + 1)
+		page.Render(locator, innerCtx)
+	}
+
+	if unknown := locator.GetUnknownAnchors(); len(unknown) > 0 {
+		return nil, fmt.Errorf("references to unknown anchors: %s", strings.Join(unknown, ", "))
+	}
+
+	return locator.Resolve(), nil
+}
+
 func (m *Maroto) getRowsHeight(rows ...core.Row) float64 {
 	var height float64
 	for _, r := range rows {
@@ -2,15 +2,24 @@ package maroto_test
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/johnfercher/maroto/v2/pkg/components/text"
 
+	"github.com/johnfercher/maroto/v2/pkg/components/anchor"
+	"github.com/johnfercher/maroto/v2/pkg/components/block"
 	"github.com/johnfercher/maroto/v2/pkg/components/col"
+	"github.com/johnfercher/maroto/v2/pkg/components/footnote"
+	"github.com/johnfercher/maroto/v2/pkg/components/index"
 	"github.com/johnfercher/maroto/v2/pkg/components/page"
 	"github.com/johnfercher/maroto/v2/pkg/components/row"
+	"github.com/johnfercher/maroto/v2/pkg/components/table"
+	"github.com/johnfercher/maroto/v2/pkg/components/toc"
 	"github.com/johnfercher/maroto/v2/pkg/config"
 	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
 	"github.com/johnfercher/maroto/v2/pkg/test"
 
 	"github.com/johnfercher/maroto/v2"
@@ -161,6 +170,149 @@ func TestMaroto_AddRows(t *testing.T) {
 	})
 }
 
+func TestMaroto_AddRows_Table(t *testing.T) {
+	t.Run("when table rows do not fit on the current page, should repeat the table header on the new page", func(t *testing.T) {
+		// Arrange
+		sut := maroto.New()
+		tbl := table.New([]table.Column{{Title: "Key", Size: 6}, {Title: "Value", Size: 6}}, props.Table{RowHeight: 10})
+		for i := 0; i < 40; i++ {
+			tbl.Add([]string{fmt.Sprintf("key %d", i), fmt.Sprintf("%d", i)})
+		}
+		rows, _ := tbl.Build()
+
+		// Act
+		sut.AddRows(rows...)
+
+		// Assert
+		pages := sut.GetStructure().GetNexts()
+		assert.Len(t, pages, 2)
+		for _, p := range pages {
+			header := p.GetNexts()[0]
+			assert.Equal(t, "Key", header.GetNexts()[0].GetNexts()[0].GetData().Value)
+			assert.Equal(t, "Value", header.GetNexts()[1].GetNexts()[0].GetData().Value)
+		}
+		assert.Equal(t, "key 25", pages[1].GetNexts()[1].GetNexts()[0].GetNexts()[0].GetData().Value)
+	})
+	t.Run("when only the table header fits on the current page, should move the header with the first row", func(t *testing.T) {
+		// Arrange
+		sut := maroto.New()
+		sut.AddRow(260, col.New(12))
+		tbl := table.New([]table.Column{{Title: "Key", Size: 6}, {Title: "Value", Size: 6}}, props.Table{RowHeight: 10})
+		tbl.Add([]string{"key 0", "0"})
+		rows, _ := tbl.Build()
+
+		// Act
+		sut.AddRows(rows...)
+
+		// Assert
+		pages := sut.GetStructure().GetNexts()
+		assert.Len(t, pages, 2)
+		assert.Len(t, pages[0].GetNexts(), 2)
+		assert.Equal(t, 260.0, pages[0].GetNexts()[0].GetData().Value)
+		assert.Equal(t, "Key", pages[1].GetNexts()[0].GetNexts()[0].GetNexts()[0].GetData().Value)
+		assert.Equal(t, "key 0", pages[1].GetNexts()[1].GetNexts()[0].GetNexts()[0].GetData().Value)
+	})
+	t.Run("when the table header is the only content of the page, should keep it on the page", func(t *testing.T) {
+		// Arrange
+		sut := maroto.New()
+		header := text.NewRow(10, "Key")
+		tall := &headeredRow{Row: row.New(280).Add(col.New(12)), header: header}
+
+		// Act
+		sut.AddRows(header, tall)
+
+		// Assert
+		pages := sut.GetStructure().GetNexts()
+		assert.Equal(t, "Key", pages[0].GetNexts()[0].GetNexts()[0].GetNexts()[0].GetData().Value)
+	})
+}
+
+// headeredRow is a row of a group whose header is repeated on new pages.
+type headeredRow struct {
+	core.Row
+	header core.Row
+}
+
+func (h *headeredRow) GetHeader() []core.Row {
+	return []core.Row{h.header}
+}
+
+func TestMaroto_AddRows_Block(t *testing.T) {
+	t.Run("when block rows do not fit on the current page, should repeat the header and keep spanned rows together", func(t *testing.T) {
+		// Arrange
+		sut := maroto.New()
+		blk := block.New([]int{4, 4, 4}, props.Block{RowHeight: 10}).
+			AddHeader(block.NewCell(text.New("Quarter")), block.NewCell(text.New("Month")), block.NewCell(text.New("Value")))
+		for i := 0; i < 10; i++ {
+			quarter := block.NewCell(text.New(fmt.Sprintf("Q%d", i))).WithSpan(3, 1)
+			blk.Add(quarter, block.NewCell(text.New("first")), block.NewCell(text.New("1")))
+			blk.Add(block.NewCell(text.New("second")), block.NewCell(text.New("2")))
+			blk.Add(block.NewCell(text.New("third")), block.NewCell(text.New("3")))
+		}
+		rows, _ := blk.Build()
+
+		// Act
+		sut.AddRows(rows...)
+
+		// Assert
+		pages := sut.GetStructure().GetNexts()
+		assert.Len(t, pages, 2)
+		for _, p := range pages {
+			header := p.GetNexts()[0]
+			assert.Equal(t, "block", header.GetData().Type)
+			assert.Equal(t, "Quarter", header.GetNexts()[0].GetNexts()[0].GetNexts()[0].GetData().Value)
+		}
+		first := pages[1].GetNexts()[1]
+		assert.Equal(t, 3, first.GetData().Value)
+		assert.Equal(t, "Q8", first.GetNexts()[0].GetNexts()[0].GetNexts()[0].GetData().Value)
+	})
+}
+
+func TestMaroto_AddRows_Footnote(t *testing.T) {
+	t.Run("when rows refer to footnotes, should write them numbered above the footer", func(t *testing.T) {
+		// Arrange
+		sut := maroto.New()
+		_ = sut.RegisterFooter(text.NewRow(10, "footer"))
+
+		// Act
+		sut.AddRows(
+			footnote.NewAutoRow("first clause", "first note"),
+			footnote.NewAutoRow("second clause", "second note"),
+		)
+
+		// Assert
+		rows := sut.GetStructure().GetNexts()[0].GetNexts()
+		assert.Len(t, rows, 7)
+		assert.Equal(t, "line", rows[3].GetNexts()[0].GetNexts()[0].GetData().Type)
+		for i, value := range []string{"first note", "second note"} {
+			note := rows[4+i].GetNexts()[0].GetNexts()[0].GetData()
+			assert.Equal(t, value, note.Value)
+			assert.Equal(t, i+1, note.Details["number"])
+		}
+		assert.Equal(t, "footer", rows[6].GetNexts()[0].GetNexts()[0].GetData().Value)
+	})
+	t.Run("when the footnote does not fit, should move the row to the next page", func(t *testing.T) {
+		// Arrange
+		sut := maroto.New(config.NewBuilder().WithFootnote(props.Footnote{Numbering: props.PerPage}).Build())
+		sut.AddRows(footnote.NewAutoRow("first clause", "first note"))
+		sut.AddRows(row.New(250))
+
+		// Act
+		sut.AddRows(footnote.NewRow(5, "second clause", "second note"))
+
+		// Assert
+		pages := sut.GetStructure().GetNexts()
+		assert.Len(t, pages, 2)
+		assert.Equal(t, "second clause", pages[1].GetNexts()[0].GetNexts()[0].GetNexts()[0].GetData().Value)
+		for i, value := range []string{"first note", "second note"} {
+			rows := pages[i].GetNexts()
+			note := rows[len(rows)-1].GetNexts()[0].GetNexts()[0].GetData()
+			assert.Equal(t, value, note.Value)
+			assert.Equal(t, 1, note.Details["number"])
+		}
+	})
+}
+
 func TestMaroto_AddAutoRow(t *testing.T) {
 	t.Run("When 100 automatic rows are sent, it should create 2 pages", func(t *testing.T) {
 		// Arrange
@@ -295,6 +447,184 @@ func TestMaroto_Generate(t *testing.T) {
 		// Assert
 		test.New(t).Assert(sut.GetStructure()).Equals("maroto_page_number.json")
 	})
+	t.Run("when a toc lists headings of the next pages, should write their pages", func(t *testing.T) {
+		// Arrange
+		sut := maroto.New(config.NewBuilder().WithCompression(false).Build())
+		sut.AddRows(toc.New())
+		sut.AddRow(250, col.New(12).Add(toc.NewHeading("Introduction", 0)))
+		sut.AddRow(50, col.New(12).Add(toc.NewHeading("Scope", 1)))
+
+		// Act
+		doc, err := sut.Generate()
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Contains(t, string(doc.GetBytes()), "(Scope) Tj")
+		assert.Contains(t, string(doc.GetBytes()), "(2) Tj")
+	})
+	t.Run("when a toc is added before its headings, should reserve the space of its entries", func(t *testing.T) {
+		// Arrange
+		sut := maroto.New(config.NewBuilder().WithCompression(false).Build())
+		sut.AddRows(toc.New())
+		sut.AddRow(272, col.New(12).Add(toc.NewHeading("Introduction", 0)))
+		sut.AddRow(10, col.New(12).Add(toc.NewHeading("Scope", 1)))
+
+		// Act
+		doc, err := sut.Generate()
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Contains(t, string(doc.GetBytes()), "(Introduction) Tj")
+		assert.Contains(t, string(doc.GetBytes()), "(2) Tj")
+		assert.Contains(t, string(doc.GetBytes()), "(3) Tj")
+	})
+	t.Run("when the structure of a document with a toc is built before it, should not repeat entries and notes", func(t *testing.T) {
+		// Arrange
+		sut := maroto.New(config.NewBuilder().WithCompression(false).Build())
+		sut.AddRows(toc.New())
+		sut.AddRow(10, col.New(12).Add(toc.NewHeading("Introduction", 0)))
+		sut.AddRows(footnote.NewAutoRow("first clause", "first note"))
+		sut.AddRows(footnote.NewAutoRow("second clause", "second note"))
+		sut.AddRow(250, col.New(12).Add(toc.NewHeading("Scope", 1)))
+		sut.GetStructure()
+
+		// Act
+		doc, err := sut.Generate()
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, 1, strings.Count(string(doc.GetBytes()), "(Introduction) Tj"))
+		assert.Equal(t, 1, strings.Count(string(doc.GetBytes()), "(Scope) Tj"))
+		assert.Equal(t, 2, strings.Count(string(doc.GetBytes()), "(note) Tj"))
+		assert.NotContains(t, string(doc.GetBytes()), "(3) Tj")
+		assert.NotContains(t, string(doc.GetBytes()), "(4) Tj")
+	})
+	t.Run("when a heading is added after the structure is built, should list it in the toc", func(t *testing.T) {
+		// Arrange
+		sut := maroto.New(config.NewBuilder().WithCompression(false).Build())
+		sut.AddRows(toc.New())
+		sut.AddRow(10, col.New(12).Add(toc.NewHeading("Introduction", 0)))
+		sut.GetStructure()
+		sut.AddRow(10, col.New(12).Add(toc.NewHeading("Scope", 1)))
+
+		// Act
+		doc, err := sut.Generate()
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, 1, strings.Count(string(doc.GetBytes()), "(Introduction) Tj"))
+		assert.Equal(t, 1, strings.Count(string(doc.GetBytes()), "(Scope) Tj"))
+	})
+	t.Run("when a reference points to an unknown anchor, should return error", func(t *testing.T) {
+		// Arrange
+		sut := maroto.New()
+		sut.AddRow(10, col.New(12).Add(anchor.New("summary")))
+		sut.AddRows(anchor.NewReferenceRow(10, "details", "see page {page}"))
+
+		// Act
+		doc, err := sut.Generate()
+
+		// Assert
+		assert.Nil(t, doc)
+		assert.Equal(t, "references to unknown anchors: details", err.Error())
+	})
+	t.Run("when a reference points to an anchor of the next page, should write its page", func(t *testing.T) {
+		// Arrange
+		sut := maroto.New(config.NewBuilder().WithCompression(false).Build())
+		sut.AddRows(anchor.NewReferenceRow(250, "details", "see page {page}"))
+		sut.AddRow(50, col.New(12).Add(anchor.New("details")))
+
+		// Act
+		doc, err := sut.Generate()
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Contains(t, string(doc.GetBytes()), "(see page 2) Tj")
+	})
+	t.Run("when an index lists terms of many pages, should merge consecutive pages", func(t *testing.T) {
+		// Arrange
+		sut := maroto.New(config.NewBuilder().WithCompression(false).Build())
+		idx := index.New(props.Index{RangeSeparator: "-"})
+		for i := 0; i < 4; i++ {
+			sut.AddRow(250, col.New(12).Add(idx.Mark("pump")))
+		}
+		sut.AddRow(250, col.New(12).Add(idx.Mark("valve")))
+		sut.AddRow(250, col.New(12).Add(idx.Mark("pump")))
+		sut.AddRows(idx.GetRows()...)
+
+		// Act
+		doc, err := sut.Generate()
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Contains(t, string(doc.GetBytes()), "(pump, 1-4, 6) Tj")
+		assert.Contains(t, string(doc.GetBytes()), "(valve, 5) Tj")
+	})
+	t.Run("when the document has a background watermark, should draw it once in each page", func(t *testing.T) {
+		// Arrange
+		cfg := config.NewBuilder().
+			WithCompression(false).
+			WithWatermark(entity.NewTextWatermark("DRAFT")).
+			Build()
+
+		sut := maroto.New(cfg)
+		for i := 0; i < 3; i++ {
+			sut.AddRows(text.NewRow(200, "content"))
+		}
+
+		// Act
+		doc, err := sut.Generate()
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, []int{1, 1, 1}, countPerPage(doc, "(DRAFT) Tj"))
+	})
+	t.Run("when a page has a background watermark, should draw it only in its page", func(t *testing.T) {
+		// Arrange
+		sut := maroto.New(config.NewBuilder().WithCompression(false).Build())
+		sut.AddRows(text.NewRow(200, "content"))
+		sut.AddPages(page.New().Add(text.NewRow(200, "content")).WithWatermark(entity.NewTextWatermark("PAID")))
+		sut.AddRows(text.NewRow(200, "content"))
+
+		// Act
+		doc, err := sut.Generate()
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, []int{0, 1, 0}, countPerPage(doc, "(PAID) Tj"))
+	})
+	t.Run("when a block is moved to a new page, should draw it in the new page", func(t *testing.T) {
+		// Arrange
+		rows, _ := block.New([]int{6, 6}, props.Block{RowHeight: 10}).
+			Add(block.NewCell(text.New("Quarter")).WithSpan(2, 1), block.NewCell(text.New("first"))).
+			Add(block.NewCell(text.New("second"))).
+			Build()
+
+		sut := maroto.New(config.NewBuilder().WithCompression(false).Build())
+		sut.AddRows(text.NewRow(250, "content"))
+		sut.AddRows(rows...)
+		sut.AddRows(text.NewRow(10, "after"))
+
+		// Act
+		doc, err := sut.Generate()
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, []int{0, 1}, countPerPage(doc, "(Quarter) Tj"))
+		assert.Equal(t, []int{0, 1}, countPerPage(doc, "(second) Tj"))
+		assert.Equal(t, []int{0, 1}, countPerPage(doc, "(after) Tj"))
+	})
+}
+
+// countPerPage returns how many times the value is written in the content of each page of
+// a document without compression, where the pages are the only streams.
+func countPerPage(doc core.Document, value string) []int {
+	streams := strings.Split(string(doc.GetBytes()), "endstream")
+	counts := make([]int, len(streams)-1)
+	for i, stream := range streams[:len(streams)-1] {
+		counts[i] = strings.Count(stream, value)
+	}
+	return counts
 }
 
 func TestMaroto_FitlnCurrentPage(t *testing.T) {
@@ -0,0 +1,317 @@
+// Code generated by mockery v2.49.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	matrixcode "github.com/johnfercher/maroto/v2/pkg/consts/matrixcode"
+	entity "github.com/johnfercher/maroto/v2/pkg/core/entity"
+
+	mock "github.com/stretchr/testify/mock"
+
+	props "github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+// Code is an autogenerated mock type for the Code type
+type Code struct {
+	mock.Mock
+}
+
+type Code_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Code) EXPECT() *Code_Expecter {
+	return &Code_Expecter{mock: &_m.Mock}
+}
+
+// AddBar provides a mock function with given fields: value, cell, margins, prop
+func (_m *Code) AddBar(value string, cell *entity.Cell, margins *entity.Margins, prop *props.Barcode) (*entity.Cell, error) {
+	ret := _m.Called(value, cell, margins, prop)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddBar")
+	}
+
+	var r0 *entity.Cell
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, *entity.Cell, *entity.Margins, *props.Barcode) (*entity.Cell, error)); ok {
+		return rf(value, cell, margins, prop)
+	}
+	if rf, ok := ret.Get(0).(func(string, *entity.Cell, *entity.Margins, *props.Barcode) *entity.Cell); ok {
+		r0 = rf(value, cell, margins, prop)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Cell)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *entity.Cell, *entity.Margins, *props.Barcode) error); ok {
+		r1 = rf(value, cell, margins, prop)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Code_AddBar_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddBar'
+type Code_AddBar_Call struct {
+	*mock.Call
+}
+
+// AddBar is a helper method to define mock.On call
+//   - value string
+//   - cell *entity.Cell
+//   - margins *entity.Margins
+//   - prop *props.Barcode
+func (_e *Code_Expecter) AddBar(value interface{}, cell interface{}, margins interface{}, prop interface{}) *Code_AddBar_Call {
+	return &Code_AddBar_Call{Call: _e.mock.On("AddBar", value, cell, margins, prop)}
+}
+
+func (_c *Code_AddBar_Call) Run(run func(value string, cell *entity.Cell, margins *entity.Margins, prop *props.Barcode)) *Code_AddBar_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(*entity.Cell), args[2].(*entity.Margins), args[3].(*props.Barcode))
+	})
+	return _c
+}
+
+func (_c *Code_AddBar_Call) Return(_a0 *entity.Cell, _a1 error) *Code_AddBar_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Code_AddBar_Call) RunAndReturn(run func(string, *entity.Cell, *entity.Margins, *props.Barcode) (*entity.Cell, error)) *Code_AddBar_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddMatrix provides a mock function with given fields: value, symbology, cell, margins, prop
+func (_m *Code) AddMatrix(value string, symbology matrixcode.Symbology, cell *entity.Cell, margins *entity.Margins, prop *props.Rect) error {
+	ret := _m.Called(value, symbology, cell, margins, prop)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddMatrix")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, matrixcode.Symbology, *entity.Cell, *entity.Margins, *props.Rect) error); ok {
+		r0 = rf(value, symbology, cell, margins, prop)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Code_AddMatrix_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddMatrix'
+type Code_AddMatrix_Call struct {
+	*mock.Call
+}
+
+// AddMatrix is a helper method to define mock.On call
+//   - value string
+//   - symbology matrixcode.Symbology
+//   - cell *entity.Cell
+//   - margins *entity.Margins
+//   - prop *props.Rect
+func (_e *Code_Expecter) AddMatrix(value interface{}, symbology interface{}, cell interface{}, margins interface{}, prop interface{}) *Code_AddMatrix_Call {
+	return &Code_AddMatrix_Call{Call: _e.mock.On("AddMatrix", value, symbology, cell, margins, prop)}
+}
+
+func (_c *Code_AddMatrix_Call) Run(run func(value string, symbology matrixcode.Symbology, cell *entity.Cell, margins *entity.Margins, prop *props.Rect)) *Code_AddMatrix_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(matrixcode.Symbology), args[2].(*entity.Cell), args[3].(*entity.Margins), args[4].(*props.Rect))
+	})
+	return _c
+}
+
+func (_c *Code_AddMatrix_Call) Return(_a0 error) *Code_AddMatrix_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Code_AddMatrix_Call) RunAndReturn(run func(string, matrixcode.Symbology, *entity.Cell, *entity.Margins, *props.Rect) error) *Code_AddMatrix_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddQr provides a mock function with given fields: value, cell, margins, prop
+func (_m *Code) AddQr(value string, cell *entity.Cell, margins *entity.Margins, prop *props.Rect) error {
+	ret := _m.Called(value, cell, margins, prop)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddQr")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, *entity.Cell, *entity.Margins, *props.Rect) error); ok {
+		r0 = rf(value, cell, margins, prop)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Code_AddQr_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddQr'
+type Code_AddQr_Call struct {
+	*mock.Call
+}
+
+// AddQr is a helper method to define mock.On call
+//   - value string
+//   - cell *entity.Cell
+//   - margins *entity.Margins
+//   - prop *props.Rect
+func (_e *Code_Expecter) AddQr(value interface{}, cell interface{}, margins interface{}, prop interface{}) *Code_AddQr_Call {
+	return &Code_AddQr_Call{Call: _e.mock.On("AddQr", value, cell, margins, prop)}
+}
+
+func (_c *Code_AddQr_Call) Run(run func(value string, cell *entity.Cell, margins *entity.Margins, prop *props.Rect)) *Code_AddQr_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(*entity.Cell), args[2].(*entity.Margins), args[3].(*props.Rect))
+	})
+	return _c
+}
+
+func (_c *Code_AddQr_Call) Return(_a0 error) *Code_AddQr_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Code_AddQr_Call) RunAndReturn(run func(string, *entity.Cell, *entity.Margins, *props.Rect) error) *Code_AddQr_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMatrixDimensions provides a mock function with given fields: value, symbology
+func (_m *Code) GetMatrixDimensions(value string, symbology matrixcode.Symbology) (*entity.Dimensions, error) {
+	ret := _m.Called(value, symbology)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMatrixDimensions")
+	}
+
+	var r0 *entity.Dimensions
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, matrixcode.Symbology) (*entity.Dimensions, error)); ok {
+		return rf(value, symbology)
+	}
+	if rf, ok := ret.Get(0).(func(string, matrixcode.Symbology) *entity.Dimensions); ok {
+		r0 = rf(value, symbology)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Dimensions)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, matrixcode.Symbology) error); ok {
+		r1 = rf(value, symbology)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Code_GetMatrixDimensions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMatrixDimensions'
+type Code_GetMatrixDimensions_Call struct {
+	*mock.Call
+}
+
+// GetMatrixDimensions is a helper method to define mock.On call
+//   - value string
+//   - symbology matrixcode.Symbology
+func (_e *Code_Expecter) GetMatrixDimensions(value interface{}, symbology interface{}) *Code_GetMatrixDimensions_Call {
+	return &Code_GetMatrixDimensions_Call{Call: _e.mock.On("GetMatrixDimensions", value, symbology)}
+}
+
+func (_c *Code_GetMatrixDimensions_Call) Run(run func(value string, symbology matrixcode.Symbology)) *Code_GetMatrixDimensions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(matrixcode.Symbology))
+	})
+	return _c
+}
+
+func (_c *Code_GetMatrixDimensions_Call) Return(_a0 *entity.Dimensions, _a1 error) *Code_GetMatrixDimensions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Code_GetMatrixDimensions_Call) RunAndReturn(run func(string, matrixcode.Symbology) (*entity.Dimensions, error)) *Code_GetMatrixDimensions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetQrDimensions provides a mock function with given fields: value
+func (_m *Code) GetQrDimensions(value string) (*entity.Dimensions, error) {
+	ret := _m.Called(value)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetQrDimensions")
+	}
+
+	var r0 *entity.Dimensions
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*entity.Dimensions, error)); ok {
+		return rf(value)
+	}
+	if rf, ok := ret.Get(0).(func(string) *entity.Dimensions); ok {
+		r0 = rf(value)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Dimensions)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(value)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Code_GetQrDimensions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetQrDimensions'
+type Code_GetQrDimensions_Call struct {
+	*mock.Call
+}
+
+// GetQrDimensions is a helper method to define mock.On call
+//   - value string
+func (_e *Code_Expecter) GetQrDimensions(value interface{}) *Code_GetQrDimensions_Call {
+	return &Code_GetQrDimensions_Call{Call: _e.mock.On("GetQrDimensions", value)}
+}
+
+func (_c *Code_GetQrDimensions_Call) Run(run func(value string)) *Code_GetQrDimensions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *Code_GetQrDimensions_Call) Return(_a0 *entity.Dimensions, _a1 error) *Code_GetQrDimensions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Code_GetQrDimensions_Call) RunAndReturn(run func(string) (*entity.Dimensions, error)) *Code_GetQrDimensions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewCode creates a new instance of Code. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCode(t interface {
+	mock.TestingT
+	Cleanup(func())
+},
+) *Code {
+	mock := &Code{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -3,7 +3,9 @@
 package mocks
 
 import (
+	align "github.com/johnfercher/maroto/v2/pkg/consts/align"
 	core "github.com/johnfercher/maroto/v2/pkg/core"
+
 	entity "github.com/johnfercher/maroto/v2/pkg/core/entity"
 
 	mock "github.com/stretchr/testify/mock"
@@ -294,6 +296,67 @@ func (_c *Col_SetConfig_Call) RunAndReturn(run func(*entity.Config)) *Col_SetCon
 	return _c
 }
 
+// WithStack provides a mock function with given fields: gap
+func (_m *Col) WithStack(gap ...float64) core.Col {
+	_va := make([]interface{}, len(gap))
+	for _i := range gap {
+		_va[_i] = gap[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithStack")
+	}
+
+	var r0 core.Col
+	if rf, ok := ret.Get(0).(func(...float64) core.Col); ok {
+		r0 = rf(gap...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(core.Col)
+		}
+	}
+
+	return r0
+}
+
+// Col_WithStack_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithStack'
+type Col_WithStack_Call struct {
+	*mock.Call
+}
+
+// WithStack is a helper method to define mock.On call
+//   - gap ...float64
+func (_e *Col_Expecter) WithStack(gap ...interface{}) *Col_WithStack_Call {
+	return &Col_WithStack_Call{Call: _e.mock.On("WithStack",
+		append([]interface{}{}, gap...)...)}
+}
+
+func (_c *Col_WithStack_Call) Run(run func(gap ...float64)) *Col_WithStack_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]float64, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(float64)
+			}
+		}
+		run(variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Col_WithStack_Call) Return(_a0 core.Col) *Col_WithStack_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Col_WithStack_Call) RunAndReturn(run func(...float64) core.Col) *Col_WithStack_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // WithStyle provides a mock function with given fields: style
 func (_m *Col) WithStyle(style *props.Cell) core.Col {
 	ret := _m.Called(style)
@@ -342,6 +405,54 @@ func (_c *Col_WithStyle_Call) RunAndReturn(run func(*props.Cell) core.Col) *Col_
 	return _c
 }
 
+// WithVerticalAlign provides a mock function with given fields: verticalAlign
+func (_m *Col) WithVerticalAlign(verticalAlign align.Type) core.Col {
+	ret := _m.Called(verticalAlign)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithVerticalAlign")
+	}
+
+	var r0 core.Col
+	if rf, ok := ret.Get(0).(func(align.Type) core.Col); ok {
+		r0 = rf(verticalAlign)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(core.Col)
+		}
+	}
+
+	return r0
+}
+
+// Col_WithVerticalAlign_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithVerticalAlign'
+type Col_WithVerticalAlign_Call struct {
+	*mock.Call
+}
+
+// WithVerticalAlign is a helper method to define mock.On call
+//   - verticalAlign align.Type
+func (_e *Col_Expecter) WithVerticalAlign(verticalAlign interface{}) *Col_WithVerticalAlign_Call {
+	return &Col_WithVerticalAlign_Call{Call: _e.mock.On("WithVerticalAlign", verticalAlign)}
+}
+
+func (_c *Col_WithVerticalAlign_Call) Run(run func(verticalAlign align.Type)) *Col_WithVerticalAlign_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(align.Type))
+	})
+	return _c
+}
+
+func (_c *Col_WithVerticalAlign_Call) Return(_a0 core.Col) *Col_WithVerticalAlign_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Col_WithVerticalAlign_Call) RunAndReturn(run func(align.Type) core.Col) *Col_WithVerticalAlign_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewCol creates a new instance of Col. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewCol(t interface {
@@ -0,0 +1,407 @@
+// Code generated by mockery v2.49.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	core "github.com/johnfercher/maroto/v2/pkg/core"
+	entity "github.com/johnfercher/maroto/v2/pkg/core/entity"
+
+	mock "github.com/stretchr/testify/mock"
+
+	node "github.com/johnfercher/go-tree/node"
+
+	props "github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+// ExpandableRow is an autogenerated mock type for the ExpandableRow type
+type ExpandableRow struct {
+	mock.Mock
+}
+
+type ExpandableRow_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ExpandableRow) EXPECT() *ExpandableRow_Expecter {
+	return &ExpandableRow_Expecter{mock: &_m.Mock}
+}
+
+// Add provides a mock function with given fields: cols
+func (_m *ExpandableRow) Add(cols ...core.Col) core.Row {
+	_va := make([]interface{}, len(cols))
+	for _i := range cols {
+		_va[_i] = cols[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Add")
+	}
+
+	var r0 core.Row
+	if rf, ok := ret.Get(0).(func(...core.Col) core.Row); ok {
+		r0 = rf(cols...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(core.Row)
+		}
+	}
+
+	return r0
+}
+
+// ExpandableRow_Add_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Add'
+type ExpandableRow_Add_Call struct {
+	*mock.Call
+}
+
+// Add is a helper method to define mock.On call
+//   - cols ...core.Col
+func (_e *ExpandableRow_Expecter) Add(cols ...interface{}) *ExpandableRow_Add_Call {
+	return &ExpandableRow_Add_Call{Call: _e.mock.On("Add",
+		append([]interface{}{}, cols...)...)}
+}
+
+func (_c *ExpandableRow_Add_Call) Run(run func(cols ...core.Col)) *ExpandableRow_Add_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]core.Col, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(core.Col)
+			}
+		}
+		run(variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *ExpandableRow_Add_Call) Return(_a0 core.Row) *ExpandableRow_Add_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ExpandableRow_Add_Call) RunAndReturn(run func(...core.Col) core.Row) *ExpandableRow_Add_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Expand provides a mock function with given fields: provider
+func (_m *ExpandableRow) Expand(provider core.Provider) []core.Row {
+	ret := _m.Called(provider)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Expand")
+	}
+
+	var r0 []core.Row
+	if rf, ok := ret.Get(0).(func(core.Provider) []core.Row); ok {
+		r0 = rf(provider)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]core.Row)
+		}
+	}
+
+	return r0
+}
+
+// ExpandableRow_Expand_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Expand'
+type ExpandableRow_Expand_Call struct {
+	*mock.Call
+}
+
+// Expand is a helper method to define mock.On call
+//   - provider core.Provider
+func (_e *ExpandableRow_Expecter) Expand(provider interface{}) *ExpandableRow_Expand_Call {
+	return &ExpandableRow_Expand_Call{Call: _e.mock.On("Expand", provider)}
+}
+
+func (_c *ExpandableRow_Expand_Call) Run(run func(provider core.Provider)) *ExpandableRow_Expand_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(core.Provider))
+	})
+	return _c
+}
+
+func (_c *ExpandableRow_Expand_Call) Return(_a0 []core.Row) *ExpandableRow_Expand_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ExpandableRow_Expand_Call) RunAndReturn(run func(core.Provider) []core.Row) *ExpandableRow_Expand_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetColumns provides a mock function with given fields:
+func (_m *ExpandableRow) GetColumns() []core.Col {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetColumns")
+	}
+
+	var r0 []core.Col
+	if rf, ok := ret.Get(0).(func() []core.Col); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]core.Col)
+		}
+	}
+
+	return r0
+}
+
+// ExpandableRow_GetColumns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetColumns'
+type ExpandableRow_GetColumns_Call struct {
+	*mock.Call
+}
+
+// GetColumns is a helper method to define mock.On call
+func (_e *ExpandableRow_Expecter) GetColumns() *ExpandableRow_GetColumns_Call {
+	return &ExpandableRow_GetColumns_Call{Call: _e.mock.On("GetColumns")}
+}
+
+func (_c *ExpandableRow_GetColumns_Call) Run(run func()) *ExpandableRow_GetColumns_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *ExpandableRow_GetColumns_Call) Return(_a0 []core.Col) *ExpandableRow_GetColumns_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ExpandableRow_GetColumns_Call) RunAndReturn(run func() []core.Col) *ExpandableRow_GetColumns_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetHeight provides a mock function with given fields: provider, cell
+func (_m *ExpandableRow) GetHeight(provider core.Provider, cell *entity.Cell) float64 {
+	ret := _m.Called(provider, cell)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetHeight")
+	}
+
+	var r0 float64
+	if rf, ok := ret.Get(0).(func(core.Provider, *entity.Cell) float64); ok {
+		r0 = rf(provider, cell)
+	} else {
+		r0 = ret.Get(0).(float64)
+	}
+
+	return r0
+}
+
+// ExpandableRow_GetHeight_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetHeight'
+type ExpandableRow_GetHeight_Call struct {
+	*mock.Call
+}
+
+// GetHeight is a helper method to define mock.On call
+//   - provider core.Provider
+//   - cell *entity.Cell
+func (_e *ExpandableRow_Expecter) GetHeight(provider interface{}, cell interface{}) *ExpandableRow_GetHeight_Call {
+	return &ExpandableRow_GetHeight_Call{Call: _e.mock.On("GetHeight", provider, cell)}
+}
+
+func (_c *ExpandableRow_GetHeight_Call) Run(run func(provider core.Provider, cell *entity.Cell)) *ExpandableRow_GetHeight_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(core.Provider), args[1].(*entity.Cell))
+	})
+	return _c
+}
+
+func (_c *ExpandableRow_GetHeight_Call) Return(_a0 float64) *ExpandableRow_GetHeight_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ExpandableRow_GetHeight_Call) RunAndReturn(run func(core.Provider, *entity.Cell) float64) *ExpandableRow_GetHeight_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStructure provides a mock function with given fields:
+func (_m *ExpandableRow) GetStructure() *node.Node[core.Structure] {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStructure")
+	}
+
+	var r0 *node.Node[core.Structure]
+	if rf, ok := ret.Get(0).(func() *node.Node[core.Structure]); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*node.Node[core.Structure])
+		}
+	}
+
+	return r0
+}
+
+// ExpandableRow_GetStructure_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStructure'
+type ExpandableRow_GetStructure_Call struct {
+	*mock.Call
+}
+
+// GetStructure is a helper method to define mock.On call
+func (_e *ExpandableRow_Expecter) GetStructure() *ExpandableRow_GetStructure_Call {
+	return &ExpandableRow_GetStructure_Call{Call: _e.mock.On("GetStructure")}
+}
+
+func (_c *ExpandableRow_GetStructure_Call) Run(run func()) *ExpandableRow_GetStructure_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *ExpandableRow_GetStructure_Call) Return(_a0 *node.Node[core.Structure]) *ExpandableRow_GetStructure_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ExpandableRow_GetStructure_Call) RunAndReturn(run func() *node.Node[core.Structure]) *ExpandableRow_GetStructure_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Render provides a mock function with given fields: provider, cell
+func (_m *ExpandableRow) Render(provider core.Provider, cell entity.Cell) {
+	_m.Called(provider, cell)
+}
+
+// ExpandableRow_Render_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Render'
+type ExpandableRow_Render_Call struct {
+	*mock.Call
+}
+
+// Render is a helper method to define mock.On call
+//   - provider core.Provider
+//   - cell entity.Cell
+func (_e *ExpandableRow_Expecter) Render(provider interface{}, cell interface{}) *ExpandableRow_Render_Call {
+	return &ExpandableRow_Render_Call{Call: _e.mock.On("Render", provider, cell)}
+}
+
+func (_c *ExpandableRow_Render_Call) Run(run func(provider core.Provider, cell entity.Cell)) *ExpandableRow_Render_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(core.Provider), args[1].(entity.Cell))
+	})
+	return _c
+}
+
+func (_c *ExpandableRow_Render_Call) Return() *ExpandableRow_Render_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *ExpandableRow_Render_Call) RunAndReturn(run func(core.Provider, entity.Cell)) *ExpandableRow_Render_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetConfig provides a mock function with given fields: config
+func (_m *ExpandableRow) SetConfig(config *entity.Config) {
+	_m.Called(config)
+}
+
+// ExpandableRow_SetConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetConfig'
+type ExpandableRow_SetConfig_Call struct {
+	*mock.Call
+}
+
+// SetConfig is a helper method to define mock.On call
+//   - config *entity.Config
+func (_e *ExpandableRow_Expecter) SetConfig(config interface{}) *ExpandableRow_SetConfig_Call {
+	return &ExpandableRow_SetConfig_Call{Call: _e.mock.On("SetConfig", config)}
+}
+
+func (_c *ExpandableRow_SetConfig_Call) Run(run func(config *entity.Config)) *ExpandableRow_SetConfig_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*entity.Config))
+	})
+	return _c
+}
+
+func (_c *ExpandableRow_SetConfig_Call) Return() *ExpandableRow_SetConfig_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *ExpandableRow_SetConfig_Call) RunAndReturn(run func(*entity.Config)) *ExpandableRow_SetConfig_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WithStyle provides a mock function with given fields: style
+func (_m *ExpandableRow) WithStyle(style *props.Cell) core.Row {
+	ret := _m.Called(style)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithStyle")
+	}
+
+	var r0 core.Row
+	if rf, ok := ret.Get(0).(func(*props.Cell) core.Row); ok {
+		r0 = rf(style)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(core.Row)
+		}
+	}
+
+	return r0
+}
+
+// ExpandableRow_WithStyle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithStyle'
+type ExpandableRow_WithStyle_Call struct {
+	*mock.Call
+}
+
+// WithStyle is a helper method to define mock.On call
+//   - style *props.Cell
+func (_e *ExpandableRow_Expecter) WithStyle(style interface{}) *ExpandableRow_WithStyle_Call {
+	return &ExpandableRow_WithStyle_Call{Call: _e.mock.On("WithStyle", style)}
+}
+
+func (_c *ExpandableRow_WithStyle_Call) Run(run func(style *props.Cell)) *ExpandableRow_WithStyle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*props.Cell))
+	})
+	return _c
+}
+
+func (_c *ExpandableRow_WithStyle_Call) Return(_a0 core.Row) *ExpandableRow_WithStyle_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ExpandableRow_WithStyle_Call) RunAndReturn(run func(*props.Cell) core.Row) *ExpandableRow_WithStyle_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewExpandableRow creates a new instance of ExpandableRow. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewExpandableRow(t interface {
+	mock.TestingT
+	Cleanup(func())
+},
+) *ExpandableRow {
+	mock := &ExpandableRow{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
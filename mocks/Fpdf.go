@@ -4079,6 +4079,45 @@ func (_c *Fpdf_RegisterImageReader_Call) RunAndReturn(run func(string, string, i
 	return _c
 }
 
+// RoundedRect provides a mock function with given fields: x, y, w, h, r, corners, stylestr
+func (_m *Fpdf) RoundedRect(x float64, y float64, w float64, h float64, r float64, corners string, stylestr string) {
+	_m.Called(x, y, w, h, r, corners, stylestr)
+}
+
+// Fpdf_RoundedRect_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RoundedRect'
+type Fpdf_RoundedRect_Call struct {
+	*mock.Call
+}
+
+// RoundedRect is a helper method to define mock.On call
+//   - x float64
+//   - y float64
+//   - w float64
+//   - h float64
+//   - r float64
+//   - corners string
+//   - stylestr string
+func (_e *Fpdf_Expecter) RoundedRect(x interface{}, y interface{}, w interface{}, h interface{}, r interface{}, corners interface{}, stylestr interface{}) *Fpdf_RoundedRect_Call {
+	return &Fpdf_RoundedRect_Call{Call: _e.mock.On("RoundedRect", x, y, w, h, r, corners, stylestr)}
+}
+
+func (_c *Fpdf_RoundedRect_Call) Run(run func(x float64, y float64, w float64, h float64, r float64, corners string, stylestr string)) *Fpdf_RoundedRect_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(float64), args[1].(float64), args[2].(float64), args[3].(float64), args[4].(float64), args[5].(string), args[6].(string))
+	})
+	return _c
+}
+
+func (_c *Fpdf_RoundedRect_Call) Return() *Fpdf_RoundedRect_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Fpdf_RoundedRect_Call) RunAndReturn(run func(float64, float64, float64, float64, float64, string, string)) *Fpdf_RoundedRect_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SVGBasicWrite provides a mock function with given fields: sb, scale
 func (_m *Fpdf) SVGBasicWrite(sb *gofpdf.SVGBasicType, scale float64) {
 	_m.Called(sb, scale)
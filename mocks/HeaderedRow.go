@@ -0,0 +1,406 @@
+// Code generated by mockery v2.49.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	core "github.com/johnfercher/maroto/v2/pkg/core"
+	entity "github.com/johnfercher/maroto/v2/pkg/core/entity"
+
+	mock "github.com/stretchr/testify/mock"
+
+	node "github.com/johnfercher/go-tree/node"
+
+	props "github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+// HeaderedRow is an autogenerated mock type for the HeaderedRow type
+type HeaderedRow struct {
+	mock.Mock
+}
+
+type HeaderedRow_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *HeaderedRow) EXPECT() *HeaderedRow_Expecter {
+	return &HeaderedRow_Expecter{mock: &_m.Mock}
+}
+
+// Add provides a mock function with given fields: cols
+func (_m *HeaderedRow) Add(cols ...core.Col) core.Row {
+	_va := make([]interface{}, len(cols))
+	for _i := range cols {
+		_va[_i] = cols[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Add")
+	}
+
+	var r0 core.Row
+	if rf, ok := ret.Get(0).(func(...core.Col) core.Row); ok {
+		r0 = rf(cols...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(core.Row)
+		}
+	}
+
+	return r0
+}
+
+// HeaderedRow_Add_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Add'
+type HeaderedRow_Add_Call struct {
+	*mock.Call
+}
+
+// Add is a helper method to define mock.On call
+//   - cols ...core.Col
+func (_e *HeaderedRow_Expecter) Add(cols ...interface{}) *HeaderedRow_Add_Call {
+	return &HeaderedRow_Add_Call{Call: _e.mock.On("Add",
+		append([]interface{}{}, cols...)...)}
+}
+
+func (_c *HeaderedRow_Add_Call) Run(run func(cols ...core.Col)) *HeaderedRow_Add_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]core.Col, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(core.Col)
+			}
+		}
+		run(variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *HeaderedRow_Add_Call) Return(_a0 core.Row) *HeaderedRow_Add_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *HeaderedRow_Add_Call) RunAndReturn(run func(...core.Col) core.Row) *HeaderedRow_Add_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetColumns provides a mock function with given fields:
+func (_m *HeaderedRow) GetColumns() []core.Col {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetColumns")
+	}
+
+	var r0 []core.Col
+	if rf, ok := ret.Get(0).(func() []core.Col); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]core.Col)
+		}
+	}
+
+	return r0
+}
+
+// HeaderedRow_GetColumns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetColumns'
+type HeaderedRow_GetColumns_Call struct {
+	*mock.Call
+}
+
+// GetColumns is a helper method to define mock.On call
+func (_e *HeaderedRow_Expecter) GetColumns() *HeaderedRow_GetColumns_Call {
+	return &HeaderedRow_GetColumns_Call{Call: _e.mock.On("GetColumns")}
+}
+
+func (_c *HeaderedRow_GetColumns_Call) Run(run func()) *HeaderedRow_GetColumns_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *HeaderedRow_GetColumns_Call) Return(_a0 []core.Col) *HeaderedRow_GetColumns_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *HeaderedRow_GetColumns_Call) RunAndReturn(run func() []core.Col) *HeaderedRow_GetColumns_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetHeader provides a mock function with given fields:
+func (_m *HeaderedRow) GetHeader() []core.Row {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetHeader")
+	}
+
+	var r0 []core.Row
+	if rf, ok := ret.Get(0).(func() []core.Row); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]core.Row)
+		}
+	}
+
+	return r0
+}
+
+// HeaderedRow_GetHeader_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetHeader'
+type HeaderedRow_GetHeader_Call struct {
+	*mock.Call
+}
+
+// GetHeader is a helper method to define mock.On call
+func (_e *HeaderedRow_Expecter) GetHeader() *HeaderedRow_GetHeader_Call {
+	return &HeaderedRow_GetHeader_Call{Call: _e.mock.On("GetHeader")}
+}
+
+func (_c *HeaderedRow_GetHeader_Call) Run(run func()) *HeaderedRow_GetHeader_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *HeaderedRow_GetHeader_Call) Return(_a0 []core.Row) *HeaderedRow_GetHeader_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *HeaderedRow_GetHeader_Call) RunAndReturn(run func() []core.Row) *HeaderedRow_GetHeader_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetHeight provides a mock function with given fields: provider, cell
+func (_m *HeaderedRow) GetHeight(provider core.Provider, cell *entity.Cell) float64 {
+	ret := _m.Called(provider, cell)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetHeight")
+	}
+
+	var r0 float64
+	if rf, ok := ret.Get(0).(func(core.Provider, *entity.Cell) float64); ok {
+		r0 = rf(provider, cell)
+	} else {
+		r0 = ret.Get(0).(float64)
+	}
+
+	return r0
+}
+
+// HeaderedRow_GetHeight_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetHeight'
+type HeaderedRow_GetHeight_Call struct {
+	*mock.Call
+}
+
+// GetHeight is a helper method to define mock.On call
+//   - provider core.Provider
+//   - cell *entity.Cell
+func (_e *HeaderedRow_Expecter) GetHeight(provider interface{}, cell interface{}) *HeaderedRow_GetHeight_Call {
+	return &HeaderedRow_GetHeight_Call{Call: _e.mock.On("GetHeight", provider, cell)}
+}
+
+func (_c *HeaderedRow_GetHeight_Call) Run(run func(provider core.Provider, cell *entity.Cell)) *HeaderedRow_GetHeight_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(core.Provider), args[1].(*entity.Cell))
+	})
+	return _c
+}
+
+func (_c *HeaderedRow_GetHeight_Call) Return(_a0 float64) *HeaderedRow_GetHeight_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *HeaderedRow_GetHeight_Call) RunAndReturn(run func(core.Provider, *entity.Cell) float64) *HeaderedRow_GetHeight_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStructure provides a mock function with given fields:
+func (_m *HeaderedRow) GetStructure() *node.Node[core.Structure] {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStructure")
+	}
+
+	var r0 *node.Node[core.Structure]
+	if rf, ok := ret.Get(0).(func() *node.Node[core.Structure]); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*node.Node[core.Structure])
+		}
+	}
+
+	return r0
+}
+
+// HeaderedRow_GetStructure_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStructure'
+type HeaderedRow_GetStructure_Call struct {
+	*mock.Call
+}
+
+// GetStructure is a helper method to define mock.On call
+func (_e *HeaderedRow_Expecter) GetStructure() *HeaderedRow_GetStructure_Call {
+	return &HeaderedRow_GetStructure_Call{Call: _e.mock.On("GetStructure")}
+}
+
+func (_c *HeaderedRow_GetStructure_Call) Run(run func()) *HeaderedRow_GetStructure_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *HeaderedRow_GetStructure_Call) Return(_a0 *node.Node[core.Structure]) *HeaderedRow_GetStructure_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *HeaderedRow_GetStructure_Call) RunAndReturn(run func() *node.Node[core.Structure]) *HeaderedRow_GetStructure_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Render provides a mock function with given fields: provider, cell
+func (_m *HeaderedRow) Render(provider core.Provider, cell entity.Cell) {
+	_m.Called(provider, cell)
+}
+
+// HeaderedRow_Render_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Render'
+type HeaderedRow_Render_Call struct {
+	*mock.Call
+}
+
+// Render is a helper method to define mock.On call
+//   - provider core.Provider
+//   - cell entity.Cell
+func (_e *HeaderedRow_Expecter) Render(provider interface{}, cell interface{}) *HeaderedRow_Render_Call {
+	return &HeaderedRow_Render_Call{Call: _e.mock.On("Render", provider, cell)}
+}
+
+func (_c *HeaderedRow_Render_Call) Run(run func(provider core.Provider, cell entity.Cell)) *HeaderedRow_Render_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(core.Provider), args[1].(entity.Cell))
+	})
+	return _c
+}
+
+func (_c *HeaderedRow_Render_Call) Return() *HeaderedRow_Render_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *HeaderedRow_Render_Call) RunAndReturn(run func(core.Provider, entity.Cell)) *HeaderedRow_Render_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetConfig provides a mock function with given fields: config
+func (_m *HeaderedRow) SetConfig(config *entity.Config) {
+	_m.Called(config)
+}
+
+// HeaderedRow_SetConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetConfig'
+type HeaderedRow_SetConfig_Call struct {
+	*mock.Call
+}
+
+// SetConfig is a helper method to define mock.On call
+//   - config *entity.Config
+func (_e *HeaderedRow_Expecter) SetConfig(config interface{}) *HeaderedRow_SetConfig_Call {
+	return &HeaderedRow_SetConfig_Call{Call: _e.mock.On("SetConfig", config)}
+}
+
+func (_c *HeaderedRow_SetConfig_Call) Run(run func(config *entity.Config)) *HeaderedRow_SetConfig_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*entity.Config))
+	})
+	return _c
+}
+
+func (_c *HeaderedRow_SetConfig_Call) Return() *HeaderedRow_SetConfig_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *HeaderedRow_SetConfig_Call) RunAndReturn(run func(*entity.Config)) *HeaderedRow_SetConfig_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WithStyle provides a mock function with given fields: style
+func (_m *HeaderedRow) WithStyle(style *props.Cell) core.Row {
+	ret := _m.Called(style)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithStyle")
+	}
+
+	var r0 core.Row
+	if rf, ok := ret.Get(0).(func(*props.Cell) core.Row); ok {
+		r0 = rf(style)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(core.Row)
+		}
+	}
+
+	return r0
+}
+
+// HeaderedRow_WithStyle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithStyle'
+type HeaderedRow_WithStyle_Call struct {
+	*mock.Call
+}
+
+// WithStyle is a helper method to define mock.On call
+//   - style *props.Cell
+func (_e *HeaderedRow_Expecter) WithStyle(style interface{}) *HeaderedRow_WithStyle_Call {
+	return &HeaderedRow_WithStyle_Call{Call: _e.mock.On("WithStyle", style)}
+}
+
+func (_c *HeaderedRow_WithStyle_Call) Run(run func(style *props.Cell)) *HeaderedRow_WithStyle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*props.Cell))
+	})
+	return _c
+}
+
+func (_c *HeaderedRow_WithStyle_Call) Return(_a0 core.Row) *HeaderedRow_WithStyle_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *HeaderedRow_WithStyle_Call) RunAndReturn(run func(*props.Cell) core.Row) *HeaderedRow_WithStyle_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewHeaderedRow creates a new instance of HeaderedRow. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewHeaderedRow(t interface {
+	mock.TestingT
+	Cleanup(func())
+},
+) *HeaderedRow {
+	mock := &HeaderedRow{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -224,6 +224,53 @@ func (_c *Page_GetStructure_Call) RunAndReturn(run func() *node.Node[core.Struct
 	return _c
 }
 
+// GetWatermark provides a mock function with given fields:
+func (_m *Page) GetWatermark() *entity.Watermark {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWatermark")
+	}
+
+	var r0 *entity.Watermark
+	if rf, ok := ret.Get(0).(func() *entity.Watermark); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Watermark)
+		}
+	}
+
+	return r0
+}
+
+// Page_GetWatermark_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWatermark'
+type Page_GetWatermark_Call struct {
+	*mock.Call
+}
+
+// GetWatermark is a helper method to define mock.On call
+func (_e *Page_Expecter) GetWatermark() *Page_GetWatermark_Call {
+	return &Page_GetWatermark_Call{Call: _e.mock.On("GetWatermark")}
+}
+
+func (_c *Page_GetWatermark_Call) Run(run func()) *Page_GetWatermark_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Page_GetWatermark_Call) Return(_a0 *entity.Watermark) *Page_GetWatermark_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Page_GetWatermark_Call) RunAndReturn(run func() *entity.Watermark) *Page_GetWatermark_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Render provides a mock function with given fields: provider, cell
 func (_m *Page) Render(provider core.Provider, cell entity.Cell) {
 	_m.Called(provider, cell)
@@ -325,6 +372,54 @@ func (_c *Page_SetNumber_Call) RunAndReturn(run func(int, int)) *Page_SetNumber_
 	return _c
 }
 
+// WithWatermark provides a mock function with given fields: watermark
+func (_m *Page) WithWatermark(watermark *entity.Watermark) core.Page {
+	ret := _m.Called(watermark)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithWatermark")
+	}
+
+	var r0 core.Page
+	if rf, ok := ret.Get(0).(func(*entity.Watermark) core.Page); ok {
+		r0 = rf(watermark)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(core.Page)
+		}
+	}
+
+	return r0
+}
+
+// Page_WithWatermark_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithWatermark'
+type Page_WithWatermark_Call struct {
+	*mock.Call
+}
+
+// WithWatermark is a helper method to define mock.On call
+//   - watermark *entity.Watermark
+func (_e *Page_Expecter) WithWatermark(watermark interface{}) *Page_WithWatermark_Call {
+	return &Page_WithWatermark_Call{Call: _e.mock.On("WithWatermark", watermark)}
+}
+
+func (_c *Page_WithWatermark_Call) Run(run func(watermark *entity.Watermark)) *Page_WithWatermark_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*entity.Watermark))
+	})
+	return _c
+}
+
+func (_c *Page_WithWatermark_Call) Return(_a0 core.Page) *Page_WithWatermark_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Page_WithWatermark_Call) RunAndReturn(run func(*entity.Watermark) core.Page) *Page_WithWatermark_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewPage creates a new instance of Page. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewPage(t interface {
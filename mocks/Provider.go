@@ -6,6 +6,8 @@ import (
 	extension "github.com/johnfercher/maroto/v2/pkg/consts/extension"
 	entity "github.com/johnfercher/maroto/v2/pkg/core/entity"
 
+	matrixcode "github.com/johnfercher/maroto/v2/pkg/consts/matrixcode"
+
 	mock "github.com/stretchr/testify/mock"
 
 	props "github.com/johnfercher/maroto/v2/pkg/props"
@@ -24,6 +26,40 @@ func (_m *Provider) EXPECT() *Provider_Expecter {
 	return &Provider_Expecter{mock: &_m.Mock}
 }
 
+// AddAnchor provides a mock function with given fields: name, cell
+func (_m *Provider) AddAnchor(name string, cell *entity.Cell) {
+	_m.Called(name, cell)
+}
+
+// Provider_AddAnchor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddAnchor'
+type Provider_AddAnchor_Call struct {
+	*mock.Call
+}
+
+// AddAnchor is a helper method to define mock.On call
+//   - name string
+//   - cell *entity.Cell
+func (_e *Provider_Expecter) AddAnchor(name interface{}, cell interface{}) *Provider_AddAnchor_Call {
+	return &Provider_AddAnchor_Call{Call: _e.mock.On("AddAnchor", name, cell)}
+}
+
+func (_c *Provider_AddAnchor_Call) Run(run func(name string, cell *entity.Cell)) *Provider_AddAnchor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(*entity.Cell))
+	})
+	return _c
+}
+
+func (_c *Provider_AddAnchor_Call) Return() *Provider_AddAnchor_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Provider_AddAnchor_Call) RunAndReturn(run func(string, *entity.Cell)) *Provider_AddAnchor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AddBackgroundImageFromBytes provides a mock function with given fields: bytes, cell, prop, _a3
 func (_m *Provider) AddBackgroundImageFromBytes(bytes []byte, cell *entity.Cell, prop *props.Rect, _a3 extension.Type) {
 	_m.Called(bytes, cell, prop, _a3)
@@ -60,6 +96,176 @@ func (_c *Provider_AddBackgroundImageFromBytes_Call) RunAndReturn(run func([]byt
 	return _c
 }
 
+// AddBarCode provides a mock function with given fields: code, cell, prop
+func (_m *Provider) AddBarCode(code string, cell *entity.Cell, prop *props.Barcode) {
+	_m.Called(code, cell, prop)
+}
+
+// Provider_AddBarCode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddBarCode'
+type Provider_AddBarCode_Call struct {
+	*mock.Call
+}
+
+// AddBarCode is a helper method to define mock.On call
+//   - code string
+//   - cell *entity.Cell
+//   - prop *props.Barcode
+func (_e *Provider_Expecter) AddBarCode(code interface{}, cell interface{}, prop interface{}) *Provider_AddBarCode_Call {
+	return &Provider_AddBarCode_Call{Call: _e.mock.On("AddBarCode", code, cell, prop)}
+}
+
+func (_c *Provider_AddBarCode_Call) Run(run func(code string, cell *entity.Cell, prop *props.Barcode)) *Provider_AddBarCode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(*entity.Cell), args[2].(*props.Barcode))
+	})
+	return _c
+}
+
+func (_c *Provider_AddBarCode_Call) Return() *Provider_AddBarCode_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Provider_AddBarCode_Call) RunAndReturn(run func(string, *entity.Cell, *props.Barcode)) *Provider_AddBarCode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddBookmark provides a mock function with given fields: title, level, cell
+func (_m *Provider) AddBookmark(title string, level int, cell *entity.Cell) {
+	_m.Called(title, level, cell)
+}
+
+// Provider_AddBookmark_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddBookmark'
+type Provider_AddBookmark_Call struct {
+	*mock.Call
+}
+
+// AddBookmark is a helper method to define mock.On call
+//   - title string
+//   - level int
+//   - cell *entity.Cell
+func (_e *Provider_Expecter) AddBookmark(title interface{}, level interface{}, cell interface{}) *Provider_AddBookmark_Call {
+	return &Provider_AddBookmark_Call{Call: _e.mock.On("AddBookmark", title, level, cell)}
+}
+
+func (_c *Provider_AddBookmark_Call) Run(run func(title string, level int, cell *entity.Cell)) *Provider_AddBookmark_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(int), args[2].(*entity.Cell))
+	})
+	return _c
+}
+
+func (_c *Provider_AddBookmark_Call) Return() *Provider_AddBookmark_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Provider_AddBookmark_Call) RunAndReturn(run func(string, int, *entity.Cell)) *Provider_AddBookmark_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddEllipse provides a mock function with given fields: cell, prop
+func (_m *Provider) AddEllipse(cell *entity.Cell, prop *props.Shape) {
+	_m.Called(cell, prop)
+}
+
+// Provider_AddEllipse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddEllipse'
+type Provider_AddEllipse_Call struct {
+	*mock.Call
+}
+
+// AddEllipse is a helper method to define mock.On call
+//   - cell *entity.Cell
+//   - prop *props.Shape
+func (_e *Provider_Expecter) AddEllipse(cell interface{}, prop interface{}) *Provider_AddEllipse_Call {
+	return &Provider_AddEllipse_Call{Call: _e.mock.On("AddEllipse", cell, prop)}
+}
+
+func (_c *Provider_AddEllipse_Call) Run(run func(cell *entity.Cell, prop *props.Shape)) *Provider_AddEllipse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*entity.Cell), args[1].(*props.Shape))
+	})
+	return _c
+}
+
+func (_c *Provider_AddEllipse_Call) Return() *Provider_AddEllipse_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Provider_AddEllipse_Call) RunAndReturn(run func(*entity.Cell, *props.Shape)) *Provider_AddEllipse_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddFootnote provides a mock function with given fields: footnote
+func (_m *Provider) AddFootnote(footnote *entity.Footnote) {
+	_m.Called(footnote)
+}
+
+// Provider_AddFootnote_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddFootnote'
+type Provider_AddFootnote_Call struct {
+	*mock.Call
+}
+
+// AddFootnote is a helper method to define mock.On call
+//   - footnote *entity.Footnote
+func (_e *Provider_Expecter) AddFootnote(footnote interface{}) *Provider_AddFootnote_Call {
+	return &Provider_AddFootnote_Call{Call: _e.mock.On("AddFootnote", footnote)}
+}
+
+func (_c *Provider_AddFootnote_Call) Run(run func(footnote *entity.Footnote)) *Provider_AddFootnote_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*entity.Footnote))
+	})
+	return _c
+}
+
+func (_c *Provider_AddFootnote_Call) Return() *Provider_AddFootnote_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Provider_AddFootnote_Call) RunAndReturn(run func(*entity.Footnote)) *Provider_AddFootnote_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddHeading provides a mock function with given fields: heading
+func (_m *Provider) AddHeading(heading *entity.Heading) {
+	_m.Called(heading)
+}
+
+// Provider_AddHeading_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddHeading'
+type Provider_AddHeading_Call struct {
+	*mock.Call
+}
+
+// AddHeading is a helper method to define mock.On call
+//   - heading *entity.Heading
+func (_e *Provider_Expecter) AddHeading(heading interface{}) *Provider_AddHeading_Call {
+	return &Provider_AddHeading_Call{Call: _e.mock.On("AddHeading", heading)}
+}
+
+func (_c *Provider_AddHeading_Call) Run(run func(heading *entity.Heading)) *Provider_AddHeading_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*entity.Heading))
+	})
+	return _c
+}
+
+func (_c *Provider_AddHeading_Call) Return() *Provider_AddHeading_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Provider_AddHeading_Call) RunAndReturn(run func(*entity.Heading)) *Provider_AddHeading_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AddImageFromBytes provides a mock function with given fields: bytes, cell, prop, _a3
 func (_m *Provider) AddImageFromBytes(bytes []byte, cell *entity.Cell, prop *props.Rect, _a3 extension.Type) {
 	_m.Called(bytes, cell, prop, _a3)
@@ -131,6 +337,40 @@ func (_c *Provider_AddImageFromFile_Call) RunAndReturn(run func(string, *entity.
 	return _c
 }
 
+// AddInternalLink provides a mock function with given fields: name, cell
+func (_m *Provider) AddInternalLink(name string, cell *entity.Cell) {
+	_m.Called(name, cell)
+}
+
+// Provider_AddInternalLink_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddInternalLink'
+type Provider_AddInternalLink_Call struct {
+	*mock.Call
+}
+
+// AddInternalLink is a helper method to define mock.On call
+//   - name string
+//   - cell *entity.Cell
+func (_e *Provider_Expecter) AddInternalLink(name interface{}, cell interface{}) *Provider_AddInternalLink_Call {
+	return &Provider_AddInternalLink_Call{Call: _e.mock.On("AddInternalLink", name, cell)}
+}
+
+func (_c *Provider_AddInternalLink_Call) Run(run func(name string, cell *entity.Cell)) *Provider_AddInternalLink_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(*entity.Cell))
+	})
+	return _c
+}
+
+func (_c *Provider_AddInternalLink_Call) Return() *Provider_AddInternalLink_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Provider_AddInternalLink_Call) RunAndReturn(run func(string, *entity.Cell)) *Provider_AddInternalLink_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AddLine provides a mock function with given fields: cell, prop
 func (_m *Provider) AddLine(cell *entity.Cell, prop *props.Line) {
 	_m.Called(cell, prop)
@@ -148,54 +388,328 @@ func (_e *Provider_Expecter) AddLine(cell interface{}, prop interface{}) *Provid
 	return &Provider_AddLine_Call{Call: _e.mock.On("AddLine", cell, prop)}
 }
 
-func (_c *Provider_AddLine_Call) Run(run func(cell *entity.Cell, prop *props.Line)) *Provider_AddLine_Call {
+func (_c *Provider_AddLine_Call) Run(run func(cell *entity.Cell, prop *props.Line)) *Provider_AddLine_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*entity.Cell), args[1].(*props.Line))
+	})
+	return _c
+}
+
+func (_c *Provider_AddLine_Call) Return() *Provider_AddLine_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Provider_AddLine_Call) RunAndReturn(run func(*entity.Cell, *props.Line)) *Provider_AddLine_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddMatrixCode provides a mock function with given fields: code, symbology, cell, prop
+func (_m *Provider) AddMatrixCode(code string, symbology matrixcode.Symbology, cell *entity.Cell, prop *props.Rect) {
+	_m.Called(code, symbology, cell, prop)
+}
+
+// Provider_AddMatrixCode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddMatrixCode'
+type Provider_AddMatrixCode_Call struct {
+	*mock.Call
+}
+
+// AddMatrixCode is a helper method to define mock.On call
+//   - code string
+//   - symbology matrixcode.Symbology
+//   - cell *entity.Cell
+//   - prop *props.Rect
+func (_e *Provider_Expecter) AddMatrixCode(code interface{}, symbology interface{}, cell interface{}, prop interface{}) *Provider_AddMatrixCode_Call {
+	return &Provider_AddMatrixCode_Call{Call: _e.mock.On("AddMatrixCode", code, symbology, cell, prop)}
+}
+
+func (_c *Provider_AddMatrixCode_Call) Run(run func(code string, symbology matrixcode.Symbology, cell *entity.Cell, prop *props.Rect)) *Provider_AddMatrixCode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(matrixcode.Symbology), args[2].(*entity.Cell), args[3].(*props.Rect))
+	})
+	return _c
+}
+
+func (_c *Provider_AddMatrixCode_Call) Return() *Provider_AddMatrixCode_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Provider_AddMatrixCode_Call) RunAndReturn(run func(string, matrixcode.Symbology, *entity.Cell, *props.Rect)) *Provider_AddMatrixCode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddPolygon provides a mock function with given fields: points, prop
+func (_m *Provider) AddPolygon(points []entity.Point, prop *props.Shape) {
+	_m.Called(points, prop)
+}
+
+// Provider_AddPolygon_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddPolygon'
+type Provider_AddPolygon_Call struct {
+	*mock.Call
+}
+
+// AddPolygon is a helper method to define mock.On call
+//   - points []entity.Point
+//   - prop *props.Shape
+func (_e *Provider_Expecter) AddPolygon(points interface{}, prop interface{}) *Provider_AddPolygon_Call {
+	return &Provider_AddPolygon_Call{Call: _e.mock.On("AddPolygon", points, prop)}
+}
+
+func (_c *Provider_AddPolygon_Call) Run(run func(points []entity.Point, prop *props.Shape)) *Provider_AddPolygon_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]entity.Point), args[1].(*props.Shape))
+	})
+	return _c
+}
+
+func (_c *Provider_AddPolygon_Call) Return() *Provider_AddPolygon_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Provider_AddPolygon_Call) RunAndReturn(run func([]entity.Point, *props.Shape)) *Provider_AddPolygon_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddPolyline provides a mock function with given fields: points, prop
+func (_m *Provider) AddPolyline(points []entity.Point, prop *props.Shape) {
+	_m.Called(points, prop)
+}
+
+// Provider_AddPolyline_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddPolyline'
+type Provider_AddPolyline_Call struct {
+	*mock.Call
+}
+
+// AddPolyline is a helper method to define mock.On call
+//   - points []entity.Point
+//   - prop *props.Shape
+func (_e *Provider_Expecter) AddPolyline(points interface{}, prop interface{}) *Provider_AddPolyline_Call {
+	return &Provider_AddPolyline_Call{Call: _e.mock.On("AddPolyline", points, prop)}
+}
+
+func (_c *Provider_AddPolyline_Call) Run(run func(points []entity.Point, prop *props.Shape)) *Provider_AddPolyline_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]entity.Point), args[1].(*props.Shape))
+	})
+	return _c
+}
+
+func (_c *Provider_AddPolyline_Call) Return() *Provider_AddPolyline_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Provider_AddPolyline_Call) RunAndReturn(run func([]entity.Point, *props.Shape)) *Provider_AddPolyline_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddQrCode provides a mock function with given fields: code, cell, prop
+func (_m *Provider) AddQrCode(code string, cell *entity.Cell, prop *props.Rect) {
+	_m.Called(code, cell, prop)
+}
+
+// Provider_AddQrCode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddQrCode'
+type Provider_AddQrCode_Call struct {
+	*mock.Call
+}
+
+// AddQrCode is a helper method to define mock.On call
+//   - code string
+//   - cell *entity.Cell
+//   - prop *props.Rect
+func (_e *Provider_Expecter) AddQrCode(code interface{}, cell interface{}, prop interface{}) *Provider_AddQrCode_Call {
+	return &Provider_AddQrCode_Call{Call: _e.mock.On("AddQrCode", code, cell, prop)}
+}
+
+func (_c *Provider_AddQrCode_Call) Run(run func(code string, cell *entity.Cell, prop *props.Rect)) *Provider_AddQrCode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(*entity.Cell), args[2].(*props.Rect))
+	})
+	return _c
+}
+
+func (_c *Provider_AddQrCode_Call) Return() *Provider_AddQrCode_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Provider_AddQrCode_Call) RunAndReturn(run func(string, *entity.Cell, *props.Rect)) *Provider_AddQrCode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddRectangle provides a mock function with given fields: cell, prop
+func (_m *Provider) AddRectangle(cell *entity.Cell, prop *props.Shape) {
+	_m.Called(cell, prop)
+}
+
+// Provider_AddRectangle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddRectangle'
+type Provider_AddRectangle_Call struct {
+	*mock.Call
+}
+
+// AddRectangle is a helper method to define mock.On call
+//   - cell *entity.Cell
+//   - prop *props.Shape
+func (_e *Provider_Expecter) AddRectangle(cell interface{}, prop interface{}) *Provider_AddRectangle_Call {
+	return &Provider_AddRectangle_Call{Call: _e.mock.On("AddRectangle", cell, prop)}
+}
+
+func (_c *Provider_AddRectangle_Call) Run(run func(cell *entity.Cell, prop *props.Shape)) *Provider_AddRectangle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*entity.Cell), args[1].(*props.Shape))
+	})
+	return _c
+}
+
+func (_c *Provider_AddRectangle_Call) Return() *Provider_AddRectangle_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Provider_AddRectangle_Call) RunAndReturn(run func(*entity.Cell, *props.Shape)) *Provider_AddRectangle_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddRichText provides a mock function with given fields: spans, cell, prop
+func (_m *Provider) AddRichText(spans []entity.Span, cell *entity.Cell, prop *props.RichText) {
+	_m.Called(spans, cell, prop)
+}
+
+// Provider_AddRichText_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddRichText'
+type Provider_AddRichText_Call struct {
+	*mock.Call
+}
+
+// AddRichText is a helper method to define mock.On call
+//   - spans []entity.Span
+//   - cell *entity.Cell
+//   - prop *props.RichText
+func (_e *Provider_Expecter) AddRichText(spans interface{}, cell interface{}, prop interface{}) *Provider_AddRichText_Call {
+	return &Provider_AddRichText_Call{Call: _e.mock.On("AddRichText", spans, cell, prop)}
+}
+
+func (_c *Provider_AddRichText_Call) Run(run func(spans []entity.Span, cell *entity.Cell, prop *props.RichText)) *Provider_AddRichText_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]entity.Span), args[1].(*entity.Cell), args[2].(*props.RichText))
+	})
+	return _c
+}
+
+func (_c *Provider_AddRichText_Call) Return() *Provider_AddRichText_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Provider_AddRichText_Call) RunAndReturn(run func([]entity.Span, *entity.Cell, *props.RichText)) *Provider_AddRichText_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddText provides a mock function with given fields: text, cell, prop
+func (_m *Provider) AddText(text string, cell *entity.Cell, prop *props.Text) {
+	_m.Called(text, cell, prop)
+}
+
+// Provider_AddText_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddText'
+type Provider_AddText_Call struct {
+	*mock.Call
+}
+
+// AddText is a helper method to define mock.On call
+//   - text string
+//   - cell *entity.Cell
+//   - prop *props.Text
+func (_e *Provider_Expecter) AddText(text interface{}, cell interface{}, prop interface{}) *Provider_AddText_Call {
+	return &Provider_AddText_Call{Call: _e.mock.On("AddText", text, cell, prop)}
+}
+
+func (_c *Provider_AddText_Call) Run(run func(text string, cell *entity.Cell, prop *props.Text)) *Provider_AddText_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(*entity.Cell), args[2].(*props.Text))
+	})
+	return _c
+}
+
+func (_c *Provider_AddText_Call) Return() *Provider_AddText_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Provider_AddText_Call) RunAndReturn(run func(string, *entity.Cell, *props.Text)) *Provider_AddText_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddWatermark provides a mock function with given fields: watermark, cell
+func (_m *Provider) AddWatermark(watermark *entity.Watermark, cell *entity.Cell) {
+	_m.Called(watermark, cell)
+}
+
+// Provider_AddWatermark_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddWatermark'
+type Provider_AddWatermark_Call struct {
+	*mock.Call
+}
+
+// AddWatermark is a helper method to define mock.On call
+//   - watermark *entity.Watermark
+//   - cell *entity.Cell
+func (_e *Provider_Expecter) AddWatermark(watermark interface{}, cell interface{}) *Provider_AddWatermark_Call {
+	return &Provider_AddWatermark_Call{Call: _e.mock.On("AddWatermark", watermark, cell)}
+}
+
+func (_c *Provider_AddWatermark_Call) Run(run func(watermark *entity.Watermark, cell *entity.Cell)) *Provider_AddWatermark_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(*entity.Cell), args[1].(*props.Line))
+		run(args[0].(*entity.Watermark), args[1].(*entity.Cell))
 	})
 	return _c
 }
 
-func (_c *Provider_AddLine_Call) Return() *Provider_AddLine_Call {
+func (_c *Provider_AddWatermark_Call) Return() *Provider_AddWatermark_Call {
 	_c.Call.Return()
 	return _c
 }
 
-func (_c *Provider_AddLine_Call) RunAndReturn(run func(*entity.Cell, *props.Line)) *Provider_AddLine_Call {
+func (_c *Provider_AddWatermark_Call) RunAndReturn(run func(*entity.Watermark, *entity.Cell)) *Provider_AddWatermark_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// AddText provides a mock function with given fields: text, cell, prop
-func (_m *Provider) AddText(text string, cell *entity.Cell, prop *props.Text) {
-	_m.Called(text, cell, prop)
+// CloseGrid provides a mock function with given fields:
+func (_m *Provider) CloseGrid() {
+	_m.Called()
 }
 
-// Provider_AddText_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddText'
-type Provider_AddText_Call struct {
+// Provider_CloseGrid_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CloseGrid'
+type Provider_CloseGrid_Call struct {
 	*mock.Call
 }
 
-// AddText is a helper method to define mock.On call
-//   - text string
-//   - cell *entity.Cell
-//   - prop *props.Text
-func (_e *Provider_Expecter) AddText(text interface{}, cell interface{}, prop interface{}) *Provider_AddText_Call {
-	return &Provider_AddText_Call{Call: _e.mock.On("AddText", text, cell, prop)}
+// CloseGrid is a helper method to define mock.On call
+func (_e *Provider_Expecter) CloseGrid() *Provider_CloseGrid_Call {
+	return &Provider_CloseGrid_Call{Call: _e.mock.On("CloseGrid")}
 }
 
-func (_c *Provider_AddText_Call) Run(run func(text string, cell *entity.Cell, prop *props.Text)) *Provider_AddText_Call {
+func (_c *Provider_CloseGrid_Call) Run(run func()) *Provider_CloseGrid_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].(*entity.Cell), args[2].(*props.Text))
+		run()
 	})
 	return _c
 }
 
-func (_c *Provider_AddText_Call) Return() *Provider_AddText_Call {
+func (_c *Provider_CloseGrid_Call) Return() *Provider_CloseGrid_Call {
 	_c.Call.Return()
 	return _c
 }
 
-func (_c *Provider_AddText_Call) RunAndReturn(run func(string, *entity.Cell, *props.Text)) *Provider_AddText_Call {
+func (_c *Provider_CloseGrid_Call) RunAndReturn(run func()) *Provider_CloseGrid_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -326,9 +840,65 @@ func (_c *Provider_GenerateBytes_Call) RunAndReturn(run func() ([]byte, error))
 	return _c
 }
 
-// GetDimensionsByImage provides a mock function with given fields: file
-func (_m *Provider) GetDimensionsByImage(file string) (*entity.Dimensions, error) {
-	ret := _m.Called(file)
+// GetAnchorPage provides a mock function with given fields: name
+func (_m *Provider) GetAnchorPage(name string) (int, bool) {
+	ret := _m.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAnchorPage")
+	}
+
+	var r0 int
+	var r1 bool
+	if rf, ok := ret.Get(0).(func(string) (int, bool)); ok {
+		return rf(name)
+	}
+	if rf, ok := ret.Get(0).(func(string) int); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) bool); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// Provider_GetAnchorPage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAnchorPage'
+type Provider_GetAnchorPage_Call struct {
+	*mock.Call
+}
+
+// GetAnchorPage is a helper method to define mock.On call
+//   - name string
+func (_e *Provider_Expecter) GetAnchorPage(name interface{}) *Provider_GetAnchorPage_Call {
+	return &Provider_GetAnchorPage_Call{Call: _e.mock.On("GetAnchorPage", name)}
+}
+
+func (_c *Provider_GetAnchorPage_Call) Run(run func(name string)) *Provider_GetAnchorPage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *Provider_GetAnchorPage_Call) Return(_a0 int, _a1 bool) *Provider_GetAnchorPage_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Provider_GetAnchorPage_Call) RunAndReturn(run func(string) (int, bool)) *Provider_GetAnchorPage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDimensionsByImage provides a mock function with given fields: file, frame
+func (_m *Provider) GetDimensionsByImage(file string, frame int) (*entity.Dimensions, error) {
+	ret := _m.Called(file, frame)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetDimensionsByImage")
@@ -336,19 +906,19 @@ func (_m *Provider) GetDimensionsByImage(file string) (*entity.Dimensions, error
 
 	var r0 *entity.Dimensions
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string) (*entity.Dimensions, error)); ok {
-		return rf(file)
+	if rf, ok := ret.Get(0).(func(string, int) (*entity.Dimensions, error)); ok {
+		return rf(file, frame)
 	}
-	if rf, ok := ret.Get(0).(func(string) *entity.Dimensions); ok {
-		r0 = rf(file)
+	if rf, ok := ret.Get(0).(func(string, int) *entity.Dimensions); ok {
+		r0 = rf(file, frame)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*entity.Dimensions)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(file)
+	if rf, ok := ret.Get(1).(func(string, int) error); ok {
+		r1 = rf(file, frame)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -363,13 +933,14 @@ type Provider_GetDimensionsByImage_Call struct {
 
 // GetDimensionsByImage is a helper method to define mock.On call
 //   - file string
-func (_e *Provider_Expecter) GetDimensionsByImage(file interface{}) *Provider_GetDimensionsByImage_Call {
-	return &Provider_GetDimensionsByImage_Call{Call: _e.mock.On("GetDimensionsByImage", file)}
+//   - frame int
+func (_e *Provider_Expecter) GetDimensionsByImage(file interface{}, frame interface{}) *Provider_GetDimensionsByImage_Call {
+	return &Provider_GetDimensionsByImage_Call{Call: _e.mock.On("GetDimensionsByImage", file, frame)}
 }
 
-func (_c *Provider_GetDimensionsByImage_Call) Run(run func(file string)) *Provider_GetDimensionsByImage_Call {
+func (_c *Provider_GetDimensionsByImage_Call) Run(run func(file string, frame int)) *Provider_GetDimensionsByImage_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		run(args[0].(string), args[1].(int))
 	})
 	return _c
 }
@@ -379,14 +950,14 @@ func (_c *Provider_GetDimensionsByImage_Call) Return(_a0 *entity.Dimensions, _a1
 	return _c
 }
 
-func (_c *Provider_GetDimensionsByImage_Call) RunAndReturn(run func(string) (*entity.Dimensions, error)) *Provider_GetDimensionsByImage_Call {
+func (_c *Provider_GetDimensionsByImage_Call) RunAndReturn(run func(string, int) (*entity.Dimensions, error)) *Provider_GetDimensionsByImage_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetDimensionsByImageByte provides a mock function with given fields: bytes, _a1
-func (_m *Provider) GetDimensionsByImageByte(bytes []byte, _a1 extension.Type) (*entity.Dimensions, error) {
-	ret := _m.Called(bytes, _a1)
+// GetDimensionsByImageByte provides a mock function with given fields: bytes, _a1, frame
+func (_m *Provider) GetDimensionsByImageByte(bytes []byte, _a1 extension.Type, frame int) (*entity.Dimensions, error) {
+	ret := _m.Called(bytes, _a1, frame)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetDimensionsByImageByte")
@@ -394,19 +965,19 @@ func (_m *Provider) GetDimensionsByImageByte(bytes []byte, _a1 extension.Type) (
 
 	var r0 *entity.Dimensions
 	var r1 error
-	if rf, ok := ret.Get(0).(func([]byte, extension.Type) (*entity.Dimensions, error)); ok {
-		return rf(bytes, _a1)
+	if rf, ok := ret.Get(0).(func([]byte, extension.Type, int) (*entity.Dimensions, error)); ok {
+		return rf(bytes, _a1, frame)
 	}
-	if rf, ok := ret.Get(0).(func([]byte, extension.Type) *entity.Dimensions); ok {
-		r0 = rf(bytes, _a1)
+	if rf, ok := ret.Get(0).(func([]byte, extension.Type, int) *entity.Dimensions); ok {
+		r0 = rf(bytes, _a1, frame)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*entity.Dimensions)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func([]byte, extension.Type) error); ok {
-		r1 = rf(bytes, _a1)
+	if rf, ok := ret.Get(1).(func([]byte, extension.Type, int) error); ok {
+		r1 = rf(bytes, _a1, frame)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -422,13 +993,14 @@ type Provider_GetDimensionsByImageByte_Call struct {
 // GetDimensionsByImageByte is a helper method to define mock.On call
 //   - bytes []byte
 //   - _a1 extension.Type
-func (_e *Provider_Expecter) GetDimensionsByImageByte(bytes interface{}, _a1 interface{}) *Provider_GetDimensionsByImageByte_Call {
-	return &Provider_GetDimensionsByImageByte_Call{Call: _e.mock.On("GetDimensionsByImageByte", bytes, _a1)}
+//   - frame int
+func (_e *Provider_Expecter) GetDimensionsByImageByte(bytes interface{}, _a1 interface{}, frame interface{}) *Provider_GetDimensionsByImageByte_Call {
+	return &Provider_GetDimensionsByImageByte_Call{Call: _e.mock.On("GetDimensionsByImageByte", bytes, _a1, frame)}
 }
 
-func (_c *Provider_GetDimensionsByImageByte_Call) Run(run func(bytes []byte, _a1 extension.Type)) *Provider_GetDimensionsByImageByte_Call {
+func (_c *Provider_GetDimensionsByImageByte_Call) Run(run func(bytes []byte, _a1 extension.Type, frame int)) *Provider_GetDimensionsByImageByte_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].([]byte), args[1].(extension.Type))
+		run(args[0].([]byte), args[1].(extension.Type), args[2].(int))
 	})
 	return _c
 }
@@ -438,7 +1010,124 @@ func (_c *Provider_GetDimensionsByImageByte_Call) Return(_a0 *entity.Dimensions,
 	return _c
 }
 
-func (_c *Provider_GetDimensionsByImageByte_Call) RunAndReturn(run func([]byte, extension.Type) (*entity.Dimensions, error)) *Provider_GetDimensionsByImageByte_Call {
+func (_c *Provider_GetDimensionsByImageByte_Call) RunAndReturn(run func([]byte, extension.Type, int) (*entity.Dimensions, error)) *Provider_GetDimensionsByImageByte_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDimensionsByMatrixCode provides a mock function with given fields: code, symbology
+func (_m *Provider) GetDimensionsByMatrixCode(code string, symbology matrixcode.Symbology) (*entity.Dimensions, error) {
+	ret := _m.Called(code, symbology)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDimensionsByMatrixCode")
+	}
+
+	var r0 *entity.Dimensions
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, matrixcode.Symbology) (*entity.Dimensions, error)); ok {
+		return rf(code, symbology)
+	}
+	if rf, ok := ret.Get(0).(func(string, matrixcode.Symbology) *entity.Dimensions); ok {
+		r0 = rf(code, symbology)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Dimensions)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, matrixcode.Symbology) error); ok {
+		r1 = rf(code, symbology)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Provider_GetDimensionsByMatrixCode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDimensionsByMatrixCode'
+type Provider_GetDimensionsByMatrixCode_Call struct {
+	*mock.Call
+}
+
+// GetDimensionsByMatrixCode is a helper method to define mock.On call
+//   - code string
+//   - symbology matrixcode.Symbology
+func (_e *Provider_Expecter) GetDimensionsByMatrixCode(code interface{}, symbology interface{}) *Provider_GetDimensionsByMatrixCode_Call {
+	return &Provider_GetDimensionsByMatrixCode_Call{Call: _e.mock.On("GetDimensionsByMatrixCode", code, symbology)}
+}
+
+func (_c *Provider_GetDimensionsByMatrixCode_Call) Run(run func(code string, symbology matrixcode.Symbology)) *Provider_GetDimensionsByMatrixCode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(matrixcode.Symbology))
+	})
+	return _c
+}
+
+func (_c *Provider_GetDimensionsByMatrixCode_Call) Return(_a0 *entity.Dimensions, _a1 error) *Provider_GetDimensionsByMatrixCode_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Provider_GetDimensionsByMatrixCode_Call) RunAndReturn(run func(string, matrixcode.Symbology) (*entity.Dimensions, error)) *Provider_GetDimensionsByMatrixCode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDimensionsByQrCode provides a mock function with given fields: code
+func (_m *Provider) GetDimensionsByQrCode(code string) (*entity.Dimensions, error) {
+	ret := _m.Called(code)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDimensionsByQrCode")
+	}
+
+	var r0 *entity.Dimensions
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*entity.Dimensions, error)); ok {
+		return rf(code)
+	}
+	if rf, ok := ret.Get(0).(func(string) *entity.Dimensions); ok {
+		r0 = rf(code)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Dimensions)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(code)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Provider_GetDimensionsByQrCode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDimensionsByQrCode'
+type Provider_GetDimensionsByQrCode_Call struct {
+	*mock.Call
+}
+
+// GetDimensionsByQrCode is a helper method to define mock.On call
+//   - code string
+func (_e *Provider_Expecter) GetDimensionsByQrCode(code interface{}) *Provider_GetDimensionsByQrCode_Call {
+	return &Provider_GetDimensionsByQrCode_Call{Call: _e.mock.On("GetDimensionsByQrCode", code)}
+}
+
+func (_c *Provider_GetDimensionsByQrCode_Call) Run(run func(code string)) *Provider_GetDimensionsByQrCode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *Provider_GetDimensionsByQrCode_Call) Return(_a0 *entity.Dimensions, _a1 error) *Provider_GetDimensionsByQrCode_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Provider_GetDimensionsByQrCode_Call) RunAndReturn(run func(string) (*entity.Dimensions, error)) *Provider_GetDimensionsByQrCode_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -489,6 +1178,53 @@ func (_c *Provider_GetFontHeight_Call) RunAndReturn(run func(*props.Font) float6
 	return _c
 }
 
+// GetHeadings provides a mock function with given fields:
+func (_m *Provider) GetHeadings() []*entity.Heading {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetHeadings")
+	}
+
+	var r0 []*entity.Heading
+	if rf, ok := ret.Get(0).(func() []*entity.Heading); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.Heading)
+		}
+	}
+
+	return r0
+}
+
+// Provider_GetHeadings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetHeadings'
+type Provider_GetHeadings_Call struct {
+	*mock.Call
+}
+
+// GetHeadings is a helper method to define mock.On call
+func (_e *Provider_Expecter) GetHeadings() *Provider_GetHeadings_Call {
+	return &Provider_GetHeadings_Call{Call: _e.mock.On("GetHeadings")}
+}
+
+func (_c *Provider_GetHeadings_Call) Run(run func()) *Provider_GetHeadings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Provider_GetHeadings_Call) Return(_a0 []*entity.Heading) *Provider_GetHeadings_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Provider_GetHeadings_Call) RunAndReturn(run func() []*entity.Heading) *Provider_GetHeadings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetLinesQuantity provides a mock function with given fields: text, textProp, colWidth
 func (_m *Provider) GetLinesQuantity(text string, textProp *props.Text, colWidth float64) int {
 	ret := _m.Called(text, textProp, colWidth)
@@ -537,6 +1273,134 @@ func (_c *Provider_GetLinesQuantity_Call) RunAndReturn(run func(string, *props.T
 	return _c
 }
 
+// GetRichTextHeight provides a mock function with given fields: spans, prop, colWidth
+func (_m *Provider) GetRichTextHeight(spans []entity.Span, prop *props.RichText, colWidth float64) float64 {
+	ret := _m.Called(spans, prop, colWidth)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRichTextHeight")
+	}
+
+	var r0 float64
+	if rf, ok := ret.Get(0).(func([]entity.Span, *props.RichText, float64) float64); ok {
+		r0 = rf(spans, prop, colWidth)
+	} else {
+		r0 = ret.Get(0).(float64)
+	}
+
+	return r0
+}
+
+// Provider_GetRichTextHeight_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRichTextHeight'
+type Provider_GetRichTextHeight_Call struct {
+	*mock.Call
+}
+
+// GetRichTextHeight is a helper method to define mock.On call
+//   - spans []entity.Span
+//   - prop *props.RichText
+//   - colWidth float64
+func (_e *Provider_Expecter) GetRichTextHeight(spans interface{}, prop interface{}, colWidth interface{}) *Provider_GetRichTextHeight_Call {
+	return &Provider_GetRichTextHeight_Call{Call: _e.mock.On("GetRichTextHeight", spans, prop, colWidth)}
+}
+
+func (_c *Provider_GetRichTextHeight_Call) Run(run func(spans []entity.Span, prop *props.RichText, colWidth float64)) *Provider_GetRichTextHeight_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]entity.Span), args[1].(*props.RichText), args[2].(float64))
+	})
+	return _c
+}
+
+func (_c *Provider_GetRichTextHeight_Call) Return(_a0 float64) *Provider_GetRichTextHeight_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Provider_GetRichTextHeight_Call) RunAndReturn(run func([]entity.Span, *props.RichText, float64) float64) *Provider_GetRichTextHeight_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTextWidth provides a mock function with given fields: text, prop
+func (_m *Provider) GetTextWidth(text string, prop *props.Font) float64 {
+	ret := _m.Called(text, prop)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTextWidth")
+	}
+
+	var r0 float64
+	if rf, ok := ret.Get(0).(func(string, *props.Font) float64); ok {
+		r0 = rf(text, prop)
+	} else {
+		r0 = ret.Get(0).(float64)
+	}
+
+	return r0
+}
+
+// Provider_GetTextWidth_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTextWidth'
+type Provider_GetTextWidth_Call struct {
+	*mock.Call
+}
+
+// GetTextWidth is a helper method to define mock.On call
+//   - text string
+//   - prop *props.Font
+func (_e *Provider_Expecter) GetTextWidth(text interface{}, prop interface{}) *Provider_GetTextWidth_Call {
+	return &Provider_GetTextWidth_Call{Call: _e.mock.On("GetTextWidth", text, prop)}
+}
+
+func (_c *Provider_GetTextWidth_Call) Run(run func(text string, prop *props.Font)) *Provider_GetTextWidth_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(*props.Font))
+	})
+	return _c
+}
+
+func (_c *Provider_GetTextWidth_Call) Return(_a0 float64) *Provider_GetTextWidth_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Provider_GetTextWidth_Call) RunAndReturn(run func(string, *props.Font) float64) *Provider_GetTextWidth_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// OpenGrid provides a mock function with given fields: cell
+func (_m *Provider) OpenGrid(cell *entity.Cell) {
+	_m.Called(cell)
+}
+
+// Provider_OpenGrid_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OpenGrid'
+type Provider_OpenGrid_Call struct {
+	*mock.Call
+}
+
+// OpenGrid is a helper method to define mock.On call
+//   - cell *entity.Cell
+func (_e *Provider_Expecter) OpenGrid(cell interface{}) *Provider_OpenGrid_Call {
+	return &Provider_OpenGrid_Call{Call: _e.mock.On("OpenGrid", cell)}
+}
+
+func (_c *Provider_OpenGrid_Call) Run(run func(cell *entity.Cell)) *Provider_OpenGrid_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*entity.Cell))
+	})
+	return _c
+}
+
+func (_c *Provider_OpenGrid_Call) Return() *Provider_OpenGrid_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Provider_OpenGrid_Call) RunAndReturn(run func(*entity.Cell)) *Provider_OpenGrid_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SetCompression provides a mock function with given fields: compression
 func (_m *Provider) SetCompression(compression bool) {
 	_m.Called(compression)
@@ -0,0 +1,174 @@
+// Code generated by mockery v2.49.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	entity "github.com/johnfercher/maroto/v2/pkg/core/entity"
+	mock "github.com/stretchr/testify/mock"
+
+	props "github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+// Shape is an autogenerated mock type for the Shape type
+type Shape struct {
+	mock.Mock
+}
+
+type Shape_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Shape) EXPECT() *Shape_Expecter {
+	return &Shape_Expecter{mock: &_m.Mock}
+}
+
+// AddEllipse provides a mock function with given fields: cell, prop
+func (_m *Shape) AddEllipse(cell *entity.Cell, prop *props.Shape) {
+	_m.Called(cell, prop)
+}
+
+// Shape_AddEllipse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddEllipse'
+type Shape_AddEllipse_Call struct {
+	*mock.Call
+}
+
+// AddEllipse is a helper method to define mock.On call
+//   - cell *entity.Cell
+//   - prop *props.Shape
+func (_e *Shape_Expecter) AddEllipse(cell interface{}, prop interface{}) *Shape_AddEllipse_Call {
+	return &Shape_AddEllipse_Call{Call: _e.mock.On("AddEllipse", cell, prop)}
+}
+
+func (_c *Shape_AddEllipse_Call) Run(run func(cell *entity.Cell, prop *props.Shape)) *Shape_AddEllipse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*entity.Cell), args[1].(*props.Shape))
+	})
+	return _c
+}
+
+func (_c *Shape_AddEllipse_Call) Return() *Shape_AddEllipse_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Shape_AddEllipse_Call) RunAndReturn(run func(*entity.Cell, *props.Shape)) *Shape_AddEllipse_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddPolygon provides a mock function with given fields: points, prop
+func (_m *Shape) AddPolygon(points []entity.Point, prop *props.Shape) {
+	_m.Called(points, prop)
+}
+
+// Shape_AddPolygon_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddPolygon'
+type Shape_AddPolygon_Call struct {
+	*mock.Call
+}
+
+// AddPolygon is a helper method to define mock.On call
+//   - points []entity.Point
+//   - prop *props.Shape
+func (_e *Shape_Expecter) AddPolygon(points interface{}, prop interface{}) *Shape_AddPolygon_Call {
+	return &Shape_AddPolygon_Call{Call: _e.mock.On("AddPolygon", points, prop)}
+}
+
+func (_c *Shape_AddPolygon_Call) Run(run func(points []entity.Point, prop *props.Shape)) *Shape_AddPolygon_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]entity.Point), args[1].(*props.Shape))
+	})
+	return _c
+}
+
+func (_c *Shape_AddPolygon_Call) Return() *Shape_AddPolygon_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Shape_AddPolygon_Call) RunAndReturn(run func([]entity.Point, *props.Shape)) *Shape_AddPolygon_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddPolyline provides a mock function with given fields: points, prop
+func (_m *Shape) AddPolyline(points []entity.Point, prop *props.Shape) {
+	_m.Called(points, prop)
+}
+
+// Shape_AddPolyline_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddPolyline'
+type Shape_AddPolyline_Call struct {
+	*mock.Call
+}
+
+// AddPolyline is a helper method to define mock.On call
+//   - points []entity.Point
+//   - prop *props.Shape
+func (_e *Shape_Expecter) AddPolyline(points interface{}, prop interface{}) *Shape_AddPolyline_Call {
+	return &Shape_AddPolyline_Call{Call: _e.mock.On("AddPolyline", points, prop)}
+}
+
+func (_c *Shape_AddPolyline_Call) Run(run func(points []entity.Point, prop *props.Shape)) *Shape_AddPolyline_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]entity.Point), args[1].(*props.Shape))
+	})
+	return _c
+}
+
+func (_c *Shape_AddPolyline_Call) Return() *Shape_AddPolyline_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Shape_AddPolyline_Call) RunAndReturn(run func([]entity.Point, *props.Shape)) *Shape_AddPolyline_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddRectangle provides a mock function with given fields: cell, prop
+func (_m *Shape) AddRectangle(cell *entity.Cell, prop *props.Shape) {
+	_m.Called(cell, prop)
+}
+
+// Shape_AddRectangle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddRectangle'
+type Shape_AddRectangle_Call struct {
+	*mock.Call
+}
+
+// AddRectangle is a helper method to define mock.On call
+//   - cell *entity.Cell
+//   - prop *props.Shape
+func (_e *Shape_Expecter) AddRectangle(cell interface{}, prop interface{}) *Shape_AddRectangle_Call {
+	return &Shape_AddRectangle_Call{Call: _e.mock.On("AddRectangle", cell, prop)}
+}
+
+func (_c *Shape_AddRectangle_Call) Run(run func(cell *entity.Cell, prop *props.Shape)) *Shape_AddRectangle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*entity.Cell), args[1].(*props.Shape))
+	})
+	return _c
+}
+
+func (_c *Shape_AddRectangle_Call) Return() *Shape_AddRectangle_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Shape_AddRectangle_Call) RunAndReturn(run func(*entity.Cell, *props.Shape)) *Shape_AddRectangle_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewShape creates a new instance of Shape. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewShape(t interface {
+	mock.TestingT
+	Cleanup(func())
+},
+) *Shape {
+	mock := &Shape{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -57,6 +57,41 @@ func (_c *Text_Add_Call) RunAndReturn(run func(string, *entity.Cell, *props.Text
 	return _c
 }
 
+// AddRich provides a mock function with given fields: spans, cell, prop
+func (_m *Text) AddRich(spans []entity.Span, cell *entity.Cell, prop *props.RichText) {
+	_m.Called(spans, cell, prop)
+}
+
+// Text_AddRich_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddRich'
+type Text_AddRich_Call struct {
+	*mock.Call
+}
+
+// AddRich is a helper method to define mock.On call
+//   - spans []entity.Span
+//   - cell *entity.Cell
+//   - prop *props.RichText
+func (_e *Text_Expecter) AddRich(spans interface{}, cell interface{}, prop interface{}) *Text_AddRich_Call {
+	return &Text_AddRich_Call{Call: _e.mock.On("AddRich", spans, cell, prop)}
+}
+
+func (_c *Text_AddRich_Call) Run(run func(spans []entity.Span, cell *entity.Cell, prop *props.RichText)) *Text_AddRich_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]entity.Span), args[1].(*entity.Cell), args[2].(*props.RichText))
+	})
+	return _c
+}
+
+func (_c *Text_AddRich_Call) Return() *Text_AddRich_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Text_AddRich_Call) RunAndReturn(run func([]entity.Span, *entity.Cell, *props.RichText)) *Text_AddRich_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetLinesQuantity provides a mock function with given fields: text, textProp, colWidth
 func (_m *Text) GetLinesQuantity(text string, textProp *props.Text, colWidth float64) int {
 	ret := _m.Called(text, textProp, colWidth)
@@ -105,6 +140,101 @@ func (_c *Text_GetLinesQuantity_Call) RunAndReturn(run func(string, *props.Text,
 	return _c
 }
 
+// GetRichHeight provides a mock function with given fields: spans, prop, colWidth
+func (_m *Text) GetRichHeight(spans []entity.Span, prop *props.RichText, colWidth float64) float64 {
+	ret := _m.Called(spans, prop, colWidth)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRichHeight")
+	}
+
+	var r0 float64
+	if rf, ok := ret.Get(0).(func([]entity.Span, *props.RichText, float64) float64); ok {
+		r0 = rf(spans, prop, colWidth)
+	} else {
+		r0 = ret.Get(0).(float64)
+	}
+
+	return r0
+}
+
+// Text_GetRichHeight_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRichHeight'
+type Text_GetRichHeight_Call struct {
+	*mock.Call
+}
+
+// GetRichHeight is a helper method to define mock.On call
+//   - spans []entity.Span
+//   - prop *props.RichText
+//   - colWidth float64
+func (_e *Text_Expecter) GetRichHeight(spans interface{}, prop interface{}, colWidth interface{}) *Text_GetRichHeight_Call {
+	return &Text_GetRichHeight_Call{Call: _e.mock.On("GetRichHeight", spans, prop, colWidth)}
+}
+
+func (_c *Text_GetRichHeight_Call) Run(run func(spans []entity.Span, prop *props.RichText, colWidth float64)) *Text_GetRichHeight_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]entity.Span), args[1].(*props.RichText), args[2].(float64))
+	})
+	return _c
+}
+
+func (_c *Text_GetRichHeight_Call) Return(_a0 float64) *Text_GetRichHeight_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Text_GetRichHeight_Call) RunAndReturn(run func([]entity.Span, *props.RichText, float64) float64) *Text_GetRichHeight_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetWidth provides a mock function with given fields: text, prop
+func (_m *Text) GetWidth(text string, prop *props.Font) float64 {
+	ret := _m.Called(text, prop)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWidth")
+	}
+
+	var r0 float64
+	if rf, ok := ret.Get(0).(func(string, *props.Font) float64); ok {
+		r0 = rf(text, prop)
+	} else {
+		r0 = ret.Get(0).(float64)
+	}
+
+	return r0
+}
+
+// Text_GetWidth_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWidth'
+type Text_GetWidth_Call struct {
+	*mock.Call
+}
+
+// GetWidth is a helper method to define mock.On call
+//   - text string
+//   - prop *props.Font
+func (_e *Text_Expecter) GetWidth(text interface{}, prop interface{}) *Text_GetWidth_Call {
+	return &Text_GetWidth_Call{Call: _e.mock.On("GetWidth", text, prop)}
+}
+
+func (_c *Text_GetWidth_Call) Run(run func(text string, prop *props.Font)) *Text_GetWidth_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(*props.Font))
+	})
+	return _c
+}
+
+func (_c *Text_GetWidth_Call) Return(_a0 float64) *Text_GetWidth_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Text_GetWidth_Call) RunAndReturn(run func(string, *props.Font) float64) *Text_GetWidth_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewText creates a new instance of Text. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewText(t interface {
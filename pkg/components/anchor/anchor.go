@@ -0,0 +1,49 @@
+// Package anchor implements creation of anchors, named positions of the document which may be
+// referred by links and page references.
+package anchor
+
+import (
+	"github.com/johnfercher/go-tree/node"
+
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+)
+
+type Anchor struct {
+	name   string
+	config *entity.Config
+}
+
+// New is responsible to create an instance of an Anchor. An anchor has no height, it should be
+// added to the col of the row it marks, so it points to the page and position where the row
+// lands after the page breaks.
+func New(name string) core.Component {
+	return &Anchor{
+		name: name,
+	}
+}
+
+// GetStructure returns the Structure of an Anchor.
+func (a *Anchor) GetStructure() *node.Node[core.Structure] {
+	str := core.Structure{
+		Type:  "anchor",
+		Value: a.name,
+	}
+
+	return node.New(str)
+}
+
+// GetHeight returns the height of an Anchor, which does not take space in the PDF.
+func (a *Anchor) GetHeight(provider core.Provider, cell *entity.Cell) float64 {
+	return 0
+}
+
+// SetConfig sets the config.
+func (a *Anchor) SetConfig(config *entity.Config) {
+	a.config = config
+}
+
+// Render adds the Anchor at the top of the cell.
+func (a *Anchor) Render(provider core.Provider, cell *entity.Cell) {
+	provider.AddAnchor(a.name, cell)
+}
@@ -0,0 +1,63 @@
+package anchor_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/mocks"
+	"github.com/johnfercher/maroto/v2/pkg/components/anchor"
+	"github.com/johnfercher/maroto/v2/pkg/test"
+)
+
+func TestNew(t *testing.T) {
+	t.Run("should create an anchor", func(t *testing.T) {
+		// Act
+		sut := anchor.New("summary")
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/anchors/new_anchor.json")
+	})
+}
+
+func TestAnchor_GetHeight(t *testing.T) {
+	t.Run("should not take space", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		sut := anchor.New("summary")
+
+		// Act
+		height := sut.GetHeight(nil, &cell)
+
+		// Assert
+		assert.Equal(t, 0.0, height)
+	})
+}
+
+func TestAnchor_Render(t *testing.T) {
+	t.Run("should call provider correctly", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		sut := anchor.New("summary")
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().AddAnchor("summary", &cell)
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		provider.AssertNumberOfCalls(t, "AddAnchor", 1)
+	})
+}
+
+func TestAnchor_SetConfig(t *testing.T) {
+	t.Run("should call correctly", func(t *testing.T) {
+		// Arrange
+		sut := anchor.New("summary")
+
+		// Act
+		sut.SetConfig(nil)
+	})
+}
@@ -0,0 +1,30 @@
+package anchor_test
+
+import (
+	"github.com/johnfercher/maroto/v2"
+	"github.com/johnfercher/maroto/v2/pkg/components/anchor"
+	"github.com/johnfercher/maroto/v2/pkg/components/col"
+	"github.com/johnfercher/maroto/v2/pkg/components/text"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+// ExampleNew demonstrates how to add an anchor and link a text to it.
+func ExampleNew() {
+	m := maroto.New()
+
+	name := "details"
+	m.AddRows(text.NewRow(10, "Go to details", props.Text{InternalLink: &name}))
+	m.AddRow(10, col.New(12).Add(anchor.New(name), text.New("Details")))
+
+	// generate document
+}
+
+// ExampleNewReference demonstrates how to write the page of an anchor.
+func ExampleNewReference() {
+	m := maroto.New()
+
+	m.AddRows(anchor.NewReferenceRow(10, "details", "The totals are detailed on page {page}."))
+	m.AddRow(10, col.New(12).Add(anchor.New("details"), text.New("Details")))
+
+	// generate document
+}
@@ -0,0 +1,101 @@
+package anchor
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/johnfercher/go-tree/node"
+
+	"github.com/johnfercher/maroto/v2/pkg/components/col"
+	"github.com/johnfercher/maroto/v2/pkg/components/row"
+	"github.com/johnfercher/maroto/v2/pkg/components/text"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+// pagePlaceholder is replaced by the page of the anchor in the value of a Reference.
+const pagePlaceholder = "{page}"
+
+type Reference struct {
+	name   string
+	value  string
+	prop   props.Text
+	config *entity.Config
+}
+
+// NewReference is responsible to create an instance of a Reference, a text which links to an
+// anchor, where {page} is replaced by the page of the anchor, ex: "see page {page}".
+func NewReference(name string, value string, ps ...props.Text) core.Component {
+	textProp := props.Text{}
+	if len(ps) > 0 {
+		textProp = ps[0]
+	}
+	textProp.InternalLink = &name
+
+	return &Reference{
+		name:  name,
+		value: value,
+		prop:  textProp,
+	}
+}
+
+// NewReferenceCol is responsible to create an instance of a Reference wrapped in a Col.
+func NewReferenceCol(size int, name string, value string, ps ...props.Text) core.Col {
+	reference := NewReference(name, value, ps...)
+	return col.New(size).Add(reference)
+}
+
+// NewReferenceRow is responsible to create an instance of a Reference wrapped in a Row.
+func NewReferenceRow(height float64, name string, value string, ps ...props.Text) core.Row {
+	reference := NewReference(name, value, ps...)
+	c := col.New().Add(reference)
+	return row.New(height).Add(c)
+}
+
+// NewReferenceAutoRow is responsible to create an instance of a Reference wrapped in a automatic Row.
+func NewReferenceAutoRow(name string, value string, ps ...props.Text) core.Row {
+	reference := NewReference(name, value, ps...)
+	c := col.New().Add(reference)
+	return row.New().Add(c)
+}
+
+// GetStructure returns the Structure of a Reference.
+func (r *Reference) GetStructure() *node.Node[core.Structure] {
+	str := core.Structure{
+		Type:    "reference",
+		Value:   r.value,
+		Details: r.prop.ToMap(),
+	}
+
+	return node.New(str)
+}
+
+// GetHeight returns the height of the text of the Reference.
+func (r *Reference) GetHeight(provider core.Provider, cell *entity.Cell) float64 {
+	return r.getText(provider).GetHeight(provider, cell)
+}
+
+// SetConfig sets the config.
+func (r *Reference) SetConfig(config *entity.Config) {
+	r.config = config
+	r.prop.MakeValid(config.DefaultFont)
+}
+
+// Render renders the text of the Reference into a PDF context.
+func (r *Reference) Render(provider core.Provider, cell *entity.Cell) {
+	r.getText(provider).Render(provider, cell)
+}
+
+// getText returns the text of the Reference with the page of the anchor.
+func (r *Reference) getText(provider core.Provider) core.Component {
+	page := core.UnknownPage
+	if number, ok := provider.GetAnchorPage(r.name); ok {
+		page = strconv.Itoa(number)
+	}
+
+	t := text.New(strings.ReplaceAll(r.value, pagePlaceholder, page), r.prop)
+	t.SetConfig(r.config)
+
+	return t
+}
@@ -0,0 +1,108 @@
+package anchor_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/mocks"
+	"github.com/johnfercher/maroto/v2/pkg/components/anchor"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+	"github.com/johnfercher/maroto/v2/pkg/test"
+)
+
+func TestNewReference(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := anchor.NewReference("summary", "see page {page}")
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/anchors/new_reference_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := anchor.NewReference("summary", "see page {page}", fixture.TextProp())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/anchors/new_reference_custom_prop.json")
+	})
+}
+
+func TestNewReferenceCol(t *testing.T) {
+	// Act
+	sut := anchor.NewReferenceCol(12, "summary", "see page {page}")
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/anchors/new_reference_col.json")
+}
+
+func TestNewReferenceRow(t *testing.T) {
+	// Act
+	sut := anchor.NewReferenceRow(10, "summary", "see page {page}")
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/anchors/new_reference_row.json")
+}
+
+func TestNewReferenceAutoRow(t *testing.T) {
+	// Act
+	sut := anchor.NewReferenceAutoRow("summary", "see page {page}")
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/anchors/new_reference_auto_row.json")
+}
+
+func TestReference_Render(t *testing.T) {
+	t.Run("when page is known, should write the page and link to the anchor", func(t *testing.T) {
+		// Arrange
+		name := "summary"
+		cell := fixture.CellEntity()
+		font := fixture.FontProp()
+		prop := props.Text{InternalLink: &name}
+		prop.MakeValid(&font)
+
+		sut := anchor.NewReference(name, "see page {page}")
+		sut.SetConfig(&entity.Config{DefaultFont: &font})
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().GetAnchorPage(name).Return(12, true)
+		provider.EXPECT().AddText("see page 12", &cell, &prop)
+		provider.EXPECT().GetLinesQuantity("see page 12", &prop, 100.0).Return(1)
+		provider.EXPECT().GetFontHeight(&font).Return(5.0)
+		provider.EXPECT().AddInternalLink(name, &entity.Cell{X: 10, Y: 15, Width: 100, Height: 5})
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		provider.AssertNumberOfCalls(t, "AddText", 1)
+		provider.AssertNumberOfCalls(t, "AddInternalLink", 1)
+	})
+}
+
+func TestReference_GetHeight(t *testing.T) {
+	t.Run("when page is unknown, should measure the text with a three digits page", func(t *testing.T) {
+		// Arrange
+		name := "summary"
+		cell := fixture.CellEntity()
+		font := fixture.FontProp()
+		prop := props.Text{InternalLink: &name}
+		prop.MakeValid(&font)
+
+		sut := anchor.NewReference(name, "see page {page}")
+		sut.SetConfig(&entity.Config{DefaultFont: &font})
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().GetAnchorPage(name).Return(0, false)
+		provider.EXPECT().GetLinesQuantity("see page 000", &prop, 100.0).Return(2)
+		provider.EXPECT().GetFontHeight(&font).Return(5.0)
+
+		// Act
+		height := sut.GetHeight(provider, &cell)
+
+		// Assert
+		assert.Equal(t, 10.0, height)
+	})
+}
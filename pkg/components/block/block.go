@@ -0,0 +1,189 @@
+// Package block implements creation of blocks, sets of rows with cells that span rows and columns.
+package block
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/johnfercher/maroto/v2/pkg/components/col"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+// Cell is a cell of a Block, it may span many rows and columns.
+type Cell struct {
+	// Components are rendered inside the cell, like in a col.
+	Components []core.Component
+	// RowSpan is how many rows the cell occupies, the default is 1.
+	RowSpan int
+	// ColSpan is how many columns the cell occupies, the default is 1.
+	ColSpan int
+	// Style is the style of the cell, its background and borders cover the whole merged area.
+	Style *props.Cell
+}
+
+// Block is a set of rows whose cells may be merged vertically and horizontally, like a
+// financial statement with a quarter next to its months. Rows connected by a cell that
+// spans them are kept on the same page, and the header rows are repeated on every page
+// the block occupies.
+type Block struct {
+	sizes  []int
+	header [][]Cell
+	rows   [][]Cell
+	prop   props.Block
+}
+
+// New is responsible to create an instance of a Block, sizes are the grid sizes of its columns.
+func New(sizes []int, ps ...props.Block) *Block {
+	prop := props.Block{}
+	if len(ps) > 0 {
+		prop = ps[0]
+	}
+	prop.MakeValid()
+
+	return &Block{
+		sizes: sizes,
+		prop:  prop,
+	}
+}
+
+// NewCell is responsible to create a Cell that occupies one row and one column.
+func NewCell(components ...core.Component) Cell {
+	return Cell{Components: components}
+}
+
+// WithSpan returns a copy of the Cell that occupies many rows and columns.
+func (c Cell) WithSpan(rowSpan, colSpan int) Cell {
+	c.RowSpan = rowSpan
+	c.ColSpan = colSpan
+	return c
+}
+
+// WithStyle returns a copy of the Cell with a style.
+func (c Cell) WithStyle(style *props.Cell) Cell {
+	c.Style = style
+	return c
+}
+
+// AddHeader is responsible to add a header row to a Block, the header rows are repeated
+// when the block is split by a page break.
+func (b *Block) AddHeader(cells ...Cell) *Block {
+	b.header = append(b.header, cells)
+	return b
+}
+
+// Add is responsible to add a row to a Block, cells are placed from left to right in the
+// columns which are not occupied by cells spanning from the rows above.
+func (b *Block) Add(cells ...Cell) *Block {
+	b.rows = append(b.rows, cells)
+	return b
+}
+
+// Build is responsible to build the rows of a Block, each built row contains the block rows
+// connected by spanning cells, so they are never split by a page break.
+func (b *Block) Build() ([]core.Row, error) {
+	if len(b.sizes) == 0 {
+		return nil, errors.New("block must have at least one column")
+	}
+
+	for i, size := range b.sizes {
+		if size <= 0 {
+			return nil, fmt.Errorf("column %d must have a size greater than zero", i)
+		}
+	}
+
+	header, err := b.buildGroups(b.header, nil)
+	if err != nil {
+		return nil, fmt.Errorf("header: %w", err)
+	}
+
+	rows, err := b.buildGroups(b.rows, header)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(header, rows...), nil
+}
+
+// placedCell is a Cell with its position in the block rows.
+type placedCell struct {
+	row     int
+	column  int
+	rowSpan int
+	colSpan int
+	content core.Col
+}
+
+func (b *Block) buildGroups(rows [][]Cell, header []core.Row) ([]core.Row, error) {
+	placed, err := b.place(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	var groups []core.Row
+	start, end := 0, 0
+	for i := range rows {
+		for _, cell := range placed {
+			if cell.row == i {
+				end = max(end, i+cell.rowSpan)
+			}
+		}
+
+		if i+1 < end {
+			continue
+		}
+
+		groups = append(groups, b.newGroup(rows[start:i+1], placed, start, header))
+		start, end = i+1, i+1
+	}
+
+	return groups, nil
+}
+
+// place returns the cells with their positions, following the same algorithm of html tables:
+// each cell is placed in the first column which is not occupied by a cell from the rows above.
+func (b *Block) place(rows [][]Cell) ([]placedCell, error) {
+	occupied := make([][]bool, len(rows))
+	for i := range occupied {
+		occupied[i] = make([]bool, len(b.sizes))
+	}
+
+	var placed []placedCell
+	for i, cells := range rows {
+		position := 0
+		for _, cell := range cells {
+			for position < len(b.sizes) && occupied[i][position] {
+				position++
+			}
+
+			rowSpan := min(max(cell.RowSpan, 1), len(rows)-i)
+			colSpan := max(cell.ColSpan, 1)
+			if position+colSpan > len(b.sizes) {
+				return nil, fmt.Errorf("row %d has cells beyond the %d columns", i, len(b.sizes))
+			}
+
+			size := 0
+			for j := position; j < position+colSpan; j++ {
+				if occupied[i][j] {
+					return nil, fmt.Errorf("row %d has a cell overlapping a cell from the rows above", i)
+				}
+
+				size += b.sizes[j]
+				for k := i; k < i+rowSpan; k++ {
+					occupied[k][j] = true
+				}
+			}
+
+			placed = append(placed, placedCell{
+				row:     i,
+				column:  position,
+				rowSpan: rowSpan,
+				colSpan: colSpan,
+				content: col.New(size).Add(cell.Components...).WithStyle(cell.Style),
+			})
+			position += colSpan
+		}
+	}
+
+	return placed, nil
+}
@@ -0,0 +1,270 @@
+package block_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/mocks"
+	"github.com/johnfercher/maroto/v2/pkg/components/block"
+	"github.com/johnfercher/maroto/v2/pkg/components/col"
+	"github.com/johnfercher/maroto/v2/pkg/components/text"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+	"github.com/johnfercher/maroto/v2/pkg/test"
+)
+
+func newTextCell(value string) block.Cell {
+	return block.NewCell(text.New(value))
+}
+
+func getQuarterBlock() *block.Block {
+	return block.New([]int{4, 4, 4}, props.Block{RowHeight: 5}).
+		AddHeader(newTextCell("Quarter"), newTextCell("Month"), newTextCell("Value")).
+		Add(newTextCell("Q1").WithSpan(3, 1), newTextCell("Jan"), newTextCell("10")).
+		Add(newTextCell("Feb"), newTextCell("20")).
+		Add(newTextCell("Mar"), newTextCell("30")).
+		Add(newTextCell("Total").WithSpan(1, 2), newTextCell("60"))
+}
+
+func TestNew(t *testing.T) {
+	// Act
+	sut := block.New([]int{6, 6}, fixture.BlockProp())
+
+	// Assert
+	assert.NotNil(t, sut)
+}
+
+func TestCell_WithSpan(t *testing.T) {
+	// Arrange
+	sut := newTextCell("value")
+
+	// Act
+	cell := sut.WithSpan(2, 3)
+
+	// Assert
+	assert.Equal(t, 2, cell.RowSpan)
+	assert.Equal(t, 3, cell.ColSpan)
+	assert.Equal(t, 0, sut.RowSpan)
+}
+
+func TestCell_WithStyle(t *testing.T) {
+	// Arrange
+	style := fixture.CellProp()
+
+	// Act
+	cell := newTextCell("value").WithStyle(&style)
+
+	// Assert
+	assert.Equal(t, &style, cell.Style)
+}
+
+func TestBlock_Build(t *testing.T) {
+	t.Run("when there is no column, should return error", func(t *testing.T) {
+		// Act
+		rows, err := block.New(nil).Build()
+
+		// Assert
+		assert.Nil(t, rows)
+		assert.NotNil(t, err)
+	})
+	t.Run("when a column has no size, should return error", func(t *testing.T) {
+		// Act
+		rows, err := block.New([]int{6, 0}).Build()
+
+		// Assert
+		assert.Nil(t, rows)
+		assert.NotNil(t, err)
+	})
+	t.Run("when a row has cells beyond the columns, should return error", func(t *testing.T) {
+		// Act
+		rows, err := block.New([]int{6, 6}).Add(newTextCell("a").WithSpan(1, 2), newTextCell("b")).Build()
+
+		// Assert
+		assert.Nil(t, rows)
+		assert.NotNil(t, err)
+	})
+	t.Run("when a cell overlaps a cell from above, should return error", func(t *testing.T) {
+		// Act
+		rows, err := block.New([]int{4, 4, 4}).
+			Add(newTextCell("a"), newTextCell("b").WithSpan(2, 1), newTextCell("c")).
+			Add(newTextCell("d").WithSpan(1, 2)).
+			Build()
+
+		// Assert
+		assert.Nil(t, rows)
+		assert.NotNil(t, err)
+	})
+	t.Run("when header is invalid, should return error", func(t *testing.T) {
+		// Act
+		rows, err := block.New([]int{12}).AddHeader(newTextCell("a"), newTextCell("b")).Build()
+
+		// Assert
+		assert.Nil(t, rows)
+		assert.ErrorContains(t, err, "header")
+	})
+	t.Run("when rows are connected by spans, should build them together", func(t *testing.T) {
+		// Act
+		rows, err := getQuarterBlock().Build()
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Len(t, rows, 3)
+		test.New(t).Assert(rows[0].GetStructure()).Equals("components/blocks/build_header.json")
+		test.New(t).Assert(rows[1].GetStructure()).Equals("components/blocks/build_row_span.json")
+		test.New(t).Assert(rows[2].GetStructure()).Equals("components/blocks/build_col_span.json")
+	})
+	t.Run("when block has header, should keep the header on the rows", func(t *testing.T) {
+		// Act
+		rows, _ := getQuarterBlock().Build()
+
+		// Assert
+		_, ok := rows[0].(core.HeaderedRow)
+		assert.False(t, ok)
+		headered, ok := rows[1].(core.HeaderedRow)
+		assert.True(t, ok)
+		assert.Equal(t, []core.Row{rows[0]}, headered.GetHeader())
+	})
+	t.Run("when span is greater than the remaining rows, should span until the last row", func(t *testing.T) {
+		// Act
+		rows, err := block.New([]int{6, 6}).Add(newTextCell("a").WithSpan(5, 1), newTextCell("b")).Build()
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Len(t, rows, 1)
+	})
+}
+
+func TestBlock_GetHeight(t *testing.T) {
+	t.Run("when spanning cell is shorter than its rows, should use the rows height", func(t *testing.T) {
+		// Arrange
+		cfg := &entity.Config{MaxGridSize: 12}
+		cell := fixture.CellEntity()
+
+		rows, _ := block.New([]int{6, 6}).
+			Add(block.NewCell(newComponent(t, 5)).WithSpan(2, 1), block.NewCell(newComponent(t, 10))).
+			Add(block.NewCell(newComponent(t, 8))).
+			Build()
+		rows[0].SetConfig(cfg)
+
+		// Act
+		height := rows[0].GetHeight(nil, &cell)
+
+		// Assert
+		assert.Equal(t, 18.0, height)
+	})
+	t.Run("when spanning cell is taller than its rows, should grow the rows evenly", func(t *testing.T) {
+		// Arrange
+		cfg := &entity.Config{MaxGridSize: 12}
+		cell := fixture.CellEntity()
+
+		rows, _ := block.New([]int{6, 6}, props.Block{RowHeight: 4}).
+			Add(block.NewCell(newComponent(t, 30)).WithSpan(2, 1), block.NewCell(newComponent(t, 10))).
+			Add(block.NewCell(newComponent(t, 2))).
+			Build()
+		rows[0].SetConfig(cfg)
+
+		// Act
+		height := rows[0].GetHeight(nil, &cell)
+
+		// Assert
+		assert.Equal(t, 30.0, height)
+	})
+	t.Run("when block is measured at two widths, should measure each width", func(t *testing.T) {
+		// Arrange
+		cfg := &entity.Config{MaxGridSize: 12}
+		wide := entity.Cell{Width: 100}
+		narrow := entity.Cell{Width: 50}
+
+		component := mocks.NewComponent(t)
+		component.EXPECT().SetConfig(cfg)
+		component.EXPECT().GetHeight(nil, mock.Anything).RunAndReturn(func(_ core.Provider, cell *entity.Cell) float64 {
+			return 500 / cell.Width
+		})
+
+		rows, _ := block.New([]int{12}, props.Block{RowHeight: 1}).
+			Add(block.NewCell(component)).
+			Build()
+		rows[0].SetConfig(cfg)
+
+		// Act
+		wideHeight := rows[0].GetHeight(nil, &wide)
+		narrowHeight := rows[0].GetHeight(nil, &narrow)
+
+		// Assert
+		assert.Equal(t, 5.0, wideHeight)
+		assert.Equal(t, 10.0, narrowHeight)
+	})
+}
+
+func TestBlock_Add(t *testing.T) {
+	t.Run("when group has cells, should add the cols in new rows spanning all columns", func(t *testing.T) {
+		// Arrange
+		rows, _ := block.New([]int{6, 6}, props.Block{RowHeight: 10}).Add(block.NewCell(), block.NewCell()).Build()
+		sut := rows[0]
+
+		// Act
+		sut.Add(col.New(), col.New())
+		sut.SetConfig(&entity.Config{MaxGridSize: 12})
+
+		// Assert
+		assert.Len(t, sut.GetColumns(), 4)
+		assert.Equal(t, 30.0, sut.GetHeight(nil, &entity.Cell{Width: 100}))
+	})
+	t.Run("when group has no cell, should fill the group with the col", func(t *testing.T) {
+		// Arrange
+		rows, _ := block.New([]int{6, 6}, props.Block{RowHeight: 10}).Add().Build()
+		sut := rows[0]
+
+		// Act
+		sut.Add(col.New())
+		sut.SetConfig(&entity.Config{MaxGridSize: 12})
+
+		// Assert
+		assert.Len(t, sut.GetColumns(), 1)
+		assert.Equal(t, 10.0, sut.GetHeight(nil, &entity.Cell{Width: 100}))
+	})
+}
+
+func TestBlock_Render(t *testing.T) {
+	// Arrange
+	cfg := &entity.Config{MaxGridSize: 12}
+	cell := fixture.CellEntity()
+	style := fixture.CellProp()
+
+	rows, _ := block.New([]int{6, 6}, props.Block{RowHeight: 10}).
+		Add(block.NewCell().WithSpan(2, 1).WithStyle(&style), block.NewCell()).
+		Add(block.NewCell()).
+		Build()
+	sut := rows[0]
+	sut.SetConfig(cfg)
+
+	provider := mocks.NewProvider(t)
+	provider.EXPECT().CreateCol(100.0, 20.0, cfg, (*props.Cell)(nil))
+	provider.EXPECT().OpenGrid(&entity.Cell{X: 10, Y: 15, Width: 50, Height: 20})
+	provider.EXPECT().CreateCol(50.0, 20.0, cfg, &style)
+	provider.EXPECT().OpenGrid(&entity.Cell{X: 60, Y: 15, Width: 50, Height: 10})
+	provider.EXPECT().OpenGrid(&entity.Cell{X: 60, Y: 25, Width: 50, Height: 10})
+	provider.EXPECT().CreateCol(50.0, 10.0, cfg, (*props.Cell)(nil))
+	provider.EXPECT().CloseGrid()
+	provider.EXPECT().CreateRow(20.0)
+
+	// Act
+	sut.Render(provider, cell)
+
+	// Assert
+	provider.AssertNumberOfCalls(t, "OpenGrid", 3)
+	provider.AssertNumberOfCalls(t, "CreateCol", 4)
+	provider.AssertNumberOfCalls(t, "CloseGrid", 3)
+	provider.AssertNumberOfCalls(t, "CreateRow", 1)
+}
+
+func newComponent(t *testing.T, height float64) core.Component {
+	component := mocks.NewComponent(t)
+	component.EXPECT().GetHeight(nil, &entity.Cell{X: 10, Y: 15, Width: 50, Height: 150}).Return(height)
+	component.EXPECT().SetConfig(&entity.Config{MaxGridSize: 12})
+	return component
+}
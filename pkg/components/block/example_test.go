@@ -0,0 +1,32 @@
+package block_test
+
+import (
+	"github.com/johnfercher/maroto/v2"
+	"github.com/johnfercher/maroto/v2/pkg/components/block"
+	"github.com/johnfercher/maroto/v2/pkg/components/text"
+	"github.com/johnfercher/maroto/v2/pkg/consts/border"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+// ExampleBlock_Build demonstrates how to create a financial statement, with each quarter
+// next to its months and a total spanning two columns.
+func ExampleBlock_Build() {
+	m := maroto.New()
+
+	style := &props.Cell{BorderType: border.Full}
+	cell := func(value string) block.Cell {
+		return block.NewCell(text.New(value, props.Text{Top: 1, Left: 1})).WithStyle(style)
+	}
+
+	statement := block.New([]int{4, 4, 4}, props.Block{RowHeight: 6}).
+		AddHeader(cell("Quarter"), cell("Month"), cell("Revenue")).
+		Add(cell("Q1").WithSpan(3, 1), cell("January"), cell("10")).
+		Add(cell("February"), cell("20")).
+		Add(cell("March"), cell("30")).
+		Add(cell("Total").WithSpan(1, 2), cell("60"))
+
+	rows, _ := statement.Build()
+	m.AddRows(rows...)
+
+	// generate document
+}
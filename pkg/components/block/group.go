@@ -0,0 +1,214 @@
+package block
+
+import (
+	"github.com/johnfercher/go-tree/node"
+
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+// group is a Row with the block rows connected by spanning cells.
+type group struct {
+	sizes  []int
+	rows   int
+	cells  []placedCell
+	prop   props.Block
+	style  *props.Cell
+	config *entity.Config
+}
+
+// headeredGroup is a group of a Block with header, it keeps a reference to the header
+// so it can be repeated when the group is moved to a new page.
+type headeredGroup struct {
+	*group
+	header []core.Row
+}
+
+func (b *Block) newGroup(rows [][]Cell, placed []placedCell, start int, header []core.Row) core.Row {
+	g := &group{
+		sizes: b.sizes,
+		rows:  len(rows),
+		prop:  b.prop,
+	}
+
+	for _, cell := range placed {
+		if cell.row >= start && cell.row < start+len(rows) {
+			cell.row -= start
+			g.cells = append(g.cells, cell)
+		}
+	}
+
+	if len(header) == 0 {
+		return g
+	}
+
+	return &headeredGroup{group: g, header: header}
+}
+
+// GetHeader returns the header rows of the Block that the group belongs to.
+func (h *headeredGroup) GetHeader() []core.Row {
+	return h.header
+}
+
+// Add appends each col below the cells of the group, in a new row spanning all the columns,
+// a group without cells is filled by the col, so the group always has the cols added to it.
+func (g *group) Add(cols ...core.Col) core.Row {
+	for _, c := range cols {
+		cell := placedCell{row: g.rows, rowSpan: 1, colSpan: len(g.sizes), content: c}
+		if len(g.cells) == 0 {
+			cell.row, cell.rowSpan = 0, g.rows
+		} else {
+			g.rows++
+		}
+
+		g.cells = append(g.cells, cell)
+	}
+
+	return g
+}
+
+// GetColumns returns the cols of the cells of the group.
+func (g *group) GetColumns() []core.Col {
+	cols := make([]core.Col, len(g.cells))
+	for i, cell := range g.cells {
+		cols[i] = cell.content
+	}
+	return cols
+}
+
+// GetHeight returns the sum of the heights of the rows of the group.
+func (g *group) GetHeight(provider core.Provider, cell *entity.Cell) float64 {
+	height := 0.0
+	for _, rowHeight := range g.getHeights(provider, cell) {
+		height += rowHeight
+	}
+	return height
+}
+
+// getHeights returns the height of each row, a row is as tall as its tallest cell, and the rows
+// spanned by a cell grow evenly when the cell is taller than them. The heights depend on the cell,
+// so they are measured again on each call.
+func (g *group) getHeights(provider core.Provider, cell *entity.Cell) []float64 {
+	heights := make([]float64, g.rows)
+	for i := range heights {
+		heights[i] = g.prop.RowHeight
+	}
+
+	for _, c := range g.cells {
+		if c.rowSpan == 1 {
+			heights[c.row] = max(heights[c.row], c.content.GetHeight(provider, cell))
+		}
+	}
+
+	for _, c := range g.cells {
+		if c.rowSpan == 1 {
+			continue
+		}
+
+		spanned := 0.0
+		for i := c.row; i < c.row+c.rowSpan; i++ {
+			spanned += heights[i]
+		}
+
+		if extra := c.content.GetHeight(provider, cell) - spanned; extra > 0 {
+			for i := c.row; i < c.row+c.rowSpan; i++ {
+				heights[i] += extra / float64(c.rowSpan)
+			}
+		}
+	}
+
+	return heights
+}
+
+// GetStructure returns the Structure of a group.
+func (g *group) GetStructure() *node.Node[core.Structure] {
+	details := g.prop.ToMap()
+	for key, value := range g.style.ToMap() {
+		details[key] = value
+	}
+
+	str := core.Structure{
+		Type:    "block",
+		Value:   g.rows,
+		Details: details,
+	}
+
+	n := node.New(str)
+
+	for _, c := range g.cells {
+		cellNode := node.New(core.Structure{
+			Type: "cell",
+			Details: map[string]interface{}{
+				"row":      c.row,
+				"col":      c.column,
+				"row_span": c.rowSpan,
+				"col_span": c.colSpan,
+			},
+		})
+		cellNode.AddNext(c.content.GetStructure())
+		n.AddNext(cellNode)
+	}
+
+	return n
+}
+
+// SetConfig sets the config of the cells.
+func (g *group) SetConfig(config *entity.Config) {
+	g.config = config
+	for _, c := range g.cells {
+		c.content.SetConfig(config)
+	}
+}
+
+// WithStyle sets the style of the whole group, which is drawn behind the cells.
+func (g *group) WithStyle(style *props.Cell) core.Row {
+	g.style = style
+	return g
+}
+
+// Render renders a group into a PDF context, each cell is created at its own position
+// with the size of the merged area. The whole group is created first at the cursor, like
+// the cols of a row, so the provider starts a new page when the group is the first of a page.
+func (g *group) Render(provider core.Provider, cell entity.Cell) {
+	heights := g.getHeights(provider, &cell)
+	cell.Height = 0
+	for _, rowHeight := range heights {
+		cell.Height += rowHeight
+	}
+
+	provider.CreateCol(cell.Width, cell.Height, g.config, g.style)
+
+	for _, c := range g.cells {
+		area := g.getArea(c, heights, cell)
+
+		provider.OpenGrid(&area)
+		c.content.Render(provider, area, true)
+		provider.CloseGrid()
+	}
+
+	provider.CreateRow(cell.Height)
+}
+
+func (g *group) getArea(c placedCell, heights []float64, cell entity.Cell) entity.Cell {
+	area := entity.Cell{X: cell.X, Y: cell.Y}
+
+	for i := 0; i < c.column+c.colSpan; i++ {
+		width := cell.Width * float64(g.sizes[i]) / float64(g.config.MaxGridSize)
+		if i < c.column {
+			area.X += width
+		} else {
+			area.Width += width
+		}
+	}
+
+	for i := 0; i < c.row+c.rowSpan; i++ {
+		if i < c.row {
+			area.Y += heights[i]
+		} else {
+			area.Height += heights[i]
+		}
+	}
+
+	return area
+}
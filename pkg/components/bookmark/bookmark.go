@@ -0,0 +1,58 @@
+// Package bookmark implements creation of bookmarks, the entries of the document outline.
+package bookmark
+
+import (
+	"github.com/johnfercher/go-tree/node"
+
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+type Bookmark struct {
+	title  string
+	prop   props.Bookmark
+	config *entity.Config
+}
+
+// New is responsible to create an instance of a Bookmark. A bookmark has no height, it should be
+// added to the col of the row it marks, so it points to the page and position where the row
+// lands after the page breaks.
+func New(title string, ps ...props.Bookmark) core.Component {
+	prop := props.Bookmark{}
+	if len(ps) > 0 {
+		prop = ps[0]
+	}
+	prop.MakeValid()
+
+	return &Bookmark{
+		title: title,
+		prop:  prop,
+	}
+}
+
+// GetStructure returns the Structure of a Bookmark.
+func (b *Bookmark) GetStructure() *node.Node[core.Structure] {
+	str := core.Structure{
+		Type:    "bookmark",
+		Value:   b.title,
+		Details: b.prop.ToMap(),
+	}
+
+	return node.New(str)
+}
+
+// GetHeight returns the height of a Bookmark, which does not take space in the PDF.
+func (b *Bookmark) GetHeight(provider core.Provider, cell *entity.Cell) float64 {
+	return 0
+}
+
+// SetConfig sets the config.
+func (b *Bookmark) SetConfig(config *entity.Config) {
+	b.config = config
+}
+
+// Render adds the Bookmark to the outline, pointing to the top of the cell.
+func (b *Bookmark) Render(provider core.Provider, cell *entity.Cell) {
+	provider.AddBookmark(b.title, b.prop.Level, cell)
+}
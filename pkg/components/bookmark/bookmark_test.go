@@ -0,0 +1,70 @@
+package bookmark_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/mocks"
+	"github.com/johnfercher/maroto/v2/pkg/components/bookmark"
+	"github.com/johnfercher/maroto/v2/pkg/test"
+)
+
+func TestNew(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := bookmark.New("Chapter")
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/bookmarks/new_bookmark_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := bookmark.New("Section", fixture.BookmarkProp())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/bookmarks/new_bookmark_custom_prop.json")
+	})
+}
+
+func TestBookmark_GetHeight(t *testing.T) {
+	t.Run("should not take space", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		sut := bookmark.New("Chapter")
+
+		// Act
+		height := sut.GetHeight(nil, &cell)
+
+		// Assert
+		assert.Equal(t, 0.0, height)
+	})
+}
+
+func TestBookmark_Render(t *testing.T) {
+	t.Run("should call provider correctly", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		sut := bookmark.New("Section", fixture.BookmarkProp())
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().AddBookmark("Section", 1, &cell)
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		provider.AssertNumberOfCalls(t, "AddBookmark", 1)
+	})
+}
+
+func TestBookmark_SetConfig(t *testing.T) {
+	t.Run("should call correctly", func(t *testing.T) {
+		// Arrange
+		sut := bookmark.New("Chapter")
+
+		// Act
+		sut.SetConfig(nil)
+	})
+}
@@ -0,0 +1,22 @@
+package bookmark_test
+
+import (
+	"github.com/johnfercher/maroto/v2"
+	"github.com/johnfercher/maroto/v2/pkg/components/bookmark"
+	"github.com/johnfercher/maroto/v2/pkg/components/col"
+	"github.com/johnfercher/maroto/v2/pkg/components/text"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+// ExampleNew demonstrates how to add bookmarks to the outline of the document.
+func ExampleNew() {
+	m := maroto.New()
+
+	chapter := col.New(12).Add(bookmark.New("Chapter 1"), text.New("Chapter 1"))
+	m.AddRow(10, chapter)
+
+	section := col.New(12).Add(bookmark.New("Seção 1.1", props.Bookmark{Level: 1}), text.New("Section 1.1"))
+	m.AddRow(10, section)
+
+	// generate document
+}
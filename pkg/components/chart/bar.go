@@ -0,0 +1,332 @@
+package chart
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/johnfercher/go-tree/node"
+
+	"github.com/johnfercher/maroto/v2/pkg/components/col"
+	"github.com/johnfercher/maroto/v2/pkg/components/row"
+	"github.com/johnfercher/maroto/v2/pkg/consts/align"
+	"github.com/johnfercher/maroto/v2/pkg/consts/orientation"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+type Bar struct {
+	labels []string
+	series []Series
+	prop   props.BarChart
+	config *entity.Config
+}
+
+// bar is a bar in the value scale, slot is its position inside the group of a label.
+type bar struct {
+	series int
+	slot   int
+	from   float64
+	to     float64
+}
+
+// NewBar is responsible to create an instance of a bar chart, each series has a value
+// for each label.
+func NewBar(labels []string, series []Series, ps ...props.BarChart) core.Component {
+	barProp := props.BarChart{}
+	if len(ps) > 0 {
+		barProp = ps[0]
+	}
+
+	return &Bar{
+		labels: labels,
+		series: series,
+		prop:   barProp,
+	}
+}
+
+// NewBarCol is responsible to create an instance of a bar chart wrapped in a Col.
+func NewBarCol(size int, labels []string, series []Series, ps ...props.BarChart) core.Col {
+	barChart := NewBar(labels, series, ps...)
+	return col.New(size).Add(barChart)
+}
+
+// NewBarRow is responsible to create an instance of a bar chart wrapped in a Row.
+func NewBarRow(height float64, labels []string, series []Series, ps ...props.BarChart) core.Row {
+	barChart := NewBar(labels, series, ps...)
+	c := col.New().Add(barChart)
+	return row.New(height).Add(c)
+}
+
+// NewAutoBarRow is responsible to create an instance of a bar chart wrapped in a Row with automatic height.
+func NewAutoBarRow(labels []string, series []Series, ps ...props.BarChart) core.Row {
+	barChart := NewBar(labels, series, ps...)
+	c := col.New().Add(barChart)
+	return row.New().Add(c)
+}
+
+// GetStructure returns the Structure of a bar chart.
+func (b *Bar) GetStructure() *node.Node[core.Structure] {
+	details := b.prop.ToMap()
+	details["labels"] = b.labels
+
+	str := core.Structure{
+		Type:    "barchart",
+		Details: details,
+	}
+
+	n := node.New(str)
+	for _, series := range b.series {
+		n.AddNext(node.New(core.Structure{
+			Type:    "series",
+			Value:   series.Name,
+			Details: map[string]interface{}{"values": series.Values},
+		}))
+	}
+
+	return n
+}
+
+// GetHeight returns the height that the chart will have in the PDF.
+func (b *Bar) GetHeight(_ core.Provider, cell *entity.Cell) float64 {
+	return cell.Width * b.prop.Proportion.Height / b.prop.Proportion.Width
+}
+
+// SetConfig sets the config.
+func (b *Bar) SetConfig(config *entity.Config) {
+	b.config = config
+	b.prop.MakeValid()
+}
+
+// Render renders a bar chart into a PDF context.
+func (b *Bar) Render(provider core.Provider, cell *entity.Cell) {
+	if len(b.labels) == 0 || len(b.series) == 0 {
+		return
+	}
+
+	font := getFont(b.config, b.prop.FontSize)
+	fontHeight := provider.GetFontHeight(&font)
+
+	area := *cell
+	if b.prop.ShowLegend {
+		area.Height -= fontHeight * 2
+		names := make([]string, len(b.series))
+		for i, series := range b.series {
+			names[i] = series.Name
+		}
+		addBottomLegend(provider, cell, names, b.prop.Palette, &font, fontHeight)
+	}
+
+	if b.prop.Horizontal {
+		b.renderHorizontal(provider, &area, &font, fontHeight)
+	} else {
+		b.renderVertical(provider, &area, &font, fontHeight)
+	}
+}
+
+func (b *Bar) renderVertical(provider core.Provider, cell *entity.Cell, font *props.Font, fontHeight float64) {
+	lowest, highest := b.getRange()
+
+	valueSpace := 0.0
+	if b.prop.ShowValues {
+		valueSpace = fontHeight * 1.5
+	}
+
+	labelY := cell.Y + cell.Height - fontHeight*1.25
+	top := cell.Y + valueSpace
+	bottom := cell.Y + cell.Height - fontHeight*1.5
+	if lowest < 0 {
+		bottom -= valueSpace
+	}
+
+	scale := (bottom - top) / (highest - lowest)
+	getY := func(value float64) float64 {
+		return top + (highest-value)*scale
+	}
+
+	groupWidth := cell.Width / float64(len(b.labels))
+	innerWidth := groupWidth * (1 - b.prop.GapPercent/100)
+	barWidth := innerWidth / float64(b.getSlots())
+
+	labelProp := getTextProp(*font, align.Center)
+	for i, label := range b.labels {
+		groupX := cell.X + float64(i)*groupWidth
+		x := groupX + (groupWidth-innerWidth)/2
+
+		for _, bar := range b.getBars(i) {
+			barX := x + float64(bar.slot)*barWidth
+			from, to := getY(bar.from), getY(bar.to)
+			provider.AddPolygon(rectangle(barX, min(from, to), barWidth, math.Abs(to-from)), &props.Shape{
+				FillColor: getColor(b.prop.Palette, bar.series),
+			})
+
+			if b.prop.ShowValues && !b.prop.Stacked {
+				b.addVerticalValue(provider, bar.to, barX, getY(bar.to), barWidth, fontHeight, &labelProp)
+			}
+		}
+
+		if b.prop.ShowValues && b.prop.Stacked {
+			positive, negative := b.getStackLimits(i)
+			total := b.getTotal(i)
+			y := getY(positive)
+			if total < 0 {
+				y = getY(negative)
+			}
+			b.addVerticalValue(provider, total, x, y, innerWidth, fontHeight, &labelProp)
+		}
+
+		addText(provider, label, groupX, labelY, groupWidth, fontHeight, &labelProp)
+	}
+
+	addAxis(provider, &entity.Cell{X: cell.X, Y: getY(0), Width: cell.Width}, b.prop.AxisColor, orientation.Horizontal)
+}
+
+func (b *Bar) addVerticalValue(provider core.Provider, value, x, y, width, fontHeight float64, prop *props.Text) {
+	if value >= 0 {
+		y -= fontHeight * 1.25
+	} else {
+		y += fontHeight * 0.25
+	}
+
+	addText(provider, fmt.Sprintf(b.prop.ValueFormat, value), x, y, width, fontHeight, prop)
+}
+
+func (b *Bar) renderHorizontal(provider core.Provider, cell *entity.Cell, font *props.Font, fontHeight float64) {
+	lowest, highest := b.getRange()
+
+	labelWidth := cell.Width * b.prop.LabelPercent / 100
+
+	valueSpace := 0.0
+	if b.prop.ShowValues {
+		valueSpace = cell.Width * 0.12
+	}
+
+	left := cell.X + labelWidth
+	if lowest < 0 {
+		left += valueSpace
+	}
+	right := cell.X + cell.Width - valueSpace
+
+	scale := (right - left) / (highest - lowest)
+	getX := func(value float64) float64 {
+		return left + (value-lowest)*scale
+	}
+
+	groupHeight := cell.Height / float64(len(b.labels))
+	innerHeight := groupHeight * (1 - b.prop.GapPercent/100)
+	barHeight := innerHeight / float64(b.getSlots())
+
+	labelProp := getTextProp(*font, align.Right)
+	valueProp := getTextProp(*font, align.Left)
+	negativeValueProp := getTextProp(*font, align.Right)
+
+	addValue := func(value, x, y float64) {
+		text := fmt.Sprintf(b.prop.ValueFormat, value)
+		if value >= 0 {
+			addText(provider, text, x+fontHeight*0.25, y-fontHeight/2, valueSpace, fontHeight, &valueProp)
+		} else {
+			addText(provider, text, x-valueSpace-fontHeight*0.25, y-fontHeight/2, valueSpace, fontHeight, &negativeValueProp)
+		}
+	}
+
+	for i, label := range b.labels {
+		groupY := cell.Y + float64(i)*groupHeight
+		y := groupY + (groupHeight-innerHeight)/2
+
+		for _, bar := range b.getBars(i) {
+			barY := y + float64(bar.slot)*barHeight
+			from, to := getX(bar.from), getX(bar.to)
+			provider.AddPolygon(rectangle(min(from, to), barY, math.Abs(to-from), barHeight), &props.Shape{
+				FillColor: getColor(b.prop.Palette, bar.series),
+			})
+
+			if b.prop.ShowValues && !b.prop.Stacked {
+				addValue(bar.to, to, barY+barHeight/2)
+			}
+		}
+
+		if b.prop.ShowValues && b.prop.Stacked {
+			positive, negative := b.getStackLimits(i)
+			total := b.getTotal(i)
+			x := getX(positive)
+			if total < 0 {
+				x = getX(negative)
+			}
+			addValue(total, x, groupY+groupHeight/2)
+		}
+
+		addText(provider, label, cell.X, groupY+groupHeight/2-fontHeight/2, labelWidth-fontHeight*0.5, fontHeight, &labelProp)
+	}
+
+	addAxis(provider, &entity.Cell{X: getX(0), Y: cell.Y, Height: cell.Height}, b.prop.AxisColor, orientation.Vertical)
+}
+
+// getBars returns the bars of a label, side by side or stacked.
+func (b *Bar) getBars(index int) []bar {
+	var bars []bar
+	positive, negative := 0.0, 0.0
+
+	for i, series := range b.series {
+		value := series.getValue(index)
+		if !b.prop.Stacked {
+			bars = append(bars, bar{series: i, slot: i, from: 0, to: value})
+			continue
+		}
+
+		if value >= 0 {
+			bars = append(bars, bar{series: i, from: positive, to: positive + value})
+			positive += value
+		} else {
+			bars = append(bars, bar{series: i, from: negative, to: negative + value})
+			negative += value
+		}
+	}
+
+	return bars
+}
+
+func (b *Bar) getSlots() int {
+	if b.prop.Stacked {
+		return 1
+	}
+
+	return len(b.series)
+}
+
+// getStackLimits returns the sum of the positive and of the negative values of a label.
+func (b *Bar) getStackLimits(index int) (float64, float64) {
+	positive, negative := 0.0, 0.0
+	for _, series := range b.series {
+		value := series.getValue(index)
+		if value >= 0 {
+			positive += value
+		} else {
+			negative += value
+		}
+	}
+
+	return positive, negative
+}
+
+func (b *Bar) getTotal(index int) float64 {
+	positive, negative := b.getStackLimits(index)
+	return positive + negative
+}
+
+// getRange returns the lowest and highest values of the chart, zero is always in the range.
+func (b *Bar) getRange() (float64, float64) {
+	lowest, highest := 0.0, 0.0
+
+	for i := range b.labels {
+		for _, bar := range b.getBars(i) {
+			lowest = min(lowest, bar.to)
+			highest = max(highest, bar.to)
+		}
+	}
+
+	if lowest == highest {
+		highest = 1
+	}
+
+	return lowest, highest
+}
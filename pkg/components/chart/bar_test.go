@@ -0,0 +1,178 @@
+package chart_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/mocks"
+	"github.com/johnfercher/maroto/v2/pkg/components/chart"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+	"github.com/johnfercher/maroto/v2/pkg/test"
+)
+
+var (
+	labels = []string{"Q1", "Q2"}
+	series = []chart.Series{
+		{Name: "North", Values: []float64{10, 20}},
+		{Name: "South", Values: []float64{5, -5}},
+	}
+)
+
+func TestNewBar(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := chart.NewBar(labels, series)
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/charts/new_bar_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := chart.NewBar(labels, series, fixture.BarChartProp())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/charts/new_bar_custom_prop.json")
+	})
+}
+
+func TestNewBarCol(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := chart.NewBarCol(12, labels, series)
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/charts/new_bar_col_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := chart.NewBarCol(12, labels, series, fixture.BarChartProp())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/charts/new_bar_col_custom_prop.json")
+	})
+}
+
+func TestNewBarRow(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := chart.NewBarRow(10, labels, series)
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/charts/new_bar_row_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := chart.NewBarRow(10, labels, series, fixture.BarChartProp())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/charts/new_bar_row_custom_prop.json")
+	})
+}
+
+func TestNewAutoBarRow(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := chart.NewAutoBarRow(labels, series)
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/charts/new_bar_auto_row_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := chart.NewAutoBarRow(labels, series, fixture.BarChartProp())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/charts/new_bar_auto_row_custom_prop.json")
+	})
+}
+
+func TestBar_GetHeight(t *testing.T) {
+	t.Run("should return height from proportion", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		sut := chart.NewBar(labels, series, props.BarChart{Proportion: props.Proportion{Width: 4, Height: 3}})
+		sut.SetConfig(&entity.Config{})
+
+		// Act
+		height := sut.GetHeight(nil, &cell)
+
+		// Assert
+		assert.Equal(t, 75.0, height)
+	})
+}
+
+func TestBar_Render(t *testing.T) {
+	t.Run("when there is no label, should not call provider", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		sut := chart.NewBar(nil, series)
+		sut.SetConfig(&entity.Config{})
+
+		provider := mocks.NewProvider(t)
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		provider.AssertNotCalled(t, "AddPolygon")
+	})
+	t.Run("when chart is vertical, should draw bars side by side from the axis", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		sut := chart.NewBar(labels, series)
+		sut.SetConfig(&entity.Config{})
+
+		var bars [][]entity.Point
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().GetFontHeight(mock.Anything).Return(4.0)
+		provider.EXPECT().AddPolygon(mock.Anything, mock.Anything).Run(func(points []entity.Point, _ *props.Shape) {
+			bars = append(bars, points)
+		})
+		provider.EXPECT().AddText(mock.Anything, mock.Anything, mock.Anything)
+		provider.EXPECT().AddLine(mock.Anything, mock.Anything)
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		provider.AssertNumberOfCalls(t, "AddPolygon", 4)
+		provider.AssertNumberOfCalls(t, "AddText", 2)
+		provider.AssertNumberOfCalls(t, "AddLine", 1)
+		assertRectangle(t, bars[0], 15, 72.6, 20, 57.6)
+		assertRectangle(t, bars[3], 85, 130.2, 20, 28.8)
+	})
+	t.Run("when chart is horizontal and stacked, should draw values and legend", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		sut := chart.NewBar(labels, series, fixture.BarChartProp())
+		sut.SetConfig(&entity.Config{})
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().GetFontHeight(mock.Anything).Return(4.0)
+		provider.EXPECT().AddPolygon(mock.Anything, mock.Anything)
+		provider.EXPECT().AddText(mock.Anything, mock.Anything, mock.Anything)
+		provider.EXPECT().AddLine(mock.Anything, mock.Anything)
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		provider.AssertNumberOfCalls(t, "AddPolygon", 6)
+		provider.AssertNumberOfCalls(t, "AddText", 6)
+		provider.AssertNumberOfCalls(t, "AddLine", 1)
+		provider.AssertCalled(t, "AddText", "15.00", mock.Anything, mock.Anything)
+		provider.AssertCalled(t, "AddText", "South", mock.Anything, mock.Anything)
+	})
+}
+
+func assertRectangle(t *testing.T, points []entity.Point, x, y, width, height float64) {
+	assert.Len(t, points, 4)
+	assert.InDelta(t, x, points[0].X, 0.001)
+	assert.InDelta(t, y, points[0].Y, 0.001)
+	assert.InDelta(t, x+width, points[2].X, 0.001)
+	assert.InDelta(t, y+height, points[2].Y, 0.001)
+}
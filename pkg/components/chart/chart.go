@@ -0,0 +1,115 @@
+// Package chart implements creation of charts drawn as vectors.
+package chart
+
+import (
+	"math"
+
+	"github.com/johnfercher/maroto/v2/pkg/consts/align"
+	"github.com/johnfercher/maroto/v2/pkg/consts/linestyle"
+	"github.com/johnfercher/maroto/v2/pkg/consts/orientation"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+// Series is a named sequence of values, one for each label of a chart.
+type Series struct {
+	Name   string
+	Values []float64
+}
+
+// getValue returns the value of a label, a label without value is zero.
+func (s Series) getValue(index int) float64 {
+	if index >= len(s.Values) {
+		return 0
+	}
+
+	return s.Values[index]
+}
+
+// getFont returns the font of labels, values and legend, which is the document default font.
+func getFont(config *entity.Config, size float64) props.Font {
+	font := props.Font{}
+	if config != nil && config.DefaultFont != nil {
+		font = *config.DefaultFont
+	}
+
+	if size > 0 {
+		font.Size = size
+	}
+
+	return font
+}
+
+func getTextProp(font props.Font, alignType align.Type) props.Text {
+	prop := props.Text{Align: alignType}
+	prop.MakeValid(&font)
+	return prop
+}
+
+func getColor(palette []props.Color, index int) *props.Color {
+	color := palette[index%len(palette)]
+	return &color
+}
+
+// addText writes a text in a single line starting at y.
+func addText(provider core.Provider, value string, x, y, width, fontHeight float64, prop *props.Text) {
+	provider.AddText(value, &entity.Cell{X: x, Y: y, Width: width, Height: fontHeight}, prop)
+}
+
+// addLegendItem writes a square with the color of an item followed by its name.
+func addLegendItem(provider core.Provider, name string, color *props.Color, x, y, width, fontHeight float64, prop *props.Text) {
+	square := fontHeight * 0.8
+	provider.AddPolygon(rectangle(x, y+(fontHeight-square)/2, square, square), &props.Shape{FillColor: color})
+	addText(provider, name, x+fontHeight*1.2, y, max(width-fontHeight*1.2, 0), fontHeight, prop)
+}
+
+// addBottomLegend writes the legend items side by side at the bottom of the cell.
+func addBottomLegend(provider core.Provider, cell *entity.Cell, names []string, palette []props.Color,
+	font *props.Font, fontHeight float64,
+) {
+	prop := getTextProp(*font, align.Left)
+	itemWidth := cell.Width / float64(len(names))
+	y := cell.Y + cell.Height - fontHeight*1.5
+
+	for i, name := range names {
+		x := cell.X + float64(i)*itemWidth
+		addLegendItem(provider, name, getColor(palette, i), x, y, itemWidth, fontHeight, &prop)
+	}
+}
+
+func addAxis(provider core.Provider, cell *entity.Cell, color *props.Color, lineOrientation orientation.Type) {
+	provider.AddLine(cell, &props.Line{
+		Color:         color,
+		Style:         linestyle.Solid,
+		Thickness:     linestyle.DefaultLineThickness,
+		Orientation:   lineOrientation,
+		OffsetPercent: 0,
+		SizePercent:   100,
+	})
+}
+
+func rectangle(x, y, width, height float64) []entity.Point {
+	return []entity.Point{
+		{X: x, Y: y},
+		{X: x + width, Y: y},
+		{X: x + width, Y: y + height},
+		{X: x, Y: y + height},
+	}
+}
+
+// arc returns the points of an arc, the angles are in degrees and grow clockwise from the right.
+func arc(centerX, centerY, radius, start, end float64) []entity.Point {
+	steps := max(int(math.Ceil(math.Abs(end-start)/2)), 2)
+
+	points := make([]entity.Point, 0, steps+1)
+	for step := 0; step <= steps; step++ {
+		angle := (start + (end-start)*float64(step)/float64(steps)) * math.Pi / 180
+		points = append(points, entity.Point{
+			X: centerX + radius*math.Cos(angle),
+			Y: centerY + radius*math.Sin(angle),
+		})
+	}
+
+	return points
+}
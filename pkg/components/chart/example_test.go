@@ -0,0 +1,91 @@
+package chart_test
+
+import (
+	"time"
+
+	"github.com/johnfercher/maroto/v2"
+	"github.com/johnfercher/maroto/v2/pkg/components/chart"
+	"github.com/johnfercher/maroto/v2/pkg/components/col"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+// ExampleNewBar demonstrates how to create a bar chart component.
+func ExampleNewBar() {
+	m := maroto.New()
+
+	bar := chart.NewBar([]string{"Q1", "Q2", "Q3"}, []chart.Series{
+		{Name: "North", Values: []float64{10, 12, 8}},
+		{Name: "South", Values: []float64{7, 9, 11}},
+	}, props.BarChart{ShowValues: true, ShowLegend: true})
+	col := col.New(12).Add(bar)
+	m.AddAutoRow(col)
+
+	// generate document
+}
+
+// ExampleNewAutoBarRow demonstrates how to create a stacked horizontal bar chart wrapped into a row.
+func ExampleNewAutoBarRow() {
+	m := maroto.New()
+
+	barRow := chart.NewAutoBarRow([]string{"2023", "2024"}, []chart.Series{
+		{Name: "Income", Values: []float64{100, 140}},
+		{Name: "Expenses", Values: []float64{-60, -80}},
+	}, props.BarChart{Horizontal: true, Stacked: true})
+	m.AddRows(barRow)
+
+	// generate document
+}
+
+// ExampleNewPie demonstrates how to create a pie chart component.
+func ExampleNewPie() {
+	m := maroto.New()
+
+	pie := chart.NewPie([]string{"Food", "Rent", "Travel"}, []float64{300, 900, 450}, props.PieChart{ShowValues: true, ShowLegend: true})
+	col := col.New(6).Add(pie)
+	m.AddAutoRow(col)
+
+	// generate document
+}
+
+// ExampleNewPieRow demonstrates how to create a donut chart wrapped into a row.
+func ExampleNewPieRow() {
+	m := maroto.New()
+
+	pieRow := chart.NewPieRow(60, []string{"Done", "Pending"}, []float64{7, 3}, props.PieChart{HolePercent: 50})
+	m.AddRows(pieRow)
+
+	// generate document
+}
+
+// ExampleNewLine demonstrates how to create a time-series line chart component.
+func ExampleNewLine() {
+	m := maroto.New()
+
+	start := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	var points []chart.Point
+	for day := 0; day < 90; day++ {
+		points = append(points, chart.NewTimePoint(start.AddDate(0, 0, day), 1000+float64(day*day%37)))
+	}
+
+	line := chart.NewLine([]chart.LineSeries{{Name: "Portfolio", Points: points}}, props.LineChart{
+		TimeAxis:   true,
+		ShowGrid:   true,
+		ShowLegend: true,
+	})
+	col := col.New(12).Add(line)
+	m.AddAutoRow(col)
+
+	// generate document
+}
+
+// ExampleNewAutoLineRow demonstrates how to create an area chart with markers wrapped into a row.
+func ExampleNewAutoLineRow() {
+	m := maroto.New()
+
+	lineRow := chart.NewAutoLineRow([]chart.LineSeries{
+		{Name: "CPU", Points: []chart.Point{{X: 0, Y: 20}, {X: 1, Y: 45}, {X: 2, Y: 30}}},
+	}, props.LineChart{Area: true, MarkerSize: 1.5, ValueFormat: "%g%%"})
+	m.AddRows(lineRow)
+
+	// generate document
+}
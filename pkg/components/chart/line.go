@@ -0,0 +1,333 @@
+package chart
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/johnfercher/go-tree/node"
+
+	"github.com/johnfercher/maroto/v2/pkg/components/col"
+	"github.com/johnfercher/maroto/v2/pkg/components/row"
+	"github.com/johnfercher/maroto/v2/pkg/consts/align"
+	"github.com/johnfercher/maroto/v2/pkg/consts/linestyle"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+// Point is a value of a line series at a position of the x axis.
+type Point struct {
+	X float64
+	Y float64
+}
+
+// NewTimePoint is responsible to create a Point of a chart with time axis.
+func NewTimePoint(t time.Time, y float64) Point {
+	return Point{X: float64(t.Unix()), Y: y}
+}
+
+// LineSeries is a named sequence of points, which are drawn sorted by x.
+type LineSeries struct {
+	Name   string
+	Points []Point
+}
+
+type Line struct {
+	series []LineSeries
+	prop   props.LineChart
+	config *entity.Config
+}
+
+// plot is the area of the chart inside the axes, with the ranges of the values.
+type plot struct {
+	left, top, right, bottom float64
+	lowestX, highestX        float64
+	lowestY, highestY        float64
+}
+
+func (p *plot) getX(value float64) float64 {
+	return p.left + (value-p.lowestX)*(p.right-p.left)/(p.highestX-p.lowestX)
+}
+
+func (p *plot) getY(value float64) float64 {
+	return p.bottom - (value-p.lowestY)*(p.bottom-p.top)/(p.highestY-p.lowestY)
+}
+
+// NewLine is responsible to create an instance of a line chart, with a numeric or time x axis.
+func NewLine(series []LineSeries, ps ...props.LineChart) core.Component {
+	lineProp := props.LineChart{}
+	if len(ps) > 0 {
+		lineProp = ps[0]
+	}
+
+	return &Line{
+		series: series,
+		prop:   lineProp,
+	}
+}
+
+// NewLineCol is responsible to create an instance of a line chart wrapped in a Col.
+func NewLineCol(size int, series []LineSeries, ps ...props.LineChart) core.Col {
+	lineChart := NewLine(series, ps...)
+	return col.New(size).Add(lineChart)
+}
+
+// NewLineRow is responsible to create an instance of a line chart wrapped in a Row.
+func NewLineRow(height float64, series []LineSeries, ps ...props.LineChart) core.Row {
+	lineChart := NewLine(series, ps...)
+	c := col.New().Add(lineChart)
+	return row.New(height).Add(c)
+}
+
+// NewAutoLineRow is responsible to create an instance of a line chart wrapped in a Row with automatic height.
+func NewAutoLineRow(series []LineSeries, ps ...props.LineChart) core.Row {
+	lineChart := NewLine(series, ps...)
+	c := col.New().Add(lineChart)
+	return row.New().Add(c)
+}
+
+// GetStructure returns the Structure of a line chart.
+func (l *Line) GetStructure() *node.Node[core.Structure] {
+	str := core.Structure{
+		Type:    "linechart",
+		Details: l.prop.ToMap(),
+	}
+
+	n := node.New(str)
+	for _, series := range l.series {
+		x := make([]float64, len(series.Points))
+		y := make([]float64, len(series.Points))
+		for i, point := range series.Points {
+			x[i], y[i] = point.X, point.Y
+		}
+
+		n.AddNext(node.New(core.Structure{
+			Type:    "series",
+			Value:   series.Name,
+			Details: map[string]interface{}{"x": x, "y": y},
+		}))
+	}
+
+	return n
+}
+
+// GetHeight returns the height that the chart will have in the PDF.
+func (l *Line) GetHeight(_ core.Provider, cell *entity.Cell) float64 {
+	return cell.Width * l.prop.Proportion.Height / l.prop.Proportion.Width
+}
+
+// SetConfig sets the config.
+func (l *Line) SetConfig(config *entity.Config) {
+	l.config = config
+	l.prop.MakeValid()
+}
+
+// Render renders a line chart into a PDF context.
+func (l *Line) Render(provider core.Provider, cell *entity.Cell) {
+	lowestX, highestX, lowestY, highestY, ok := l.getRange()
+	if !ok {
+		return
+	}
+
+	font := getFont(l.config, l.prop.FontSize)
+	fontHeight := provider.GetFontHeight(&font)
+
+	area := *cell
+	if l.prop.ShowLegend {
+		area.Height -= fontHeight * 2
+		names := make([]string, len(l.series))
+		for i, series := range l.series {
+			names[i] = series.Name
+		}
+		addBottomLegend(provider, cell, names, l.prop.Palette, &font, fontHeight)
+	}
+
+	yTicks := getValueTicks(lowestY, highestY, l.prop.Ticks)
+	xTicks, xLabels := l.getXTicks(lowestX, highestX)
+
+	p := &plot{
+		left:     area.X + area.Width*l.prop.LabelPercent/100,
+		top:      area.Y + fontHeight/2,
+		right:    area.X + area.Width - fontHeight,
+		bottom:   area.Y + area.Height - fontHeight*1.5,
+		lowestX:  lowestX,
+		highestX: highestX,
+		lowestY:  yTicks[0],
+		highestY: yTicks[len(yTicks)-1],
+	}
+
+	l.addYAxis(provider, p, yTicks, &area, &font, fontHeight)
+	l.addXAxis(provider, p, xTicks, xLabels, &font, fontHeight)
+
+	sorted := l.getSortedSeries()
+	if l.prop.Area {
+		for i, points := range sorted {
+			l.addArea(provider, p, points, getColor(l.prop.Palette, i))
+		}
+	}
+
+	for i, points := range sorted {
+		l.addSeries(provider, p, points, getColor(l.prop.Palette, i))
+	}
+
+	provider.AddPolyline([]entity.Point{
+		{X: p.left, Y: p.top},
+		{X: p.left, Y: p.bottom},
+		{X: p.right, Y: p.bottom},
+	}, &props.Shape{BorderColor: l.prop.AxisColor, BorderThickness: linestyle.DefaultLineThickness, LineStyle: linestyle.Solid})
+}
+
+func (l *Line) addYAxis(provider core.Provider, p *plot, ticks []float64, area *entity.Cell, font *props.Font, fontHeight float64) {
+	labelProp := getTextProp(*font, align.Right)
+	grid := &props.Shape{BorderColor: l.prop.GridColor, BorderThickness: linestyle.DefaultLineThickness / 2, LineStyle: linestyle.Solid}
+
+	for _, tick := range ticks {
+		y := p.getY(tick)
+		if l.prop.ShowGrid && tick != p.lowestY {
+			provider.AddPolyline([]entity.Point{{X: p.left, Y: y}, {X: p.right, Y: y}}, grid)
+		}
+
+		width := max(p.left-area.X-fontHeight*0.5, 0)
+		addText(provider, fmt.Sprintf(l.prop.ValueFormat, tick), area.X, y-fontHeight/2, width, fontHeight, &labelProp)
+	}
+}
+
+func (l *Line) addXAxis(provider core.Provider, p *plot, ticks []float64, labels []string, font *props.Font, fontHeight float64) {
+	if len(ticks) == 0 {
+		return
+	}
+
+	labelProp := getTextProp(*font, align.Center)
+	grid := &props.Shape{BorderColor: l.prop.GridColor, BorderThickness: linestyle.DefaultLineThickness / 2, LineStyle: linestyle.Solid}
+	width := (p.right - p.left) / float64(len(ticks))
+
+	for i, tick := range ticks {
+		x := p.getX(tick)
+		if l.prop.ShowGrid && tick != p.lowestX {
+			provider.AddPolyline([]entity.Point{{X: x, Y: p.top}, {X: x, Y: p.bottom}}, grid)
+		}
+
+		addText(provider, labels[i], x-width/2, p.bottom+fontHeight*0.25, width, fontHeight, &labelProp)
+	}
+}
+
+func (l *Line) addArea(provider core.Provider, p *plot, points []Point, color *props.Color) {
+	if len(points) < 2 {
+		return
+	}
+
+	base := p.getY(min(max(0, p.lowestY), p.highestY))
+	polygon := l.getPoints(p, points)
+	polygon = append(polygon,
+		entity.Point{X: p.getX(points[len(points)-1].X), Y: base},
+		entity.Point{X: p.getX(points[0].X), Y: base},
+	)
+
+	provider.AddPolygon(polygon, &props.Shape{FillColor: l.getAreaColor(color)})
+}
+
+func (l *Line) addSeries(provider core.Provider, p *plot, points []Point, color *props.Color) {
+	mapped := l.getPoints(p, points)
+	provider.AddPolyline(mapped, &props.Shape{BorderColor: color, BorderThickness: l.prop.LineThickness, LineStyle: linestyle.Solid})
+
+	if l.prop.MarkerSize == 0 {
+		return
+	}
+
+	marker := &props.Shape{FillColor: color}
+	for _, point := range mapped {
+		provider.AddPolygon(arc(point.X, point.Y, l.prop.MarkerSize/2, 0, 360), marker)
+	}
+}
+
+func (l *Line) getPoints(p *plot, points []Point) []entity.Point {
+	mapped := make([]entity.Point, len(points))
+	for i, point := range points {
+		mapped[i] = entity.Point{X: p.getX(point.X), Y: p.getY(point.Y)}
+	}
+
+	return mapped
+}
+
+// getAreaColor mixes the color of a series with white.
+func (l *Line) getAreaColor(color *props.Color) *props.Color {
+	intensity := l.prop.AreaIntensity / 100
+	mix := func(value int) int {
+		return int(math.Round(255 - float64(255-value)*intensity))
+	}
+
+	return &props.Color{Red: mix(color.Red), Green: mix(color.Green), Blue: mix(color.Blue)}
+}
+
+// getXTicks returns the ticks of the x axis and their labels.
+func (l *Line) getXTicks(lowest, highest float64) ([]float64, []string) {
+	var ticks []float64
+	layout := l.prop.TimeFormat
+
+	if l.prop.TimeAxis {
+		var defaultLayout string
+		ticks, defaultLayout = getTimeTicks(lowest, highest, l.prop.Ticks)
+		if layout == "" {
+			layout = defaultLayout
+		}
+	} else {
+		ticks = getNumberTicks(lowest, highest, l.prop.Ticks)
+	}
+
+	labels := make([]string, len(ticks))
+	for i, tick := range ticks {
+		if l.prop.TimeAxis {
+			labels[i] = time.Unix(int64(tick), 0).UTC().Format(layout)
+		} else {
+			labels[i] = fmt.Sprintf(l.prop.XFormat, tick)
+		}
+	}
+
+	return ticks, labels
+}
+
+// getRange returns the lowest and highest values of both axes, areas always include zero.
+func (l *Line) getRange() (float64, float64, float64, float64, bool) {
+	lowestX, highestX := math.Inf(1), math.Inf(-1)
+	lowestY, highestY := math.Inf(1), math.Inf(-1)
+
+	for _, series := range l.series {
+		for _, point := range series.Points {
+			lowestX, highestX = min(lowestX, point.X), max(highestX, point.X)
+			lowestY, highestY = min(lowestY, point.Y), max(highestY, point.Y)
+		}
+	}
+
+	if math.IsInf(lowestX, 1) {
+		return 0, 0, 0, 0, false
+	}
+
+	if lowestX == highestX {
+		margin := 1.0
+		if l.prop.TimeAxis {
+			margin = day
+		}
+		lowestX, highestX = lowestX-margin, highestX+margin
+	}
+
+	if l.prop.Area {
+		lowestY, highestY = min(lowestY, 0), max(highestY, 0)
+	}
+
+	return lowestX, highestX, lowestY, highestY, true
+}
+
+func (l *Line) getSortedSeries() [][]Point {
+	sorted := make([][]Point, len(l.series))
+	for i, series := range l.series {
+		points := append([]Point{}, series.Points...)
+		sort.SliceStable(points, func(a, b int) bool {
+			return points[a].X < points[b].X
+		})
+		sorted[i] = points
+	}
+
+	return sorted
+}
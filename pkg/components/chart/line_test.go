@@ -0,0 +1,181 @@
+package chart_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/mocks"
+	"github.com/johnfercher/maroto/v2/pkg/components/chart"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+	"github.com/johnfercher/maroto/v2/pkg/test"
+)
+
+var lineSeries = []chart.LineSeries{
+	{Name: "Portfolio", Points: []chart.Point{{X: 10, Y: 10}, {X: 0, Y: 0}, {X: 5, Y: 20}}},
+}
+
+func TestNewTimePoint(t *testing.T) {
+	// Act
+	point := chart.NewTimePoint(time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC), 5)
+
+	// Assert
+	assert.Equal(t, chart.Point{X: 1704067200, Y: 5}, point)
+}
+
+func TestNewLine(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := chart.NewLine(lineSeries)
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/charts/new_line_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := chart.NewLine(lineSeries, fixture.LineChartProp())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/charts/new_line_custom_prop.json")
+	})
+}
+
+func TestNewLineCol(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := chart.NewLineCol(12, lineSeries)
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/charts/new_line_col_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := chart.NewLineCol(12, lineSeries, fixture.LineChartProp())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/charts/new_line_col_custom_prop.json")
+	})
+}
+
+func TestNewLineRow(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := chart.NewLineRow(10, lineSeries)
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/charts/new_line_row_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := chart.NewLineRow(10, lineSeries, fixture.LineChartProp())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/charts/new_line_row_custom_prop.json")
+	})
+}
+
+func TestNewAutoLineRow(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := chart.NewAutoLineRow(lineSeries)
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/charts/new_line_auto_row_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := chart.NewAutoLineRow(lineSeries, fixture.LineChartProp())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/charts/new_line_auto_row_custom_prop.json")
+	})
+}
+
+func TestLine_GetHeight(t *testing.T) {
+	t.Run("should return height from proportion", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		sut := chart.NewLine(lineSeries, props.LineChart{Proportion: props.Proportion{Width: 2, Height: 1}})
+		sut.SetConfig(&entity.Config{})
+
+		// Act
+		height := sut.GetHeight(nil, &cell)
+
+		// Assert
+		assert.Equal(t, 50.0, height)
+	})
+}
+
+func TestLine_Render(t *testing.T) {
+	t.Run("when there is no point, should not call provider", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		sut := chart.NewLine([]chart.LineSeries{{Name: "empty"}})
+		sut.SetConfig(&entity.Config{})
+
+		provider := mocks.NewProvider(t)
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		provider.AssertNotCalled(t, "AddPolyline")
+	})
+	t.Run("when prop is default, should draw sorted series, labels and axes", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		sut := chart.NewLine(lineSeries)
+		sut.SetConfig(&entity.Config{})
+
+		var lines [][]entity.Point
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().GetFontHeight(mock.Anything).Return(4.0)
+		provider.EXPECT().AddText(mock.Anything, mock.Anything, mock.Anything)
+		provider.EXPECT().AddPolyline(mock.Anything, mock.Anything).Run(func(points []entity.Point, _ *props.Shape) {
+			lines = append(lines, points)
+		})
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		provider.AssertNumberOfCalls(t, "AddText", 11)
+		provider.AssertNumberOfCalls(t, "AddPolyline", 2)
+		provider.AssertCalled(t, "AddText", "20", mock.Anything, mock.Anything)
+		provider.AssertCalled(t, "AddText", "8", mock.Anything, mock.Anything)
+		assert.Equal(t, []entity.Point{{X: 22, Y: 159}, {X: 64, Y: 17}, {X: 106, Y: 88}}, lines[0])
+		assert.Equal(t, []entity.Point{{X: 22, Y: 17}, {X: 22, Y: 159}, {X: 106, Y: 159}}, lines[1])
+	})
+	t.Run("when prop is custom, should draw grid, area, markers, legend and time labels", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		start := time.Date(2024, time.January, 3, 0, 0, 0, 0, time.UTC)
+		sut := chart.NewLine([]chart.LineSeries{{Name: "Latency", Points: []chart.Point{
+			chart.NewTimePoint(start, 1),
+			chart.NewTimePoint(start.Add(4*time.Hour), 3),
+			chart.NewTimePoint(start.Add(7*time.Hour), 2),
+		}}}, fixture.LineChartProp())
+		sut.SetConfig(&entity.Config{})
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().GetFontHeight(mock.Anything).Return(4.0)
+		provider.EXPECT().AddText(mock.Anything, mock.Anything, mock.Anything)
+		provider.EXPECT().AddPolygon(mock.Anything, mock.Anything)
+		provider.EXPECT().AddPolyline(mock.Anything, mock.Anything)
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		provider.AssertNumberOfCalls(t, "AddText", 9)
+		provider.AssertNumberOfCalls(t, "AddPolygon", 5)
+		provider.AssertNumberOfCalls(t, "AddPolyline", 8)
+		provider.AssertCalled(t, "AddText", "Latency", mock.Anything, mock.Anything)
+		provider.AssertCalled(t, "AddText", "06h", mock.Anything, mock.Anything)
+		provider.AssertCalled(t, "AddText", "2.00", mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,181 @@
+package chart
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/johnfercher/go-tree/node"
+
+	"github.com/johnfercher/maroto/v2/pkg/components/col"
+	"github.com/johnfercher/maroto/v2/pkg/components/row"
+	"github.com/johnfercher/maroto/v2/pkg/consts/align"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+const (
+	pieStartAngle    = -90.0
+	pieRadiusPercent = 0.95
+	pieLegendPercent = 0.4
+	pieLabelRadius   = 0.65
+)
+
+type Pie struct {
+	labels []string
+	values []float64
+	prop   props.PieChart
+	config *entity.Config
+}
+
+// NewPie is responsible to create an instance of a pie chart, with a slice for each label.
+// Values less than or equal to zero are not drawn. A pie with HolePercent is a donut.
+func NewPie(labels []string, values []float64, ps ...props.PieChart) core.Component {
+	pieProp := props.PieChart{}
+	if len(ps) > 0 {
+		pieProp = ps[0]
+	}
+
+	return &Pie{
+		labels: labels,
+		values: values,
+		prop:   pieProp,
+	}
+}
+
+// NewPieCol is responsible to create an instance of a pie chart wrapped in a Col.
+func NewPieCol(size int, labels []string, values []float64, ps ...props.PieChart) core.Col {
+	pieChart := NewPie(labels, values, ps...)
+	return col.New(size).Add(pieChart)
+}
+
+// NewPieRow is responsible to create an instance of a pie chart wrapped in a Row.
+func NewPieRow(height float64, labels []string, values []float64, ps ...props.PieChart) core.Row {
+	pieChart := NewPie(labels, values, ps...)
+	c := col.New().Add(pieChart)
+	return row.New(height).Add(c)
+}
+
+// NewAutoPieRow is responsible to create an instance of a pie chart wrapped in a Row with automatic height.
+func NewAutoPieRow(labels []string, values []float64, ps ...props.PieChart) core.Row {
+	pieChart := NewPie(labels, values, ps...)
+	c := col.New().Add(pieChart)
+	return row.New().Add(c)
+}
+
+// GetStructure returns the Structure of a pie chart.
+func (p *Pie) GetStructure() *node.Node[core.Structure] {
+	str := core.Structure{
+		Type:    "piechart",
+		Details: p.prop.ToMap(),
+	}
+
+	n := node.New(str)
+	for i, label := range p.labels {
+		n.AddNext(node.New(core.Structure{
+			Type:    "slice",
+			Value:   label,
+			Details: map[string]interface{}{"value": p.getValue(i)},
+		}))
+	}
+
+	return n
+}
+
+// GetHeight returns the height that the chart will have in the PDF.
+func (p *Pie) GetHeight(_ core.Provider, cell *entity.Cell) float64 {
+	return cell.Width * p.prop.Proportion.Height / p.prop.Proportion.Width
+}
+
+// SetConfig sets the config.
+func (p *Pie) SetConfig(config *entity.Config) {
+	p.config = config
+	p.prop.MakeValid()
+}
+
+// Render renders a pie chart into a PDF context.
+func (p *Pie) Render(provider core.Provider, cell *entity.Cell) {
+	total := 0.0
+	for i := range p.labels {
+		total += max(p.getValue(i), 0)
+	}
+
+	font := getFont(p.config, p.prop.FontSize)
+	fontHeight := provider.GetFontHeight(&font)
+
+	pieWidth := cell.Width
+	if p.prop.ShowLegend {
+		pieWidth *= 1 - pieLegendPercent
+		p.addLegend(provider, cell, pieWidth, &font, fontHeight)
+	}
+
+	if total == 0 {
+		return
+	}
+
+	centerX := cell.X + pieWidth/2
+	centerY := cell.Y + cell.Height/2
+	radius := min(pieWidth, cell.Height) / 2 * pieRadiusPercent
+	hole := radius * p.prop.HolePercent / 100
+
+	slice := &props.Shape{BorderColor: p.prop.SliceBorderColor}
+	slice.MakeValid()
+
+	valueProp := getTextProp(font, align.Center)
+	labelRadius := radius * pieLabelRadius
+	if hole > 0 {
+		labelRadius = (radius + hole) / 2
+	}
+
+	angle := pieStartAngle
+	for i := range p.labels {
+		value := p.getValue(i)
+		if value <= 0 {
+			continue
+		}
+
+		sweep := value / total * 360
+		slice.FillColor = getColor(p.prop.Palette, i)
+		provider.AddPolygon(p.getSlice(centerX, centerY, radius, hole, angle, angle+sweep), slice)
+
+		if p.prop.ShowValues {
+			middle := (angle + sweep/2) * math.Pi / 180
+			x := centerX + labelRadius*math.Cos(middle)
+			y := centerY + labelRadius*math.Sin(middle)
+			width := radius
+			addText(provider, fmt.Sprintf(p.prop.PercentFormat, value/total*100), x-width/2, y-fontHeight/2, width, fontHeight, &valueProp)
+		}
+
+		angle += sweep
+	}
+}
+
+// getSlice returns the points of a slice, which is closed in the center or, in a donut, by the hole.
+func (p *Pie) getSlice(centerX, centerY, radius, hole, start, end float64) []entity.Point {
+	points := arc(centerX, centerY, radius, start, end)
+	if hole == 0 {
+		return append(points, entity.Point{X: centerX, Y: centerY})
+	}
+
+	return append(points, arc(centerX, centerY, hole, end, start)...)
+}
+
+func (p *Pie) addLegend(provider core.Provider, cell *entity.Cell, pieWidth float64, font *props.Font, fontHeight float64) {
+	prop := getTextProp(*font, align.Left)
+	itemHeight := fontHeight * 1.5
+	x := cell.X + pieWidth + fontHeight*0.5
+	y := cell.Y + (cell.Height-itemHeight*float64(len(p.labels)))/2
+
+	for i, label := range p.labels {
+		addLegendItem(provider, label, getColor(p.prop.Palette, i), x, y, cell.X+cell.Width-x, fontHeight, &prop)
+		y += itemHeight
+	}
+}
+
+func (p *Pie) getValue(index int) float64 {
+	if index >= len(p.values) {
+		return 0
+	}
+
+	return p.values[index]
+}
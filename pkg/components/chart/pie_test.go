@@ -0,0 +1,167 @@
+package chart_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/mocks"
+	"github.com/johnfercher/maroto/v2/pkg/components/chart"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+	"github.com/johnfercher/maroto/v2/pkg/test"
+)
+
+var (
+	sliceLabels = []string{"Food", "Rent", "Travel"}
+	sliceValues = []float64{1, 1, 2}
+)
+
+func TestNewPie(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := chart.NewPie(sliceLabels, sliceValues)
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/charts/new_pie_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := chart.NewPie(sliceLabels, sliceValues, fixture.PieChartProp())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/charts/new_pie_custom_prop.json")
+	})
+}
+
+func TestNewPieCol(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := chart.NewPieCol(12, sliceLabels, sliceValues)
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/charts/new_pie_col_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := chart.NewPieCol(12, sliceLabels, sliceValues, fixture.PieChartProp())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/charts/new_pie_col_custom_prop.json")
+	})
+}
+
+func TestNewPieRow(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := chart.NewPieRow(10, sliceLabels, sliceValues)
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/charts/new_pie_row_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := chart.NewPieRow(10, sliceLabels, sliceValues, fixture.PieChartProp())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/charts/new_pie_row_custom_prop.json")
+	})
+}
+
+func TestNewAutoPieRow(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := chart.NewAutoPieRow(sliceLabels, sliceValues)
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/charts/new_pie_auto_row_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := chart.NewAutoPieRow(sliceLabels, sliceValues, fixture.PieChartProp())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/charts/new_pie_auto_row_custom_prop.json")
+	})
+}
+
+func TestPie_GetHeight(t *testing.T) {
+	t.Run("should return height from proportion", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		sut := chart.NewPie(sliceLabels, sliceValues)
+		sut.SetConfig(&entity.Config{})
+
+		// Act
+		height := sut.GetHeight(nil, &cell)
+
+		// Assert
+		assert.Equal(t, 56.25, height)
+	})
+}
+
+func TestPie_Render(t *testing.T) {
+	t.Run("when values sum zero, should not draw slices", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		sut := chart.NewPie(sliceLabels, []float64{0, -1})
+		sut.SetConfig(&entity.Config{})
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().GetFontHeight(mock.Anything).Return(4.0)
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		provider.AssertNotCalled(t, "AddPolygon")
+	})
+	t.Run("when prop is default, should draw a slice for each value", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		sut := chart.NewPie(sliceLabels, sliceValues)
+		sut.SetConfig(&entity.Config{})
+
+		var slices [][]entity.Point
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().GetFontHeight(mock.Anything).Return(4.0)
+		provider.EXPECT().AddPolygon(mock.Anything, mock.Anything).Run(func(points []entity.Point, _ *props.Shape) {
+			slices = append(slices, points)
+		})
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		provider.AssertNumberOfCalls(t, "AddPolygon", 3)
+		first := slices[0]
+		assert.Len(t, first, 47)
+		assert.InDelta(t, 60, first[0].X, 0.001)
+		assert.InDelta(t, 42.5, first[0].Y, 0.001)
+		assert.InDelta(t, 107.5, first[45].X, 0.001)
+		assert.InDelta(t, 90, first[45].Y, 0.001)
+		assert.Equal(t, entity.Point{X: 60, Y: 90}, first[46])
+	})
+	t.Run("when prop is custom, should draw a donut with values and legend", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		sut := chart.NewPie(sliceLabels, sliceValues, fixture.PieChartProp())
+		sut.SetConfig(&entity.Config{})
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().GetFontHeight(mock.Anything).Return(4.0)
+		provider.EXPECT().AddPolygon(mock.Anything, mock.Anything)
+		provider.EXPECT().AddText(mock.Anything, mock.Anything, mock.Anything)
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		provider.AssertNumberOfCalls(t, "AddPolygon", 6)
+		provider.AssertNumberOfCalls(t, "AddText", 6)
+		provider.AssertCalled(t, "AddText", "50%", mock.Anything, mock.Anything)
+		provider.AssertCalled(t, "AddText", "Travel", mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,141 @@
+package chart
+
+import (
+	"math"
+	"time"
+)
+
+const (
+	minute = 60
+	hour   = 60 * minute
+	day    = 24 * hour
+	week   = 7 * day
+	// monday is the first monday after the unix epoch, weeks start on it.
+	monday = 4 * day
+)
+
+// timeSteps are the distances in seconds that time ticks can have, longer distances use months and years.
+var timeSteps = []int64{
+	1, 2, 5, 10, 15, 30,
+	minute, 2 * minute, 5 * minute, 10 * minute, 15 * minute, 30 * minute,
+	hour, 2 * hour, 3 * hour, 6 * hour, 12 * hour,
+	day, 2 * day, week,
+}
+
+var monthSteps = []int{1, 2, 3, 6}
+
+// getNiceStep returns the distance between ticks, which is 1, 2 or 5 times a power of ten.
+func getNiceStep(lowest, highest float64, count int) float64 {
+	raw := (highest - lowest) / float64(count)
+	magnitude := math.Pow(10, math.Floor(math.Log10(raw)))
+
+	for _, factor := range []float64{1, 2, 5} {
+		if factor*magnitude >= raw {
+			return factor * magnitude
+		}
+	}
+
+	return 10 * magnitude
+}
+
+// getValueTicks returns the ticks of a value axis, which is expanded to start and end at a tick.
+func getValueTicks(lowest, highest float64, count int) []float64 {
+	if lowest == highest {
+		lowest, highest = lowest-1, highest+1
+	}
+
+	step := getNiceStep(lowest, highest, count)
+	first := math.Floor(lowest/step) * step
+	last := math.Ceil(highest/step) * step
+
+	return getStepTicks(first, last, step)
+}
+
+// getNumberTicks returns the ticks inside the range of a numeric x axis.
+func getNumberTicks(lowest, highest float64, count int) []float64 {
+	step := getNiceStep(lowest, highest, count)
+	return getStepTicks(math.Ceil(lowest/step)*step, highest, step)
+}
+
+func getStepTicks(first, last, step float64) []float64 {
+	// rounds to the decimals of the step to avoid values like 0.6000000000000001
+	precision := math.Pow(10, math.Max(0, -math.Floor(math.Log10(step))))
+
+	var ticks []float64
+	for i := 0; ; i++ {
+		tick := math.Round((first+float64(i)*step)*precision) / precision
+		if tick > last+step/1e6 {
+			return ticks
+		}
+
+		ticks = append(ticks, tick)
+	}
+}
+
+// getTimeTicks returns the ticks inside the range of a time axis, in unix seconds, and the
+// default layout of their labels.
+func getTimeTicks(lowest, highest float64, count int) ([]float64, string) {
+	raw := (highest - lowest) / float64(count)
+
+	for _, step := range timeSteps {
+		if float64(step) >= raw {
+			return getSecondTicks(lowest, highest, step), getSecondLayout(lowest, highest, step)
+		}
+	}
+
+	for _, months := range monthSteps {
+		if float64(months*30*day) >= raw {
+			return getMonthTicks(lowest, highest, months), "Jan 2006"
+		}
+	}
+
+	years := int(math.Max(getNiceStep(lowest/(365*day), highest/(365*day), count), 1))
+	return getMonthTicks(lowest, highest, years*12), "2006"
+}
+
+func getSecondTicks(lowest, highest float64, step int64) []float64 {
+	offset := int64(0)
+	if step == week {
+		offset = monday
+	}
+
+	var ticks []float64
+	first := int64(math.Ceil((lowest-float64(offset))/float64(step)))*step + offset
+	for tick := first; float64(tick) <= highest; tick += step {
+		ticks = append(ticks, float64(tick))
+	}
+
+	return ticks
+}
+
+// getMonthTicks returns the first day of the months, in UTC, that are multiple of the step.
+func getMonthTicks(lowest, highest float64, months int) []float64 {
+	start := time.Unix(int64(math.Floor(lowest)), 0).UTC()
+	index := start.Year()*12 + int(start.Month()) - 1
+	index -= index % months
+
+	var ticks []float64
+	for ; ; index += months {
+		tick := time.Date(index/12, time.Month(index%12+1), 1, 0, 0, 0, 0, time.UTC).Unix()
+		if float64(tick) > highest {
+			return ticks
+		}
+
+		if float64(tick) >= lowest {
+			ticks = append(ticks, float64(tick))
+		}
+	}
+}
+
+func getSecondLayout(lowest, highest float64, step int64) string {
+	switch {
+	case step < minute:
+		return "15:04:05"
+	case step < day && highest-lowest > day:
+		return "Jan 2 15:04"
+	case step < day:
+		return "15:04"
+	default:
+		return "Jan 2"
+	}
+}
@@ -0,0 +1,81 @@
+package chart
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetValueTicks(t *testing.T) {
+	t.Run("when range is not round, should expand to round ticks", func(t *testing.T) {
+		// Act
+		ticks := getValueTicks(3, 97, 5)
+
+		// Assert
+		assert.Equal(t, []float64{0, 20, 40, 60, 80, 100}, ticks)
+	})
+	t.Run("when range is decimal, should not accumulate float errors", func(t *testing.T) {
+		// Act
+		ticks := getValueTicks(0.1, 0.65, 5)
+
+		// Assert
+		assert.Equal(t, []float64{0, 0.2, 0.4, 0.6, 0.8}, ticks)
+	})
+	t.Run("when range is empty, should create a range around the value", func(t *testing.T) {
+		// Act
+		ticks := getValueTicks(10, 10, 4)
+
+		// Assert
+		assert.Equal(t, []float64{9, 9.5, 10, 10.5, 11}, ticks)
+	})
+}
+
+func TestGetNumberTicks(t *testing.T) {
+	t.Run("should return ticks inside the range", func(t *testing.T) {
+		// Act
+		ticks := getNumberTicks(-3, 23, 5)
+
+		// Assert
+		assert.Equal(t, []float64{0, 10, 20}, ticks)
+	})
+}
+
+func TestGetTimeTicks(t *testing.T) {
+	unix := func(year int, month time.Month, day, hour int) float64 {
+		return float64(time.Date(year, month, day, hour, 0, 0, 0, time.UTC).Unix())
+	}
+
+	t.Run("when range has hours, should return round hours", func(t *testing.T) {
+		// Act
+		ticks, layout := getTimeTicks(unix(2024, 1, 3, 0)+100, unix(2024, 1, 3, 7), 5)
+
+		// Assert
+		assert.Equal(t, []float64{unix(2024, 1, 3, 2), unix(2024, 1, 3, 4), unix(2024, 1, 3, 6)}, ticks)
+		assert.Equal(t, "15:04", layout)
+	})
+	t.Run("when range has weeks, should return mondays", func(t *testing.T) {
+		// Act
+		ticks, layout := getTimeTicks(unix(2024, 1, 3, 0), unix(2024, 2, 1, 0), 5)
+
+		// Assert
+		assert.Equal(t, []float64{unix(2024, 1, 8, 0), unix(2024, 1, 15, 0), unix(2024, 1, 22, 0), unix(2024, 1, 29, 0)}, ticks)
+		assert.Equal(t, "Jan 2", layout)
+	})
+	t.Run("when range has months, should return first days of months", func(t *testing.T) {
+		// Act
+		ticks, layout := getTimeTicks(unix(2024, 1, 3, 0), unix(2024, 7, 20, 0), 5)
+
+		// Assert
+		assert.Equal(t, []float64{unix(2024, 3, 1, 0), unix(2024, 5, 1, 0), unix(2024, 7, 1, 0)}, ticks)
+		assert.Equal(t, "Jan 2006", layout)
+	})
+	t.Run("when range has years, should return first days of years", func(t *testing.T) {
+		// Act
+		ticks, layout := getTimeTicks(unix(2001, 6, 1, 0), unix(2019, 6, 1, 0), 5)
+
+		// Assert
+		assert.Equal(t, []float64{unix(2005, 1, 1, 0), unix(2010, 1, 1, 0), unix(2015, 1, 1, 0)}, ticks)
+		assert.Equal(t, "2006", layout)
+	})
+}
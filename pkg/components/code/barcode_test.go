@@ -0,0 +1,143 @@
+package code_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/mocks"
+	"github.com/johnfercher/maroto/v2/pkg/components/code"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+	"github.com/johnfercher/maroto/v2/pkg/test"
+)
+
+func TestNewBar(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := code.NewBar("code")
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_barcode_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := code.NewBar("code", fixture.BarcodeProp())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_barcode_custom_prop.json")
+	})
+}
+
+func TestNewBarCol(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := code.NewBarCol(12, "code")
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_barcode_col_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := code.NewBarCol(12, "code", fixture.BarcodeProp())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_barcode_col_custom_prop.json")
+	})
+}
+
+func TestNewBarRow(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := code.NewBarRow(10, "code")
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_barcode_row_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := code.NewBarRow(10, "code", fixture.BarcodeProp())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_barcode_row_custom_prop.json")
+	})
+}
+
+func TestNewAutoBarRow(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := code.NewAutoBarRow("code")
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_barcode_auto_row_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := code.NewAutoBarRow("code", fixture.BarcodeProp())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_barcode_auto_row_custom_prop.json")
+	})
+}
+
+func TestBarcode_Render(t *testing.T) {
+	t.Run("should call provider correctly", func(t *testing.T) {
+		// Arrange
+		codeValue := "code"
+		cell := fixture.CellEntity()
+		prop := fixture.BarcodeProp()
+		sut := code.NewBar(codeValue, prop)
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().AddBarCode(codeValue, &cell, &prop)
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		provider.AssertNumberOfCalls(t, "AddBarCode", 1)
+	})
+}
+
+func TestBarcode_SetConfig(t *testing.T) {
+	t.Run("should call correctly", func(t *testing.T) {
+		// Arrange
+		sut := code.NewBar("code")
+
+		// Act
+		sut.SetConfig(nil)
+	})
+}
+
+func TestBarcode_GetHeight(t *testing.T) {
+	t.Run("when there is no caption, should return the height from proportion", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		sut := code.NewBar("code", props.Barcode{Proportion: props.Proportion{Width: 10, Height: 2}})
+
+		// Act
+		height := sut.GetHeight(mocks.NewProvider(t), &cell)
+
+		// Assert
+		assert.Equal(t, 20.0, height)
+	})
+	t.Run("when there is caption, should add the font height", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		font := fixture.FontProp()
+		cfg := &entity.Config{DefaultFont: &font}
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().GetFontHeight(&font).Return(5.0)
+
+		sut := code.NewBar("code", props.Barcode{Proportion: props.Proportion{Width: 10, Height: 2}, HumanReadable: true})
+		sut.SetConfig(cfg)
+
+		// Act
+		height := sut.GetHeight(provider, &cell)
+
+		// Assert
+		assert.Equal(t, 25.0, height)
+	})
+}
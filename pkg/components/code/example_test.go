@@ -0,0 +1,134 @@
+package code_test
+
+import (
+	"github.com/johnfercher/maroto/v2"
+	"github.com/johnfercher/maroto/v2/pkg/components/code"
+	"github.com/johnfercher/maroto/v2/pkg/components/col"
+	"github.com/johnfercher/maroto/v2/pkg/consts/barcode"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+// ExampleNewQr demonstrates how to generate a qrcode and add it to a maroto instance.
+func ExampleNewQr() {
+	m := maroto.New()
+
+	qrCode := code.NewQr("https://github.com/johnfercher/maroto")
+	col := col.New(6).Add(qrCode)
+	m.AddRow(10, col)
+
+	// generate document
+}
+
+// ExampleNewQrCol demonstrates how to generate a qrcode wrapped in a column.
+func ExampleNewQrCol() {
+	m := maroto.New()
+
+	qrCodeCol := code.NewQrCol(12, "https://github.com/johnfercher/maroto")
+	m.AddRow(10, qrCodeCol)
+
+	// generate document
+}
+
+// ExampleNewQrRow demonstrates how to generate a qrcode wrapped in a row.
+func ExampleNewQrRow() {
+	m := maroto.New()
+
+	qrCodeRow := code.NewQrRow(10, "https://github.com/johnfercher/maroto")
+	m.AddRows(qrCodeRow)
+
+	// generate document
+}
+
+// ExampleNewAutoQrRow demonstrates how to generate a qrcode wrapped in an automatic row.
+func ExampleNewAutoQrRow() {
+	m := maroto.New()
+
+	qrCodeRow := code.NewAutoQrRow("https://github.com/johnfercher/maroto")
+	m.AddRows(qrCodeRow)
+
+	// generate document
+}
+
+// ExampleNewBar demonstrates how to generate a barcode and add it to a maroto instance.
+func ExampleNewBar() {
+	m := maroto.New()
+
+	barCode := code.NewBar("7891234567895", props.Barcode{Type: barcode.EAN13, HumanReadable: true})
+	col := col.New(6).Add(barCode)
+	m.AddRow(10, col)
+
+	// generate document
+}
+
+// ExampleNewBarCol demonstrates how to generate a barcode wrapped in a column.
+func ExampleNewBarCol() {
+	m := maroto.New()
+
+	barCodeCol := code.NewBarCol(12, "https://github.com/johnfercher/maroto")
+	m.AddRow(10, barCodeCol)
+
+	// generate document
+}
+
+// ExampleNewBarRow demonstrates how to generate a barcode wrapped in a row.
+func ExampleNewBarRow() {
+	m := maroto.New()
+
+	barCodeRow := code.NewBarRow(10, "https://github.com/johnfercher/maroto")
+	m.AddRows(barCodeRow)
+
+	// generate document
+}
+
+// ExampleNewAutoBarRow demonstrates how to generate a barcode wrapped in an automatic row.
+func ExampleNewAutoBarRow() {
+	m := maroto.New()
+
+	barCodeRow := code.NewAutoBarRow("https://github.com/johnfercher/maroto")
+	m.AddRows(barCodeRow)
+
+	// generate document
+}
+
+// ExampleNewMatrix demonstrates how to generate a data matrix and add it to a maroto instance.
+func ExampleNewMatrix() {
+	m := maroto.New()
+
+	matrixCode := code.NewMatrix("https://github.com/johnfercher/maroto")
+	col := col.New(6).Add(matrixCode)
+	m.AddRow(10, col)
+
+	// generate document
+}
+
+// ExampleNewGS1Matrix demonstrates how to generate a GS1 data matrix from element strings.
+func ExampleNewGS1Matrix() {
+	m := maroto.New()
+
+	matrixCode := code.NewGS1Matrix("(01)09501101530003(17)250101(10)AB12")
+	col := col.New(3).Add(matrixCode)
+	m.AddRow(20, col)
+
+	// generate document
+}
+
+// ExampleNewPDF417 demonstrates how to generate a pdf417 code and add it to a maroto instance.
+func ExampleNewPDF417() {
+	m := maroto.New()
+
+	pdf417 := code.NewPDF417("M1DOE/JOHN            EABC123 GRULISLA 0001 123Y012A0001 100")
+	col := col.New(12).Add(pdf417)
+	m.AddRow(20, col)
+
+	// generate document
+}
+
+// ExampleNewAutoPDF417Row demonstrates how to generate a pdf417 code wrapped in an automatic row.
+func ExampleNewAutoPDF417Row() {
+	m := maroto.New()
+
+	pdf417Row := code.NewAutoPDF417Row("https://github.com/johnfercher/maroto")
+	m.AddRows(pdf417Row)
+
+	// generate document
+}
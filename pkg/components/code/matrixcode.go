@@ -0,0 +1,143 @@
+package code
+
+import (
+	"github.com/johnfercher/go-tree/node"
+
+	"github.com/johnfercher/maroto/v2/pkg/components/col"
+	"github.com/johnfercher/maroto/v2/pkg/components/row"
+	"github.com/johnfercher/maroto/v2/pkg/consts/matrixcode"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+type MatrixCode struct {
+	code      string
+	symbology matrixcode.Symbology
+	prop      props.Rect
+	config    *entity.Config
+}
+
+// NewMatrix is responsible to create an instance of a Data Matrix.
+func NewMatrix(code string, ps ...props.Rect) core.Component {
+	return newMatrixCode(code, matrixcode.DataMatrix, ps...)
+}
+
+// NewMatrixCol is responsible to create an instance of a Data Matrix wrapped in a Col.
+func NewMatrixCol(size int, code string, ps ...props.Rect) core.Col {
+	matrixCode := NewMatrix(code, ps...)
+	return col.New(size).Add(matrixCode)
+}
+
+// NewMatrixRow is responsible to create an instance of a Data Matrix wrapped in a Row.
+func NewMatrixRow(height float64, code string, ps ...props.Rect) core.Row {
+	matrixCode := NewMatrix(code, ps...)
+	c := col.New().Add(matrixCode)
+	return row.New(height).Add(c)
+}
+
+// NewAutoMatrixRow is responsible to create an instance of a Data Matrix wrapped in a automatic Row.
+func NewAutoMatrixRow(code string, ps ...props.Rect) core.Row {
+	matrixCode := NewMatrix(code, ps...)
+	c := col.New().Add(matrixCode)
+	return row.New().Add(c)
+}
+
+// NewGS1Matrix is responsible to create an instance of a GS1 Data Matrix,
+// the code must be written as GS1 element strings, e.g. "(01)09501101530003(10)AB12".
+func NewGS1Matrix(code string, ps ...props.Rect) core.Component {
+	return newMatrixCode(code, matrixcode.GS1DataMatrix, ps...)
+}
+
+// NewGS1MatrixCol is responsible to create an instance of a GS1 Data Matrix wrapped in a Col.
+func NewGS1MatrixCol(size int, code string, ps ...props.Rect) core.Col {
+	matrixCode := NewGS1Matrix(code, ps...)
+	return col.New(size).Add(matrixCode)
+}
+
+// NewGS1MatrixRow is responsible to create an instance of a GS1 Data Matrix wrapped in a Row.
+func NewGS1MatrixRow(height float64, code string, ps ...props.Rect) core.Row {
+	matrixCode := NewGS1Matrix(code, ps...)
+	c := col.New().Add(matrixCode)
+	return row.New(height).Add(c)
+}
+
+// NewAutoGS1MatrixRow is responsible to create an instance of a GS1 Data Matrix wrapped in a automatic Row.
+func NewAutoGS1MatrixRow(code string, ps ...props.Rect) core.Row {
+	matrixCode := NewGS1Matrix(code, ps...)
+	c := col.New().Add(matrixCode)
+	return row.New().Add(c)
+}
+
+// NewPDF417 is responsible to create an instance of a PDF417 code.
+func NewPDF417(code string, ps ...props.Rect) core.Component {
+	return newMatrixCode(code, matrixcode.PDF417, ps...)
+}
+
+// NewPDF417Col is responsible to create an instance of a PDF417 code wrapped in a Col.
+func NewPDF417Col(size int, code string, ps ...props.Rect) core.Col {
+	matrixCode := NewPDF417(code, ps...)
+	return col.New(size).Add(matrixCode)
+}
+
+// NewPDF417Row is responsible to create an instance of a PDF417 code wrapped in a Row.
+func NewPDF417Row(height float64, code string, ps ...props.Rect) core.Row {
+	matrixCode := NewPDF417(code, ps...)
+	c := col.New().Add(matrixCode)
+	return row.New(height).Add(c)
+}
+
+// NewAutoPDF417Row is responsible to create an instance of a PDF417 code wrapped in a automatic Row.
+func NewAutoPDF417Row(code string, ps ...props.Rect) core.Row {
+	matrixCode := NewPDF417(code, ps...)
+	c := col.New().Add(matrixCode)
+	return row.New().Add(c)
+}
+
+func newMatrixCode(code string, symbology matrixcode.Symbology, ps ...props.Rect) *MatrixCode {
+	prop := props.Rect{}
+	if len(ps) > 0 {
+		prop = ps[0]
+	}
+	prop.MakeValid()
+
+	return &MatrixCode{
+		code:      code,
+		symbology: symbology,
+		prop:      prop,
+	}
+}
+
+// Render renders a MatrixCode into a PDF context.
+func (m *MatrixCode) Render(provider core.Provider, cell *entity.Cell) {
+	provider.AddMatrixCode(m.code, m.symbology, cell, &m.prop)
+}
+
+// GetStructure returns the Structure of a MatrixCode.
+func (m *MatrixCode) GetStructure() *node.Node[core.Structure] {
+	str := core.Structure{
+		Type:    "matrixcode",
+		Value:   m.code,
+		Details: m.prop.ToMap(),
+	}
+
+	str.Details["symbology"] = m.symbology
+
+	return node.New(str)
+}
+
+// GetHeight returns the height that the MatrixCode will have in the PDF
+func (m *MatrixCode) GetHeight(provider core.Provider, cell *entity.Cell) float64 {
+	dimensions, err := provider.GetDimensionsByMatrixCode(m.code, m.symbology)
+	if err != nil {
+		return 0
+	}
+	proportion := dimensions.Height / dimensions.Width
+	width := (m.prop.Percent / 100) * cell.Width
+	return (proportion * width) + m.prop.Top
+}
+
+// SetConfig sets the config.
+func (m *MatrixCode) SetConfig(config *entity.Config) {
+	m.config = config
+}
@@ -0,0 +1,279 @@
+package code_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/mocks"
+	"github.com/johnfercher/maroto/v2/pkg/components/code"
+	"github.com/johnfercher/maroto/v2/pkg/consts/matrixcode"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/test"
+)
+
+func TestNewMatrix(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := code.NewMatrix("code")
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_matrix_code_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := code.NewMatrix("code", fixture.RectProp())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_matrix_code_custom_prop.json")
+	})
+}
+
+func TestNewMatrixCol(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := code.NewMatrixCol(12, "code")
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_matrix_code_col_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := code.NewMatrixCol(12, "code", fixture.RectProp())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_matrix_code_col_custom_prop.json")
+	})
+}
+
+func TestNewMatrixRow(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := code.NewMatrixRow(10, "code")
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_matrix_code_row_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := code.NewMatrixRow(10, "code", fixture.RectProp())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_matrix_code_row_custom_prop.json")
+	})
+}
+
+func TestNewAutoMatrixRow(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := code.NewAutoMatrixRow("code")
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_matrix_code_auto_row_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := code.NewAutoMatrixRow("code", fixture.RectProp())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_matrix_code_auto_row_custom_prop.json")
+	})
+}
+
+func TestNewGS1Matrix(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := code.NewGS1Matrix("(01)09501101530003(10)AB12")
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_gs1_matrix_code_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := code.NewGS1Matrix("(01)09501101530003(10)AB12", fixture.RectProp())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_gs1_matrix_code_custom_prop.json")
+	})
+}
+
+func TestNewGS1MatrixCol(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := code.NewGS1MatrixCol(12, "(01)09501101530003(10)AB12")
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_gs1_matrix_code_col_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := code.NewGS1MatrixCol(12, "(01)09501101530003(10)AB12", fixture.RectProp())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_gs1_matrix_code_col_custom_prop.json")
+	})
+}
+
+func TestNewGS1MatrixRow(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := code.NewGS1MatrixRow(10, "(01)09501101530003(10)AB12")
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_gs1_matrix_code_row_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := code.NewGS1MatrixRow(10, "(01)09501101530003(10)AB12", fixture.RectProp())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_gs1_matrix_code_row_custom_prop.json")
+	})
+}
+
+func TestNewAutoGS1MatrixRow(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := code.NewAutoGS1MatrixRow("(01)09501101530003(10)AB12")
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_gs1_matrix_code_auto_row_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := code.NewAutoGS1MatrixRow("(01)09501101530003(10)AB12", fixture.RectProp())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_gs1_matrix_code_auto_row_custom_prop.json")
+	})
+}
+
+func TestNewPDF417(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := code.NewPDF417("code")
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_pdf417_code_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := code.NewPDF417("code", fixture.RectProp())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_pdf417_code_custom_prop.json")
+	})
+}
+
+func TestNewPDF417Col(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := code.NewPDF417Col(12, "code")
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_pdf417_code_col_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := code.NewPDF417Col(12, "code", fixture.RectProp())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_pdf417_code_col_custom_prop.json")
+	})
+}
+
+func TestNewPDF417Row(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := code.NewPDF417Row(10, "code")
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_pdf417_code_row_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := code.NewPDF417Row(10, "code", fixture.RectProp())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_pdf417_code_row_custom_prop.json")
+	})
+}
+
+func TestNewAutoPDF417Row(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := code.NewAutoPDF417Row("code")
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_pdf417_code_auto_row_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := code.NewAutoPDF417Row("code", fixture.RectProp())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_pdf417_code_auto_row_custom_prop.json")
+	})
+}
+
+func TestMatrixCode_Render(t *testing.T) {
+	t.Run("should call provider correctly", func(t *testing.T) {
+		// Arrange
+		codeValue := "code"
+		cell := fixture.CellEntity()
+		prop := fixture.RectProp()
+		sut := code.NewPDF417(codeValue, prop)
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().AddMatrixCode(codeValue, matrixcode.PDF417, &cell, &prop)
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		provider.AssertNumberOfCalls(t, "AddMatrixCode", 1)
+	})
+}
+
+func TestMatrixCode_SetConfig(t *testing.T) {
+	t.Run("should call correctly", func(t *testing.T) {
+		// Arrange
+		sut := code.NewMatrix("code")
+
+		// Act
+		sut.SetConfig(nil)
+	})
+}
+
+func TestMatrixCode_GetHeight(t *testing.T) {
+	t.Run("when dimensions cannot be calculated, should return zero", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().GetDimensionsByMatrixCode("", matrixcode.DataMatrix).Return(nil, errors.New("anyError"))
+
+		sut := code.NewMatrix("")
+
+		// Act
+		height := sut.GetHeight(provider, &cell)
+
+		// Assert
+		assert.Equal(t, 0.0, height)
+	})
+	t.Run("when dimensions can be calculated, should return the height from the code proportion", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().GetDimensionsByMatrixCode("code", matrixcode.PDF417).Return(&entity.Dimensions{Width: 100, Height: 20}, nil)
+
+		sut := code.NewPDF417("code")
+
+		// Act
+		height := sut.GetHeight(provider, &cell)
+
+		// Assert
+		assert.Equal(t, cell.Width*0.2, height)
+	})
+}
@@ -0,0 +1,144 @@
+package code_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/mocks"
+	"github.com/johnfercher/maroto/v2/pkg/components/code"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/test"
+)
+
+func TestNewQr(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := code.NewQr("code")
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_qr_code_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := code.NewQr("code", fixture.RectProp())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_qr_code_custom_prop.json")
+	})
+}
+
+func TestNewQrCol(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := code.NewQrCol(12, "code")
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_qr_code_col_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := code.NewQrCol(12, "code", fixture.RectProp())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_qr_code_col_custom_prop.json")
+	})
+}
+
+func TestNewQrRow(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := code.NewQrRow(10, "code")
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_qr_code_row_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := code.NewQrRow(10, "code", fixture.RectProp())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_qr_code_row_custom_prop.json")
+	})
+}
+
+func TestNewAutoQrRow(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := code.NewAutoQrRow("code")
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_qr_code_auto_row_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := code.NewAutoQrRow("code", fixture.RectProp())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_qr_code_auto_row_custom_prop.json")
+	})
+}
+
+func TestQrCode_Render(t *testing.T) {
+	t.Run("should call provider correctly", func(t *testing.T) {
+		// Arrange
+		codeValue := "code"
+		cell := fixture.CellEntity()
+		prop := fixture.RectProp()
+		sut := code.NewQr(codeValue, prop)
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().AddQrCode(codeValue, &cell, &prop)
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		provider.AssertNumberOfCalls(t, "AddQrCode", 1)
+	})
+}
+
+func TestQrCode_SetConfig(t *testing.T) {
+	t.Run("should call correctly", func(t *testing.T) {
+		// Arrange
+		sut := code.NewQr("code")
+
+		// Act
+		sut.SetConfig(nil)
+	})
+}
+
+func TestQrCode_GetHeight(t *testing.T) {
+	t.Run("when it is not possible to generate the code, should return height 0", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().GetDimensionsByQrCode("code").Return(nil, errors.New("anyError"))
+
+		sut := code.NewQr("code")
+
+		// Act
+		height := sut.GetHeight(provider, &cell)
+
+		// Assert
+		assert.Equal(t, 0.0, height)
+	})
+	t.Run("when code is square, should return the width of the cell", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().GetDimensionsByQrCode("code").Return(&entity.Dimensions{Width: 21, Height: 21}, nil)
+
+		sut := code.NewQr("code")
+
+		// Act
+		height := sut.GetHeight(provider, &cell)
+
+		// Assert
+		assert.Equal(t, cell.Width, height)
+	})
+}
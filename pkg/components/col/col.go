@@ -4,17 +4,21 @@ package col
 import (
 	"github.com/johnfercher/go-tree/node"
 
+	"github.com/johnfercher/maroto/v2/pkg/consts/align"
 	"github.com/johnfercher/maroto/v2/pkg/core"
 	"github.com/johnfercher/maroto/v2/pkg/core/entity"
 	"github.com/johnfercher/maroto/v2/pkg/props"
 )
 
 type Col struct {
-	size       int
-	isMax      bool
-	components []core.Component
-	config     *entity.Config
-	style      *props.Cell
+	size          int
+	isMax         bool
+	components    []core.Component
+	config        *entity.Config
+	style         *props.Cell
+	stacked       bool
+	gap           float64
+	verticalAlign align.Type
 }
 
 // New is responsible to create an instance of core.Col.
@@ -56,6 +60,23 @@ func (c *Col) GetStructure() *node.Node[core.Structure] {
 		str.Details["is_max"] = true
 	}
 
+	if c.stacked {
+		if len(str.Details) == 0 {
+			str.Details = make(map[string]interface{})
+		}
+		str.Details["is_stacked"] = true
+		if c.gap != 0 {
+			str.Details["stack_gap"] = c.gap
+		}
+	}
+
+	if c.verticalAlign != "" {
+		if len(str.Details) == 0 {
+			str.Details = make(map[string]interface{})
+		}
+		str.Details["vertical_align"] = c.verticalAlign
+	}
+
 	node := node.New(str)
 
 	for _, c := range c.components {
@@ -72,8 +93,38 @@ func (c *Col) Render(provider core.Provider, cell entity.Cell, createCell bool)
 		provider.CreateCol(cell.Width, cell.Height, c.config, c.style)
 	}
 
+	if c.stacked {
+		c.renderStack(provider, cell)
+		return
+	}
+
+	if c.verticalAlign == "" {
+		for _, component := range c.components {
+			component.Render(provider, &cell)
+		}
+		return
+	}
+
 	for _, component := range c.components {
-		component.Render(provider, &cell)
+		height := component.GetHeight(provider, &cell)
+		componentCell := cell.Copy()
+		componentCell.Y += c.getVerticalOffset(cell.Height, height)
+		componentCell.Height = min(height, cell.Height)
+		component.Render(provider, &componentCell)
+	}
+}
+
+// renderStack renders the components one below the other, each with its own height.
+func (c *Col) renderStack(provider core.Provider, cell entity.Cell) {
+	componentCell := cell.Copy()
+	if c.verticalAlign != "" {
+		componentCell.Y += c.getVerticalOffset(cell.Height, c.getStackHeight(provider, &cell))
+	}
+
+	for _, component := range c.components {
+		componentCell.Height = component.GetHeight(provider, &cell)
+		component.Render(provider, &componentCell)
+		componentCell.Y += componentCell.Height + c.gap
 	}
 }
 
@@ -91,12 +142,52 @@ func (c *Col) WithStyle(style *props.Cell) core.Col {
 	return c
 }
 
-// GetHeight returns the height of the column content
+// WithStack sets the column to render its components from top to bottom, instead of all at
+// the origin of the cell, with an optional gap between them.
+func (c *Col) WithStack(gap ...float64) core.Col {
+	c.stacked = true
+	if len(gap) > 0 && gap[0] > 0 {
+		c.gap = gap[0]
+	}
+	return c
+}
+
+// WithVerticalAlign sets the column to place its content at the top, the middle or the bottom
+// of the row, the height of each component, or of the whole stack, is compared to the row height.
+func (c *Col) WithVerticalAlign(verticalAlign align.Type) core.Col {
+	switch verticalAlign {
+	case align.Middle, align.Center, align.Bottom:
+		c.verticalAlign = verticalAlign
+	default:
+		c.verticalAlign = ""
+	}
+	return c
+}
+
+// getVerticalOffset returns the space between the top of the row and content of the height.
+func (c *Col) getVerticalOffset(rowHeight, height float64) float64 {
+	space := max(rowHeight-height, 0)
+	switch c.verticalAlign {
+	case align.Middle, align.Center:
+		return space / 2
+	case align.Bottom:
+		return space
+	default:
+		return 0
+	}
+}
+
+// GetHeight returns the height of the column content, which is the height of the largest component
+// or the sum of the heights and gaps when the column is stacked.
 func (c *Col) GetHeight(provider core.Provider, cell *entity.Cell) float64 {
 	innerCell := cell.Copy()
 	percent := float64(c.GetSize()) / float64(c.config.MaxGridSize)
 	innerCell.Width *= percent
 
+	if c.stacked {
+		return c.getStackHeight(provider, &innerCell)
+	}
+
 	greaterHeight := 0.0
 	for _, component := range c.components {
 		height := component.GetHeight(provider, &innerCell)
@@ -106,3 +197,14 @@ func (c *Col) GetHeight(provider core.Provider, cell *entity.Cell) float64 {
 	}
 	return greaterHeight
 }
+
+func (c *Col) getStackHeight(provider core.Provider, cell *entity.Cell) float64 {
+	height := 0.0
+	for i, component := range c.components {
+		if i > 0 {
+			height += c.gap
+		}
+		height += component.GetHeight(provider, cell)
+	}
+	return height
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/johnfercher/maroto/v2/mocks"
 	"github.com/johnfercher/maroto/v2/pkg/components/col"
 	"github.com/johnfercher/maroto/v2/pkg/components/text"
+	"github.com/johnfercher/maroto/v2/pkg/consts/align"
 	"github.com/johnfercher/maroto/v2/pkg/core/entity"
 	"github.com/johnfercher/maroto/v2/pkg/props"
 	"github.com/johnfercher/maroto/v2/pkg/test"
@@ -44,6 +45,20 @@ func TestNew(t *testing.T) {
 		// Assert
 		test.New(t).Assert(c.GetStructure()).Equals("components/cols/new_with_props.json")
 	})
+	t.Run("when is stacked, should retrieve stack", func(t *testing.T) {
+		// Act
+		c := col.New(12).Add(text.New("title"), text.New("paragraph")).WithStack(2)
+
+		// Assert
+		test.New(t).Assert(c.GetStructure()).Equals("components/cols/new_stacked.json")
+	})
+	t.Run("when has vertical align, should retrieve vertical align", func(t *testing.T) {
+		// Act
+		c := col.New(12).Add(text.New("label")).WithVerticalAlign(align.Middle)
+
+		// Assert
+		test.New(t).Assert(c.GetStructure()).Equals("components/cols/new_vertical_align.json")
+	})
 }
 
 func TestCol_GetSize(t *testing.T) {
@@ -119,6 +134,108 @@ func TestCol_Render(t *testing.T) {
 		component.AssertNumberOfCalls(t, "Render", 1)
 		component.AssertNumberOfCalls(t, "SetConfig", 1)
 	})
+	t.Run("when stacked, should render components one below the other", func(t *testing.T) {
+		// Arrange
+		cfg := &entity.Config{}
+		cell := fixture.CellEntity()
+
+		provider := mocks.NewProvider(t)
+
+		component := mocks.NewComponent(t)
+		component.EXPECT().GetHeight(provider, &cell).Return(10.0)
+		component.EXPECT().Render(provider, &entity.Cell{X: 10, Y: 15, Width: 100, Height: 10})
+		component.EXPECT().SetConfig(cfg)
+
+		component2 := mocks.NewComponent(t)
+		component2.EXPECT().GetHeight(provider, &cell).Return(20.0)
+		component2.EXPECT().Render(provider, &entity.Cell{X: 10, Y: 27, Width: 100, Height: 20})
+		component2.EXPECT().SetConfig(cfg)
+
+		sut := col.New(12).Add(component, component2).WithStack(2)
+		sut.SetConfig(cfg)
+
+		// Act
+		sut.Render(provider, cell, false)
+
+		// Assert
+		component.AssertNumberOfCalls(t, "Render", 1)
+		component2.AssertNumberOfCalls(t, "Render", 1)
+	})
+	t.Run("when vertical align is middle, should render each component in the middle of the cell", func(t *testing.T) {
+		// Arrange
+		cfg := &entity.Config{}
+		cell := fixture.CellEntity()
+
+		provider := mocks.NewProvider(t)
+
+		component := mocks.NewComponent(t)
+		component.EXPECT().GetHeight(provider, &cell).Return(10.0)
+		component.EXPECT().Render(provider, &entity.Cell{X: 10, Y: 85, Width: 100, Height: 10})
+		component.EXPECT().SetConfig(cfg)
+
+		component2 := mocks.NewComponent(t)
+		component2.EXPECT().GetHeight(provider, &cell).Return(50.0)
+		component2.EXPECT().Render(provider, &entity.Cell{X: 10, Y: 65, Width: 100, Height: 50})
+		component2.EXPECT().SetConfig(cfg)
+
+		sut := col.New(12).Add(component, component2).WithVerticalAlign(align.Middle)
+		sut.SetConfig(cfg)
+
+		// Act
+		sut.Render(provider, cell, false)
+
+		// Assert
+		component.AssertNumberOfCalls(t, "Render", 1)
+		component2.AssertNumberOfCalls(t, "Render", 1)
+	})
+	t.Run("when stacked with vertical align bottom, should render the stack at the bottom of the cell", func(t *testing.T) {
+		// Arrange
+		cfg := &entity.Config{}
+		cell := fixture.CellEntity()
+
+		provider := mocks.NewProvider(t)
+
+		component := mocks.NewComponent(t)
+		component.EXPECT().GetHeight(provider, &cell).Return(10.0)
+		component.EXPECT().Render(provider, &entity.Cell{X: 10, Y: 133, Width: 100, Height: 10})
+		component.EXPECT().SetConfig(cfg)
+
+		component2 := mocks.NewComponent(t)
+		component2.EXPECT().GetHeight(provider, &cell).Return(20.0)
+		component2.EXPECT().Render(provider, &entity.Cell{X: 10, Y: 145, Width: 100, Height: 20})
+		component2.EXPECT().SetConfig(cfg)
+
+		sut := col.New(12).Add(component, component2).WithStack(2).WithVerticalAlign(align.Bottom)
+		sut.SetConfig(cfg)
+
+		// Act
+		sut.Render(provider, cell, false)
+
+		// Assert
+		component.AssertNumberOfCalls(t, "Render", 1)
+		component2.AssertNumberOfCalls(t, "Render", 1)
+	})
+	t.Run("when vertical align is top, should render components at the origin of the cell", func(t *testing.T) {
+		// Arrange
+		cfg := &entity.Config{}
+		cell := fixture.CellEntity()
+
+		provider := mocks.NewProvider(t)
+
+		component := mocks.NewComponent(t)
+		component.EXPECT().Render(provider, &cell)
+		component.EXPECT().SetConfig(cfg)
+
+		sut := col.New(12).Add(component).WithVerticalAlign(align.Top)
+		sut.SetConfig(cfg)
+
+		// Act
+		sut.Render(provider, cell, false)
+
+		// Assert
+		component.AssertNumberOfCalls(t, "Render", 1)
+		component.AssertNotCalled(t, "GetHeight")
+	})
 }
 
 func TestCol_GetHeight(t *testing.T) {
@@ -147,4 +264,30 @@ func TestCol_GetHeight(t *testing.T) {
 		component.AssertNumberOfCalls(t, "GetHeight", 1)
 		assert.Equal(t, height, 15.0)
 	})
+	t.Run("when column is stacked, should return the sum with gaps", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		cfg := &entity.Config{MaxGridSize: 12}
+		innerCell := cell.Copy()
+		innerCell.Width = 50
+
+		provider := mocks.NewProvider(t)
+
+		component := mocks.NewComponent(t)
+		component.EXPECT().GetHeight(provider, &innerCell).Return(10.0)
+		component.EXPECT().SetConfig(cfg)
+
+		component2 := mocks.NewComponent(t)
+		component2.EXPECT().GetHeight(provider, &innerCell).Return(15.0)
+		component2.EXPECT().SetConfig(cfg)
+
+		sut := col.New(6).Add(component, component2).WithStack(3)
+		sut.SetConfig(cfg)
+
+		// Act
+		height := sut.GetHeight(provider, &cell)
+
+		// Assert
+		assert.Equal(t, 28.0, height)
+	})
 }
@@ -3,10 +3,13 @@ package col_test
 import (
 	"github.com/johnfercher/maroto/v2"
 	"github.com/johnfercher/maroto/v2/pkg/components/col"
+	"github.com/johnfercher/maroto/v2/pkg/components/image"
 	"github.com/johnfercher/maroto/v2/pkg/components/row"
 	"github.com/johnfercher/maroto/v2/pkg/components/signature"
 	"github.com/johnfercher/maroto/v2/pkg/components/text"
+	"github.com/johnfercher/maroto/v2/pkg/consts/align"
 	"github.com/johnfercher/maroto/v2/pkg/consts/border"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
 	"github.com/johnfercher/maroto/v2/pkg/consts/linestyle"
 	"github.com/johnfercher/maroto/v2/pkg/props"
 )
@@ -73,3 +76,32 @@ func ExampleCol_WithStyle() {
 	// Do things and generate
 	_, _ = m.Generate()
 }
+
+// ExampleCol_WithStack demonstrates how to stack a title, a paragraph and an image in one Col.
+func ExampleCol_WithStack() {
+	col := col.New(6).WithStack(2)
+
+	title := text.New("Title", props.Text{Size: 14, Style: fontstyle.Bold})
+	paragraph := text.New("A long paragraph that is broken in many lines.")
+	image := image.NewFromFile("image.png")
+
+	col.Add(title, paragraph, image)
+
+	m := maroto.New()
+	m.AddAutoRow(col)
+
+	// Do things and generate
+	_, _ = m.Generate()
+}
+
+// ExampleCol_WithVerticalAlign demonstrates how to center a text next to a tall image.
+func ExampleCol_WithVerticalAlign() {
+	imageCol := image.NewFromFileCol(4, "image.png")
+	textCol := text.NewCol(8, "A single line next to the image").WithVerticalAlign(align.Middle)
+
+	m := maroto.New()
+	m.AddRow(40, imageCol, textCol)
+
+	// Do things and generate
+	_, _ = m.Generate()
+}
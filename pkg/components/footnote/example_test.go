@@ -0,0 +1,21 @@
+package footnote_test
+
+import (
+	"github.com/johnfercher/maroto/v2"
+	"github.com/johnfercher/maroto/v2/pkg/components/footnote"
+	"github.com/johnfercher/maroto/v2/pkg/config"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+// ExampleNew demonstrates how to add a text with a footnote.
+func ExampleNew() {
+	cfg := config.NewBuilder().
+		WithFootnote(props.Footnote{Numbering: props.PerPage}).
+		Build()
+
+	m := maroto.New(cfg)
+
+	m.AddRows(footnote.NewAutoRow("The parties agree to the terms", "As defined in the annex."))
+
+	// generate document
+}
@@ -0,0 +1,138 @@
+// Package footnote implements creation of texts with footnotes, which are written at the bottom
+// of the page where the text is.
+package footnote
+
+import (
+	"strconv"
+
+	"github.com/johnfercher/go-tree/node"
+
+	"github.com/johnfercher/maroto/v2/pkg/components/col"
+	"github.com/johnfercher/maroto/v2/pkg/components/richtext"
+	"github.com/johnfercher/maroto/v2/pkg/components/row"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+const (
+	// markerScale is the size of the number of a footnote relative to the size of the text.
+	markerScale = 0.6
+	// markerShift is how much the number of a footnote is moved up relative to the height of the text,
+	// so it is as high as the text and does not increase the height of the line.
+	markerShift = 0.4
+)
+
+type Footnote struct {
+	value    string
+	footnote *entity.Footnote
+	prop     props.Text
+	config   *entity.Config
+}
+
+// New is responsible to create an instance of a Footnote, a text followed by the number of its note,
+// the note is written at the bottom of the page where the text is. The number is given when the row
+// of the text is added to the document.
+func New(value string, note string, ps ...props.Text) core.Component {
+	textProp := props.Text{}
+	if len(ps) > 0 {
+		textProp = ps[0]
+	}
+
+	return &Footnote{
+		value:    value,
+		footnote: &entity.Footnote{Value: note},
+		prop:     textProp,
+	}
+}
+
+// NewCol is responsible to create an instance of a Footnote wrapped in a Col.
+func NewCol(size int, value string, note string, ps ...props.Text) core.Col {
+	footnote := New(value, note, ps...)
+	return col.New(size).Add(footnote)
+}
+
+// NewRow is responsible to create an instance of a Footnote wrapped in a Row.
+func NewRow(height float64, value string, note string, ps ...props.Text) core.Row {
+	footnote := New(value, note, ps...)
+	c := col.New().Add(footnote)
+	return row.New(height).Add(c)
+}
+
+// NewAutoRow is responsible to create an instance of a Footnote wrapped in a automatic Row.
+func NewAutoRow(value string, note string, ps ...props.Text) core.Row {
+	footnote := New(value, note, ps...)
+	c := col.New().Add(footnote)
+	return row.New().Add(c)
+}
+
+// GetStructure returns the Structure of a Footnote.
+func (f *Footnote) GetStructure() *node.Node[core.Structure] {
+	details := f.prop.ToMap()
+	details["footnote"] = f.footnote.Value
+
+	str := core.Structure{
+		Type:    "footnote",
+		Value:   f.value,
+		Details: details,
+	}
+
+	return node.New(str)
+}
+
+// GetHeight returns the height of the text with the number of the footnote.
+func (f *Footnote) GetHeight(provider core.Provider, cell *entity.Cell) float64 {
+	return f.getRichText(provider).GetHeight(provider, cell)
+}
+
+// SetConfig sets the config.
+func (f *Footnote) SetConfig(config *entity.Config) {
+	f.config = config
+	f.prop.MakeValid(config.DefaultFont)
+}
+
+// Render refers to the footnote and renders the text with its number into a PDF context.
+func (f *Footnote) Render(provider core.Provider, cell *entity.Cell) {
+	provider.AddFootnote(f.footnote)
+	f.getRichText(provider).Render(provider, cell)
+}
+
+// getRichText returns the text followed by the number of the footnote, while the footnote has
+// no number only the text is written.
+func (f *Footnote) getRichText(provider core.Provider) core.Component {
+	font := &props.Font{Family: f.prop.Family, Style: f.prop.Style, Size: f.prop.Size, Color: f.prop.Color}
+	spans := []entity.Span{richtext.NewSpan(f.value, props.Span{
+		Family:    font.Family,
+		Style:     font.Style,
+		Size:      font.Size,
+		Color:     font.Color,
+		Hyperlink: f.prop.Hyperlink,
+	})}
+
+	if f.footnote.Number > 0 {
+		spans = append(spans, newMarker(provider, f.footnote.Number, font))
+	}
+
+	r := richtext.New(spans, props.RichText{
+		Top:             f.prop.Top,
+		Bottom:          f.prop.Bottom,
+		Left:            f.prop.Left,
+		Right:           f.prop.Right,
+		Align:           f.prop.Align,
+		VerticalPadding: f.prop.VerticalPadding,
+	})
+	r.SetConfig(f.config)
+
+	return r
+}
+
+// newMarker returns the number of a footnote as a superscript of a text with the font.
+func newMarker(provider core.Provider, number int, font *props.Font) entity.Span {
+	return richtext.NewSpan(strconv.Itoa(number), props.Span{
+		Family:        font.Family,
+		Style:         font.Style,
+		Size:          font.Size * markerScale,
+		Color:         font.Color,
+		BaselineShift: provider.GetFontHeight(font) * markerShift,
+	})
+}
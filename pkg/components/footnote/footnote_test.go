@@ -0,0 +1,118 @@
+package footnote_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/mocks"
+	"github.com/johnfercher/maroto/v2/pkg/components/footnote"
+	"github.com/johnfercher/maroto/v2/pkg/consts/align"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+	"github.com/johnfercher/maroto/v2/pkg/test"
+)
+
+func TestNew(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := footnote.New("The parties agree", "As defined in the annex.")
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/footnotes/new_footnote_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := footnote.New("The parties agree", "As defined in the annex.", fixture.TextProp())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/footnotes/new_footnote_custom_prop.json")
+	})
+}
+
+func TestNewCol(t *testing.T) {
+	// Act
+	sut := footnote.NewCol(12, "The parties agree", "As defined in the annex.")
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/footnotes/new_footnote_col.json")
+}
+
+func TestNewRow(t *testing.T) {
+	// Act
+	sut := footnote.NewRow(10, "The parties agree", "As defined in the annex.")
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/footnotes/new_footnote_row.json")
+}
+
+func TestNewAutoRow(t *testing.T) {
+	// Act
+	sut := footnote.NewAutoRow("The parties agree", "As defined in the annex.")
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/footnotes/new_footnote_auto_row.json")
+}
+
+func TestFootnote_GetHeight(t *testing.T) {
+	t.Run("when footnote has no number, should measure only the text", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		font := fixture.FontProp()
+		spans := []entity.Span{{
+			Value: "The parties agree",
+			Prop:  props.Span{Family: font.Family, Style: font.Style, Size: font.Size, Color: font.Color},
+		}}
+
+		sut := footnote.New("The parties agree", "As defined in the annex.", props.Text{Top: 2})
+		sut.SetConfig(&entity.Config{DefaultFont: &font})
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().GetRichTextHeight(spans, &props.RichText{Top: 2, Align: align.Left}, 100.0).Return(5.0)
+
+		// Act
+		height := sut.GetHeight(provider, &cell)
+
+		// Assert
+		assert.Equal(t, 7.0, height)
+	})
+}
+
+func TestFootnote_Render(t *testing.T) {
+	t.Run("should refer to the footnote and write its number as a superscript", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		font := fixture.FontProp()
+		spans := []entity.Span{
+			{
+				Value: "The parties agree",
+				Prop:  props.Span{Family: font.Family, Style: font.Style, Size: font.Size, Color: font.Color},
+			},
+			{
+				Value: "3",
+				Prop: props.Span{
+					Family: font.Family, Style: font.Style, Size: font.Size * 0.6, Color: font.Color, BaselineShift: 2,
+				},
+			},
+		}
+
+		sut := footnote.New("The parties agree", "As defined in the annex.")
+		sut.SetConfig(&entity.Config{DefaultFont: &font})
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().AddFootnote(mock.Anything).Run(func(footnote *entity.Footnote) {
+			footnote.Number = 3
+		})
+		provider.EXPECT().GetFontHeight(&font).Return(5.0)
+		provider.EXPECT().AddRichText(spans, &cell, &props.RichText{Align: align.Left})
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		provider.AssertNumberOfCalls(t, "AddFootnote", 1)
+		provider.AssertNumberOfCalls(t, "AddRichText", 1)
+	})
+}
@@ -0,0 +1,87 @@
+package footnote
+
+import (
+	"github.com/johnfercher/go-tree/node"
+
+	"github.com/johnfercher/maroto/v2/pkg/components/col"
+	"github.com/johnfercher/maroto/v2/pkg/components/richtext"
+	"github.com/johnfercher/maroto/v2/pkg/components/row"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+type Note struct {
+	footnote *entity.Footnote
+	prop     props.Footnote
+	config   *entity.Config
+}
+
+// NewNote is responsible to create an instance of a Note, the text of a footnote after its number,
+// written at the bottom of the page.
+func NewNote(footnote *entity.Footnote, ps ...props.Footnote) core.Component {
+	footnoteProp := props.Footnote{}
+	if len(ps) > 0 {
+		footnoteProp = ps[0]
+	}
+
+	return &Note{
+		footnote: footnote,
+		prop:     footnoteProp,
+	}
+}
+
+// NewNoteRow is responsible to create an instance of a Note wrapped in a automatic Row.
+func NewNoteRow(footnote *entity.Footnote, ps ...props.Footnote) core.Row {
+	note := NewNote(footnote, ps...)
+	c := col.New().Add(note)
+	return row.New().Add(c)
+}
+
+// GetStructure returns the Structure of a Note.
+func (n *Note) GetStructure() *node.Node[core.Structure] {
+	details := n.prop.ToMap()
+	details["number"] = n.footnote.Number
+
+	str := core.Structure{
+		Type:    "footnote_note",
+		Value:   n.footnote.Value,
+		Details: details,
+	}
+
+	return node.New(str)
+}
+
+// GetHeight returns the height of the note with its vertical padding.
+func (n *Note) GetHeight(provider core.Provider, cell *entity.Cell) float64 {
+	return n.getRichText(provider).GetHeight(provider, cell)
+}
+
+// SetConfig sets the config.
+func (n *Note) SetConfig(config *entity.Config) {
+	n.config = config
+	n.prop.MakeValid(config.DefaultFont)
+}
+
+// Render renders a Note into a PDF context.
+func (n *Note) Render(provider core.Provider, cell *entity.Cell) {
+	n.getRichText(provider).Render(provider, cell)
+}
+
+func (n *Note) getRichText(provider core.Provider) core.Component {
+	font := n.prop.ToFontProp()
+	spans := []entity.Span{
+		newMarker(provider, n.footnote.Number, font),
+		richtext.NewSpan(" "+n.footnote.Value, props.Span{
+			Family: font.Family,
+			Style:  font.Style,
+			Size:   font.Size,
+			Color:  font.Color,
+		}),
+	}
+
+	r := richtext.New(spans, props.RichText{Top: n.prop.VerticalPadding})
+	r.SetConfig(n.config)
+
+	return r
+}
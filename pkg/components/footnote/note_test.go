@@ -0,0 +1,73 @@
+package footnote_test
+
+import (
+	"testing"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/mocks"
+	"github.com/johnfercher/maroto/v2/pkg/components/footnote"
+	"github.com/johnfercher/maroto/v2/pkg/consts/align"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+	"github.com/johnfercher/maroto/v2/pkg/test"
+)
+
+func TestNewNote(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := footnote.NewNote(&entity.Footnote{Value: "As defined in the annex.", Number: 1})
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/footnotes/new_note_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := footnote.NewNote(&entity.Footnote{Value: "As defined in the annex.", Number: 1}, fixture.FootnoteProp())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/footnotes/new_note_custom_prop.json")
+	})
+}
+
+func TestNewNoteRow(t *testing.T) {
+	// Act
+	sut := footnote.NewNoteRow(&entity.Footnote{Value: "As defined in the annex.", Number: 1})
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/footnotes/new_note_row.json")
+}
+
+func TestNote_Render(t *testing.T) {
+	t.Run("should write the number of the footnote before its text", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		font := fixture.FontProp()
+		prop := fixture.FootnoteProp()
+		noteFont := prop.ToFontProp()
+		spans := []entity.Span{
+			{
+				Value: "2",
+				Prop: props.Span{
+					Family: prop.Family, Style: prop.Style, Size: prop.Size * 0.6, Color: prop.Color, BaselineShift: 1.6,
+				},
+			},
+			{
+				Value: " As defined in the annex.",
+				Prop:  props.Span{Family: prop.Family, Style: prop.Style, Size: prop.Size, Color: prop.Color},
+			},
+		}
+
+		sut := footnote.NewNote(&entity.Footnote{Value: "As defined in the annex.", Number: 2}, prop)
+		sut.SetConfig(&entity.Config{DefaultFont: &font})
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().GetFontHeight(noteFont).Return(4.0)
+		provider.EXPECT().AddRichText(spans, &cell, &props.RichText{Top: 1, Align: align.Left})
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		provider.AssertNumberOfCalls(t, "AddRichText", 1)
+	})
+}
@@ -0,0 +1,24 @@
+package grid_test
+
+import (
+	"github.com/johnfercher/maroto/v2"
+	"github.com/johnfercher/maroto/v2/pkg/components/grid"
+	"github.com/johnfercher/maroto/v2/pkg/components/image"
+	"github.com/johnfercher/maroto/v2/pkg/components/row"
+	"github.com/johnfercher/maroto/v2/pkg/components/text"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+// ExampleNewCol demonstrates how to create a logo on the left and three lines, each split into two columns, on the right.
+func ExampleNewCol() {
+	m := maroto.New()
+
+	details := grid.NewCol(8,
+		row.New(6).Add(text.NewCol(6, "Invoice"), text.NewCol(6, "#1234")),
+		row.New(6).Add(text.NewCol(6, "Date"), text.NewCol(6, "2024-01-31")),
+		row.New(6).Add(text.NewCol(6, "Due"), text.NewCol(6, "2024-02-29")),
+	)
+	m.AddAutoRow(image.NewFromFileCol(4, "logo.png", props.Rect{Center: true}), details)
+
+	// generate document
+}
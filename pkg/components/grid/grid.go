@@ -0,0 +1,94 @@
+// Package grid implements creation of nested grids, which are rows inside a col.
+package grid
+
+import (
+	"github.com/johnfercher/go-tree/node"
+
+	"github.com/johnfercher/maroto/v2/pkg/components/col"
+	"github.com/johnfercher/maroto/v2/pkg/components/row"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+)
+
+type Grid struct {
+	rows   []core.Row
+	config *entity.Config
+}
+
+// New is responsible to create an instance of a Grid, the rows are stacked inside the cell of the col
+// which holds the grid, and their cols are split with the same MaxGridSize of the document.
+func New(rows ...core.Row) core.Component {
+	return &Grid{
+		rows: rows,
+	}
+}
+
+// NewCol is responsible to create an instance of a Grid wrapped in a Col.
+func NewCol(size int, rows ...core.Row) core.Col {
+	grid := New(rows...)
+	return col.New(size).Add(grid)
+}
+
+// NewRow is responsible to create an instance of a Grid wrapped in a Row.
+func NewRow(height float64, rows ...core.Row) core.Row {
+	grid := New(rows...)
+	c := col.New().Add(grid)
+	return row.New(height).Add(c)
+}
+
+// NewAutoRow is responsible to create an instance of a Grid wrapped in a automatic Row.
+func NewAutoRow(rows ...core.Row) core.Row {
+	grid := New(rows...)
+	c := col.New().Add(grid)
+	return row.New().Add(c)
+}
+
+// GetStructure returns the Structure of a Grid.
+func (g *Grid) GetStructure() *node.Node[core.Structure] {
+	str := core.Structure{
+		Type:  "grid",
+		Value: len(g.rows),
+	}
+
+	node := node.New(str)
+
+	for _, r := range g.rows {
+		inner := r.GetStructure()
+		node.AddNext(inner)
+	}
+
+	return node
+}
+
+// GetHeight returns the sum of the heights of the rows of the Grid.
+func (g *Grid) GetHeight(provider core.Provider, cell *entity.Cell) float64 {
+	height := 0.0
+	for _, r := range g.rows {
+		height += r.GetHeight(provider, cell)
+	}
+
+	return height
+}
+
+// SetConfig sets the config of the rows.
+func (g *Grid) SetConfig(config *entity.Config) {
+	g.config = config
+	for _, r := range g.rows {
+		r.SetConfig(config)
+	}
+}
+
+// Render renders a Grid into a PDF context, each row is created at its own position inside the cell.
+func (g *Grid) Render(provider core.Provider, cell *entity.Cell) {
+	rowCell := cell.Copy()
+
+	for _, r := range g.rows {
+		rowCell.Height = r.GetHeight(provider, &rowCell)
+
+		provider.OpenGrid(&rowCell)
+		r.Render(provider, rowCell)
+		provider.CloseGrid()
+
+		rowCell.Y += rowCell.Height
+	}
+}
@@ -0,0 +1,93 @@
+package grid_test
+
+import (
+	"testing"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/mocks"
+	"github.com/johnfercher/maroto/v2/pkg/components/col"
+	"github.com/johnfercher/maroto/v2/pkg/components/grid"
+	"github.com/johnfercher/maroto/v2/pkg/components/row"
+	"github.com/johnfercher/maroto/v2/pkg/components/text"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+	"github.com/johnfercher/maroto/v2/pkg/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func getRows() []core.Row {
+	return []core.Row{
+		row.New(10).Add(text.NewCol(6, "label"), text.NewCol(6, "value")),
+		row.New(5).Add(col.New(12)),
+	}
+}
+
+func TestNew(t *testing.T) {
+	// Act
+	sut := grid.New(getRows()...)
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/grids/new_grid.json")
+}
+
+func TestNewCol(t *testing.T) {
+	// Act
+	sut := grid.NewCol(8, getRows()...)
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/grids/new_grid_col.json")
+}
+
+func TestNewRow(t *testing.T) {
+	// Act
+	sut := grid.NewRow(20, getRows()...)
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/grids/new_grid_row.json")
+}
+
+func TestNewAutoRow(t *testing.T) {
+	// Act
+	sut := grid.NewAutoRow(getRows()...)
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/grids/new_grid_auto_row.json")
+}
+
+func TestGrid_GetHeight(t *testing.T) {
+	// Arrange
+	cell := fixture.CellEntity()
+	sut := grid.New(getRows()...)
+
+	// Act
+	height := sut.GetHeight(nil, &cell)
+
+	// Assert
+	assert.Equal(t, 15.0, height)
+}
+
+func TestGrid_Render(t *testing.T) {
+	// Arrange
+	cfg := &entity.Config{MaxGridSize: 12}
+	cell := fixture.CellEntity()
+	sut := grid.New(row.New(10).Add(col.New(6), col.New(6)), row.New(5).Add(col.New()))
+	sut.SetConfig(cfg)
+
+	provider := mocks.NewProvider(t)
+	provider.EXPECT().OpenGrid(&entity.Cell{X: 10, Y: 15, Width: 100, Height: 10})
+	provider.EXPECT().OpenGrid(&entity.Cell{X: 10, Y: 25, Width: 100, Height: 5})
+	provider.EXPECT().CreateCol(50.0, 10.0, cfg, (*props.Cell)(nil))
+	provider.EXPECT().CreateCol(100.0, 5.0, cfg, (*props.Cell)(nil))
+	provider.EXPECT().CreateRow(10.0)
+	provider.EXPECT().CreateRow(5.0)
+	provider.EXPECT().CloseGrid()
+
+	// Act
+	sut.Render(provider, &cell)
+
+	// Assert
+	provider.AssertNumberOfCalls(t, "OpenGrid", 2)
+	provider.AssertNumberOfCalls(t, "CreateCol", 3)
+	provider.AssertNumberOfCalls(t, "CloseGrid", 2)
+}
@@ -0,0 +1,35 @@
+package html_test
+
+import (
+	"fmt"
+
+	"github.com/johnfercher/maroto/v2"
+	"github.com/johnfercher/maroto/v2/pkg/components/html"
+	"github.com/johnfercher/maroto/v2/pkg/consts/border"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+// ExampleBuild demonstrates how to convert an HTML fragment into rows.
+func ExampleBuild() {
+	m := maroto.New()
+
+	content := `<p style="text-align: center">Invoice <b>#123</b></p>
+<table>
+  <tr><th width="50%">Item</th><th>Qty</th><th>Price</th></tr>
+  <tr><td>Coffee</td><td align="center">2</td><td align="right">10.00</td></tr>
+</table>
+<marquee>unsupported</marquee>`
+
+	rows, warnings := html.Build(content, props.HTML{
+		TableCell: &props.Cell{BorderType: border.Full},
+	})
+	m.AddRows(rows...)
+
+	for _, warning := range warnings {
+		fmt.Println(warning)
+	}
+
+	// generate document
+
+	// Output: unsupported element <marquee>, its content was kept as text
+}
@@ -0,0 +1,452 @@
+// Package html implements the conversion of HTML fragments into rows.
+package html
+
+import (
+	"encoding/base64"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	xhtml "golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+
+	"github.com/johnfercher/maroto/v2/pkg/components/col"
+	"github.com/johnfercher/maroto/v2/pkg/components/image"
+	"github.com/johnfercher/maroto/v2/pkg/components/richtext"
+	"github.com/johnfercher/maroto/v2/pkg/components/row"
+	"github.com/johnfercher/maroto/v2/pkg/consts/align"
+	"github.com/johnfercher/maroto/v2/pkg/consts/extension"
+	"github.com/johnfercher/maroto/v2/pkg/consts/pagesize"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+var whitespaces = regexp.MustCompile(`\s+`)
+
+// ignoredElements are elements whose content is never rendered.
+var ignoredElements = map[atom.Atom]bool{
+	atom.Script:   true,
+	atom.Style:    true,
+	atom.Head:     true,
+	atom.Title:    true,
+	atom.Meta:     true,
+	atom.Link:     true,
+	atom.Template: true,
+	atom.Iframe:   true,
+	atom.Object:   true,
+	atom.Svg:      true,
+}
+
+// converter walks the HTML nodes creating rows, what can't be converted
+// is collected as warnings.
+type converter struct {
+	prop     props.HTML
+	rows     []core.Row
+	warnings []string
+}
+
+// paragraph collects the inline content of a block element.
+type paragraph struct {
+	spans  []entity.Span
+	text   props.Text
+	inCell bool
+	image  core.Component
+}
+
+// Build is responsible to convert an HTML fragment into rows, which can be added to
+// maroto and are paginated as any other row. The second return has a warning for
+// each element, attribute or style that could not be converted.
+//
+// The supported elements are p, div, span, b, strong, i, em, a, br, ul, ol, li, img
+// and table, with tr, td and th. The supported inline styles are color, font-size,
+// font-weight, font-style, text-align and, on table cells, width in percentage.
+// Unsupported elements keep their text content. Images must be a local path or a
+// base64 png/jpeg data URI.
+func Build(content string, ps ...props.HTML) ([]core.Row, []string) {
+	prop := props.HTML{}
+	if len(ps) > 0 {
+		prop = ps[0]
+	}
+	prop.MakeValid()
+
+	c := &converter{prop: prop}
+
+	body := &xhtml.Node{Type: xhtml.ElementNode, DataAtom: atom.Body, Data: "body"}
+	nodes, err := xhtml.ParseFragment(strings.NewReader(content), body)
+	if err != nil {
+		return nil, []string{fmt.Sprintf("could not parse html: %s", err.Error())}
+	}
+
+	p := c.newParagraph(prop.Paragraph)
+	for _, n := range nodes {
+		c.flow(n, p, toSpan(prop.Paragraph), 0)
+	}
+	c.flush(p)
+
+	return c.rows, c.warnings
+}
+
+func (c *converter) warn(format string, args ...interface{}) {
+	c.warnings = append(c.warnings, fmt.Sprintf(format, args...))
+}
+
+func (c *converter) newParagraph(text props.Text) *paragraph {
+	return &paragraph{text: text}
+}
+
+// flow converts a node inside a block container, inline nodes are added to the paragraph
+// and block nodes end it. level is the nesting of lists created inside the node.
+func (c *converter) flow(n *xhtml.Node, p *paragraph, span props.Span, level int) {
+	switch n.Type {
+	case xhtml.TextNode:
+		p.spans = append(p.spans, entity.Span{Value: whitespaces.ReplaceAllString(n.Data, " "), Prop: span})
+		return
+	case xhtml.ElementNode:
+	default:
+		return
+	}
+
+	if ignoredElements[n.DataAtom] {
+		c.warn("unsupported element <%s> was ignored", n.Data)
+		return
+	}
+
+	span, s := c.applyStyle(n, span)
+
+	switch n.DataAtom {
+	case atom.B, atom.Strong:
+		span.Style = combineStyle(span.Style, true, false)
+		c.children(n, p, span, level)
+	case atom.I, atom.Em:
+		span.Style = combineStyle(span.Style, false, true)
+		c.children(n, p, span, level)
+	case atom.Span:
+		c.children(n, p, span, level)
+	case atom.A:
+		c.link(n, p, span, level)
+	case atom.Br:
+		p.spans = append(p.spans, entity.Span{Value: "\n", Prop: span})
+	case atom.Img:
+		c.image(n, p)
+	case atom.P, atom.Div:
+		c.block(n, p, span, s, level)
+	case atom.Li:
+		if !p.inCell {
+			c.warn("unsupported element <li> outside of a list, its content was kept as text")
+		}
+		c.block(n, p, span, s, level)
+	case atom.Ul, atom.Ol:
+		if p.inCell {
+			c.warn("unsupported element <%s> inside a table cell, its content was kept as text", n.Data)
+			c.block(n, p, span, s, level)
+			return
+		}
+		c.flush(p)
+		c.list(n, span, level)
+	case atom.Thead, atom.Tbody, atom.Tfoot, atom.Tr:
+		c.block(n, p, span, s, level)
+	case atom.Td, atom.Th:
+		p.spans = append(p.spans, entity.Span{Value: " ", Prop: span})
+		c.children(n, p, span, level)
+	case atom.Table:
+		if p.inCell {
+			c.warn("unsupported element <table> inside a table cell, its content was kept as text")
+			c.block(n, p, span, s, level)
+			return
+		}
+		c.flush(p)
+		c.table(n, span)
+	default:
+		c.warn("unsupported element <%s>, its content was kept as text", n.Data)
+		c.children(n, p, span, level)
+	}
+}
+
+func (c *converter) children(n *xhtml.Node, p *paragraph, span props.Span, level int) {
+	for child := n.FirstChild; child != nil; child = child.NextSibling {
+		c.flow(child, p, span, level)
+	}
+}
+
+// block converts p and div, inside a table cell they are separated by a line break.
+func (c *converter) block(n *xhtml.Node, p *paragraph, span props.Span, s style, level int) {
+	if p.inCell {
+		if hasContent(p.spans) {
+			p.spans = append(p.spans, entity.Span{Value: "\n", Prop: span})
+		}
+		if s.textAlign != "" {
+			p.text.Align = s.textAlign
+		}
+		c.children(n, p, span, level)
+		return
+	}
+
+	c.flush(p)
+
+	inner := c.newParagraph(c.prop.Paragraph)
+	if alignType := getAlign(n, s); alignType != "" {
+		inner.text.Align = alignType
+	}
+
+	c.children(n, inner, span, level)
+	c.flush(inner)
+}
+
+func (c *converter) link(n *xhtml.Node, p *paragraph, span props.Span, level int) {
+	href, ok := getAttribute(n, "href")
+	switch {
+	case !ok || href == "":
+	case strings.HasPrefix(href, "#"):
+		c.warn("internal link %q is not supported, its content was kept as text", href)
+	default:
+		span.Hyperlink = &href
+	}
+
+	c.children(n, p, span, level)
+}
+
+func (c *converter) image(n *xhtml.Node, p *paragraph) {
+	src, _ := getAttribute(n, "src")
+	component, ok := c.newImage(src)
+	if !ok {
+		return
+	}
+
+	if p.inCell {
+		if p.image != nil {
+			c.warn("only one image is supported in a table cell, image %q was ignored", shorten(src))
+			return
+		}
+		p.image = component
+		return
+	}
+
+	c.flush(p)
+	c.rows = append(c.rows, row.New().Add(col.New().Add(component)))
+}
+
+func (c *converter) newImage(src string) (core.Component, bool) {
+	switch {
+	case src == "":
+		c.warn("image without src was ignored")
+		return nil, false
+	case strings.HasPrefix(src, "http://") || strings.HasPrefix(src, "https://"):
+		c.warn("remote image %q is not supported", src)
+		return nil, false
+	case strings.HasPrefix(src, "data:"):
+		return c.newImageFromData(src)
+	default:
+		return image.NewFromFile(src, c.prop.Image), true
+	}
+}
+
+func (c *converter) newImageFromData(src string) (core.Component, bool) {
+	header, data, _ := strings.Cut(src[len("data:"):], ",")
+	mediaType, encoding, _ := strings.Cut(header, ";")
+
+	extensions := map[string]extension.Type{
+		"image/png":     extension.Png,
+		"image/jpeg":    extension.Jpeg,
+		"image/jpg":     extension.Jpg,
+		"image/svg+xml": extension.Svg,
+		"image/gif":     extension.Gif,
+		"image/bmp":     extension.Bmp,
+		"image/tiff":    extension.Tiff,
+		"image/webp":    extension.Webp,
+	}
+
+	ext, ok := extensions[mediaType]
+	if !ok || encoding != "base64" {
+		c.warn("image data %q is not supported, only base64 png, jpeg, gif, bmp, tiff, webp and svg", shorten(src))
+		return nil, false
+	}
+
+	bytes, err := base64.StdEncoding.DecodeString(data)
+	if err != nil {
+		c.warn("image data %q is not valid base64", shorten(src))
+		return nil, false
+	}
+
+	return image.NewFromBytes(bytes, ext, c.prop.Image), true
+}
+
+// list converts ul and ol, nested lists are indented by their level.
+func (c *converter) list(n *xhtml.Node, span props.Span, level int) {
+	number := 1
+	if start, ok := getAttribute(n, "start"); ok {
+		if value, err := strconv.Atoi(start); err == nil {
+			number = value
+		} else {
+			c.warn("invalid start %q in <ol>", start)
+		}
+	}
+
+	for child := n.FirstChild; child != nil; child = child.NextSibling {
+		if child.Type == xhtml.TextNode && strings.TrimSpace(child.Data) == "" || child.Type == xhtml.CommentNode {
+			continue
+		}
+
+		if child.DataAtom != atom.Li {
+			c.warn("unsupported %s inside <%s>, its content was kept as text", describe(child), n.Data)
+			p := c.newParagraph(c.prop.Paragraph)
+			c.flow(child, p, span, level)
+			c.flush(p)
+			continue
+		}
+
+		marker := "•"
+		if n.DataAtom == atom.Ol {
+			marker = fmt.Sprintf("%d.", number)
+			number++
+		}
+
+		itemSpan, s := c.applyStyle(child, mergeSpan(span, toSpan(c.prop.ListItem)))
+		item := c.newParagraph(c.prop.ListItem)
+		item.text.Left += float64(level) * c.prop.ListIndent
+		if alignType := getAlign(child, s); alignType != "" {
+			item.text.Align = alignType
+		}
+
+		item.spans = append(item.spans, entity.Span{Value: marker + " ", Prop: itemSpan})
+		c.children(child, item, itemSpan, level+1)
+		c.flush(item)
+	}
+}
+
+// flush creates a row with the content of the paragraph and empties it.
+func (c *converter) flush(p *paragraph) {
+	if hasContent(p.spans) {
+		c.rows = append(c.rows, richtext.NewAutoRow(p.spans, toRichText(p.text)))
+	}
+
+	p.spans = nil
+}
+
+// applyStyle applies the style attribute of a node to the span inherited from its parent.
+func (c *converter) applyStyle(n *xhtml.Node, span props.Span) (props.Span, style) {
+	value, ok := getAttribute(n, "style")
+	if !ok {
+		return span, style{}
+	}
+
+	baseSize := span.Size
+	if baseSize == 0 {
+		baseSize = pagesize.DefaultFontSize
+	}
+
+	s, warnings := parseStyle(value, baseSize)
+	for _, warning := range warnings {
+		c.warn("%s in <%s>", warning, n.Data)
+	}
+
+	if s.color != nil {
+		span.Color = s.color
+	}
+
+	if s.fontSize != 0 {
+		span.Size = s.fontSize
+	}
+
+	span.Style = combineStyle(span.Style, s.bold, s.italic)
+
+	return span, s
+}
+
+func getAlign(n *xhtml.Node, s style) align.Type {
+	if s.textAlign != "" {
+		return s.textAlign
+	}
+
+	value, ok := getAttribute(n, "align")
+	if !ok {
+		return ""
+	}
+
+	alignType, err := parseTextAlign(strings.ToLower(value))
+	if err != nil {
+		return ""
+	}
+
+	return alignType
+}
+
+func getAttribute(n *xhtml.Node, key string) (string, bool) {
+	for _, attribute := range n.Attr {
+		if attribute.Key == key {
+			return attribute.Val, true
+		}
+	}
+
+	return "", false
+}
+
+func hasContent(spans []entity.Span) bool {
+	for _, span := range spans {
+		if strings.TrimSpace(span.Value) != "" {
+			return true
+		}
+	}
+
+	return false
+}
+
+func describe(n *xhtml.Node) string {
+	if n.Type == xhtml.TextNode {
+		return "text"
+	}
+
+	return fmt.Sprintf("element <%s>", n.Data)
+}
+
+// shorten limits long values, as data URIs, written in warnings.
+func shorten(value string) string {
+	const limit = 40
+	if len(value) <= limit {
+		return value
+	}
+
+	return value[:limit] + "..."
+}
+
+func toRichText(text props.Text) props.RichText {
+	return props.RichText{
+		Top:             text.Top,
+		Bottom:          text.Bottom,
+		Left:            text.Left,
+		Right:           text.Right,
+		Align:           text.Align,
+		VerticalPadding: text.VerticalPadding,
+	}
+}
+
+func toSpan(text props.Text) props.Span {
+	return props.Span{
+		Family: text.Family,
+		Style:  text.Style,
+		Size:   text.Size,
+		Color:  text.Color,
+	}
+}
+
+// mergeSpan overrides the inherited span with the fields defined in the override.
+func mergeSpan(span, override props.Span) props.Span {
+	if override.Family != "" {
+		span.Family = override.Family
+	}
+
+	if override.Style != "" {
+		span.Style = override.Style
+	}
+
+	if override.Size != 0 {
+		span.Size = override.Size
+	}
+
+	if override.Color != nil {
+		span.Color = override.Color
+	}
+
+	return span
+}
@@ -0,0 +1,113 @@
+package html_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/pkg/components/html"
+	"github.com/johnfercher/maroto/v2/pkg/components/page"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+	"github.com/johnfercher/maroto/v2/pkg/test"
+)
+
+const fragment = `<p style="text-align: center; color: #f00; font-size: 16px">Hello <b>bold <i>both</i></b>,
+<strong>strong</strong>, <em>em</em> and <a href="https://maroto.io">link</a><br>second line</p>
+text outside <span style="color: rgb(0, 0, 255)">blue</span>
+<ul><li>one</li><li style="font-weight: bold">two<ol start="3"><li>three</li><li>four</li></ol></li></ul>
+<table>
+  <thead><tr><th width="50%">Name</th><th>Qty</th><th>Price</th></tr></thead>
+  <tbody>
+    <tr><td>apple<br>red</td><td align="center">1</td><td style="text-align: right">2.00</td></tr>
+    <tr><td colspan="2">total</td><td><img src="docs/assets/images/biplane.jpg"></td></tr>
+  </tbody>
+</table>
+<div align="right"><img src="data:image/png;base64,AQID"></div>`
+
+func TestBuild(t *testing.T) {
+	t.Run("when content is empty, should return no rows and no warnings", func(t *testing.T) {
+		// Act
+		rows, warnings := html.Build("  \n ")
+
+		// Assert
+		assert.Empty(t, rows)
+		assert.Empty(t, warnings)
+	})
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		rows, warnings := html.Build(fragment)
+
+		// Assert
+		assert.Empty(t, warnings)
+		p := page.New().Add(rows...)
+		test.New(t).Assert(p.GetStructure()).Equals("components/html/build_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Arrange
+		textProp := fixture.TextProp()
+		cellProp := fixture.CellProp()
+		prop := props.HTML{
+			Paragraph:  textProp,
+			ListItem:   textProp,
+			ListIndent: 10,
+			TableText:  textProp,
+			TableCell:  &cellProp,
+			Image:      fixture.RectProp(),
+		}
+
+		// Act
+		rows, warnings := html.Build(fragment, prop)
+
+		// Assert
+		assert.Empty(t, warnings)
+		p := page.New().Add(rows...)
+		test.New(t).Assert(p.GetStructure()).Equals("components/html/build_custom_prop.json")
+	})
+	t.Run("when table has more columns than the grid, should keep the first columns", func(t *testing.T) {
+		// Act
+		rows, warnings := html.Build("<table><tr><td>1</td><td>2</td><td>3</td></tr></table>", props.HTML{GridSize: 2})
+
+		// Assert
+		assert.Equal(t, []string{"table has 3 columns, only the first 2 were kept"}, warnings)
+		assert.Len(t, rows[0].GetColumns(), 2)
+	})
+	t.Run("when content has unsupported elements and styles, should return warnings", func(t *testing.T) {
+		// Arrange
+		content := `<h1>Title</h1><script>alert("maroto")</script>
+<p style="margin: 2px; color: nocolor; font-size: big; text-align: middle">text</p>
+<a href="#anchor">internal</a><img><img src="https://maroto.io/logo.png"><img src="data:image/avif;base64,AQID">
+<img src="data:image/png;base64,???"><ul><p>paragraph</p></ul><li>item</li><ol start="a"><li>item</li></ol>
+<table><caption>caption</caption><tr><td rowspan="2" colspan="x" width="10px">cell</td><p>text</p></tr></table>
+<table><tr><td><ul><li>list</li></ul><table><tr><td>table</td></tr></table>text<img src="a.png"><img src="b.png"></td></tr></table>`
+
+		// Act
+		_, warnings := html.Build(content)
+
+		// Assert
+		assert.Equal(t, []string{
+			"unsupported element <h1>, its content was kept as text",
+			"unsupported element <script> was ignored",
+			"unsupported style property \"margin\" in <p>",
+			"invalid color \"nocolor\" in <p>",
+			"invalid font-size \"big\" in <p>",
+			"invalid text-align \"middle\" in <p>",
+			"internal link \"#anchor\" is not supported, its content was kept as text",
+			"image without src was ignored",
+			"remote image \"https://maroto.io/logo.png\" is not supported",
+			"image data \"data:image/avif;base64,AQID\" is not supported, only base64 png, jpeg, gif, bmp, tiff, webp and svg",
+			"image data \"data:image/png;base64,???\" is not valid base64",
+			"unsupported element <p> inside <ul>, its content was kept as text",
+			"unsupported element <li> outside of a list, its content was kept as text",
+			"invalid start \"a\" in <ol>",
+			"unsupported element <caption> inside a table was ignored",
+			"invalid colspan \"x\" in <td>",
+			"rowspan is not supported, <td> was rendered in a single row",
+			"invalid width \"10px\", only percentages are supported in <td>",
+			"unsupported element <ul> inside a table cell, its content was kept as text",
+			"unsupported element <table> inside a table cell, its content was kept as text",
+			"only one image is supported in a table cell, image \"b.png\" was ignored",
+			"text and image in the same <td> are not supported, the text was ignored",
+		}, warnings)
+	})
+}
@@ -0,0 +1,191 @@
+package html
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/johnfercher/maroto/v2/pkg/consts/align"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+const pixelToPoint = 0.75
+
+var namedColors = map[string]props.Color{
+	"black":  {Red: 0, Green: 0, Blue: 0},
+	"white":  {Red: 255, Green: 255, Blue: 255},
+	"red":    {Red: 255, Green: 0, Blue: 0},
+	"green":  {Red: 0, Green: 128, Blue: 0},
+	"blue":   {Red: 0, Green: 0, Blue: 255},
+	"gray":   {Red: 128, Green: 128, Blue: 128},
+	"grey":   {Red: 128, Green: 128, Blue: 128},
+	"yellow": {Red: 255, Green: 255, Blue: 0},
+	"orange": {Red: 255, Green: 165, Blue: 0},
+	"purple": {Red: 128, Green: 0, Blue: 128},
+}
+
+// style is the result of an inline style attribute, only the supported properties.
+type style struct {
+	color     *props.Color
+	fontSize  float64
+	textAlign align.Type
+	bold      bool
+	italic    bool
+	width     float64
+}
+
+// parseStyle parses the declarations of a style attribute, the unsupported ones
+// are returned as warnings.
+func parseStyle(value string, baseSize float64) (style, []string) {
+	var result style
+	var warnings []string
+
+	for _, declaration := range strings.Split(value, ";") {
+		property, content, found := strings.Cut(declaration, ":")
+		property = strings.ToLower(strings.TrimSpace(property))
+		content = strings.ToLower(strings.TrimSpace(content))
+		if !found || property == "" {
+			if strings.TrimSpace(declaration) != "" {
+				warnings = append(warnings, fmt.Sprintf("invalid style declaration %q", strings.TrimSpace(declaration)))
+			}
+			continue
+		}
+
+		var err error
+		switch property {
+		case "color":
+			result.color, err = parseColor(content)
+		case "font-size":
+			result.fontSize, err = parseFontSize(content, baseSize)
+		case "text-align":
+			result.textAlign, err = parseTextAlign(content)
+		case "font-weight":
+			weight, weightErr := strconv.Atoi(content)
+			result.bold = content == "bold" || content == "bolder" || weightErr == nil && weight >= 600
+		case "font-style":
+			result.italic = content == "italic" || content == "oblique"
+		case "width":
+			result.width, err = parsePercent(content)
+		default:
+			err = fmt.Errorf("unsupported style property %q", property)
+		}
+
+		if err != nil {
+			warnings = append(warnings, err.Error())
+		}
+	}
+
+	return result, warnings
+}
+
+func parseColor(value string) (*props.Color, error) {
+	if color, ok := namedColors[value]; ok {
+		return &color, nil
+	}
+
+	if strings.HasPrefix(value, "#") {
+		hex := value[1:]
+		if len(hex) == 3 {
+			hex = string([]byte{hex[0], hex[0], hex[1], hex[1], hex[2], hex[2]})
+		}
+
+		rgb, err := strconv.ParseUint(hex, 16, 32)
+		if len(hex) != 6 || err != nil {
+			return nil, fmt.Errorf("invalid color %q", value)
+		}
+
+		return &props.Color{Red: int(rgb >> 16), Green: int(rgb >> 8 & 0xff), Blue: int(rgb & 0xff)}, nil
+	}
+
+	if strings.HasPrefix(value, "rgb(") && strings.HasSuffix(value, ")") {
+		channels := strings.Split(value[len("rgb("):len(value)-1], ",")
+		if len(channels) == 3 {
+			var rgb [3]int
+			valid := true
+			for i, channel := range channels {
+				number, err := strconv.Atoi(strings.TrimSpace(channel))
+				valid = valid && err == nil && number >= 0 && number <= 255
+				rgb[i] = number
+			}
+
+			if valid {
+				return &props.Color{Red: rgb[0], Green: rgb[1], Blue: rgb[2]}, nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("invalid color %q", value)
+}
+
+// parseFontSize converts a font size to points, the relative units use the size
+// of the parent element.
+func parseFontSize(value string, baseSize float64) (float64, error) {
+	units := []struct {
+		suffix string
+		factor float64
+	}{
+		{"pt", 1},
+		{"px", pixelToPoint},
+		{"em", baseSize},
+		{"%", baseSize / 100},
+		{"", 1},
+	}
+
+	for _, unit := range units {
+		if !strings.HasSuffix(value, unit.suffix) {
+			continue
+		}
+
+		number, err := strconv.ParseFloat(strings.TrimSuffix(value, unit.suffix), 64)
+		if err != nil || number <= 0 {
+			break
+		}
+
+		return number * unit.factor, nil
+	}
+
+	return 0, fmt.Errorf("invalid font-size %q", value)
+}
+
+func parseTextAlign(value string) (align.Type, error) {
+	switch value {
+	case "left":
+		return align.Left, nil
+	case "center":
+		return align.Center, nil
+	case "right":
+		return align.Right, nil
+	case "justify":
+		return align.Justify, nil
+	default:
+		return "", fmt.Errorf("invalid text-align %q", value)
+	}
+}
+
+// parsePercent parses a width, only percentages are supported, as the cols are
+// sized relative to the row.
+func parsePercent(value string) (float64, error) {
+	number, err := strconv.ParseFloat(strings.TrimSuffix(value, "%"), 64)
+	if !strings.HasSuffix(value, "%") || err != nil || number <= 0 {
+		return 0, fmt.Errorf("invalid width %q, only percentages are supported", value)
+	}
+
+	return number, nil
+}
+
+func combineStyle(style fontstyle.Type, bold, italic bool) fontstyle.Type {
+	bold = bold || style == fontstyle.Bold || style == fontstyle.BoldItalic
+	italic = italic || style == fontstyle.Italic || style == fontstyle.BoldItalic
+
+	switch {
+	case bold && italic:
+		return fontstyle.BoldItalic
+	case bold:
+		return fontstyle.Bold
+	case italic:
+		return fontstyle.Italic
+	default:
+		return fontstyle.Normal
+	}
+}
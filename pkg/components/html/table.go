@@ -0,0 +1,271 @@
+package html
+
+import (
+	"math"
+	"sort"
+	"strconv"
+
+	xhtml "golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+
+	"github.com/johnfercher/maroto/v2/pkg/components/col"
+	"github.com/johnfercher/maroto/v2/pkg/components/richtext"
+	"github.com/johnfercher/maroto/v2/pkg/components/row"
+	"github.com/johnfercher/maroto/v2/pkg/consts/align"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+// tableCell is a td or th, span is its colspan and width its percentage of the table width.
+type tableCell struct {
+	node   *xhtml.Node
+	span   int
+	header bool
+	width  float64
+}
+
+// table converts a table into a row for each tr, the col sizes are derived from
+// the colspan and width of the cells.
+func (c *converter) table(n *xhtml.Node, span props.Span) {
+	tableRows := c.getTableRows(n)
+
+	columns := 0
+	for _, cells := range tableRows {
+		count := 0
+		for _, cell := range cells {
+			count += cell.span
+		}
+		columns = max(columns, count)
+	}
+
+	if columns == 0 {
+		return
+	}
+
+	if columns > c.prop.GridSize {
+		c.warn("table has %d columns, only the first %d were kept", columns, c.prop.GridSize)
+	}
+
+	sizes := distributeSizes(getColumnWidths(tableRows, columns), c.prop.GridSize)
+
+	for _, cells := range tableRows {
+		var cols []core.Col
+		position := 0
+		for _, cell := range cells {
+			if position >= len(sizes) {
+				break
+			}
+
+			size := 0
+			for i := position; i < position+cell.span && i < len(sizes); i++ {
+				size += sizes[i]
+			}
+			position += cell.span
+
+			cols = append(cols, c.newCol(cell, size, span))
+		}
+
+		c.rows = append(c.rows, row.New().Add(cols...))
+	}
+}
+
+func (c *converter) getTableRows(n *xhtml.Node) [][]tableCell {
+	var tableRows [][]tableCell
+
+	for child := n.FirstChild; child != nil; child = child.NextSibling {
+		switch {
+		case child.Type != xhtml.ElementNode:
+			continue
+		case child.DataAtom == atom.Thead || child.DataAtom == atom.Tbody || child.DataAtom == atom.Tfoot:
+			tableRows = append(tableRows, c.getTableRows(child)...)
+		case child.DataAtom == atom.Tr:
+			if cells := c.getTableCells(child); len(cells) > 0 {
+				tableRows = append(tableRows, cells)
+			}
+		default:
+			c.warn("unsupported element <%s> inside a table was ignored", child.Data)
+		}
+	}
+
+	return tableRows
+}
+
+func (c *converter) getTableCells(tr *xhtml.Node) []tableCell {
+	var cells []tableCell
+
+	for child := tr.FirstChild; child != nil; child = child.NextSibling {
+		if child.Type != xhtml.ElementNode {
+			continue
+		}
+
+		if child.DataAtom != atom.Td && child.DataAtom != atom.Th {
+			c.warn("unsupported element <%s> inside <tr> was ignored", child.Data)
+			continue
+		}
+
+		cell := tableCell{node: child, span: 1, header: child.DataAtom == atom.Th}
+
+		if value, ok := getAttribute(child, "colspan"); ok {
+			colspan, err := strconv.Atoi(value)
+			if err != nil || colspan < 1 {
+				c.warn("invalid colspan %q in <%s>", value, child.Data)
+			} else {
+				cell.span = colspan
+			}
+		}
+
+		if value, ok := getAttribute(child, "rowspan"); ok && value != "1" {
+			c.warn("rowspan is not supported, <%s> was rendered in a single row", child.Data)
+		}
+
+		if value, ok := getAttribute(child, "width"); ok {
+			width, err := parsePercent(value)
+			if err != nil {
+				c.warn("%s in <%s>", err.Error(), child.Data)
+			}
+			cell.width = width
+		}
+
+		if value, ok := getAttribute(child, "style"); ok {
+			if s, _ := parseStyle(value, 0); s.width > 0 {
+				cell.width = s.width
+			}
+		}
+
+		cells = append(cells, cell)
+	}
+
+	return cells
+}
+
+func (c *converter) newCol(cell tableCell, size int, span props.Span) core.Col {
+	cellSpan, s := c.applyStyle(cell.node, mergeSpan(span, toSpan(c.prop.TableText)))
+
+	p := &paragraph{text: c.prop.TableText, inCell: true}
+	if cell.header {
+		cellSpan.Style = combineStyle(cellSpan.Style, true, false)
+		p.text.Align = align.Center
+	}
+
+	if alignType := getAlign(cell.node, s); alignType != "" {
+		p.text.Align = alignType
+	}
+
+	c.children(cell.node, p, cellSpan, 0)
+
+	column := col.New(size)
+	switch {
+	case p.image != nil:
+		if hasContent(p.spans) {
+			c.warn("text and image in the same <%s> are not supported, the text was ignored", cell.node.Data)
+		}
+		column.Add(p.image)
+	case hasContent(p.spans):
+		column.Add(richtext.New(p.spans, toRichText(p.text)))
+	}
+
+	if c.prop.TableCell != nil {
+		column.WithStyle(c.prop.TableCell)
+	}
+
+	return column
+}
+
+// getColumnWidths returns the percentage of each column, the columns without width
+// share what was not defined by the others.
+func getColumnWidths(tableRows [][]tableCell, columns int) []float64 {
+	widths := make([]float64, columns)
+
+	for _, cells := range tableRows {
+		position := 0
+		for _, cell := range cells {
+			end := min(position+cell.span, columns)
+			if cell.width > 0 && allZero(widths[position:end]) {
+				for i := position; i < end; i++ {
+					widths[i] = cell.width / float64(cell.span)
+				}
+			}
+			position = end
+		}
+	}
+
+	defined, undefined := 0.0, 0
+	for _, width := range widths {
+		defined += width
+		if width == 0 {
+			undefined++
+		}
+	}
+
+	if undefined == 0 {
+		return widths
+	}
+
+	share := (100 - defined) / float64(undefined)
+	if share <= 0 {
+		share = 100 / float64(columns)
+	}
+
+	for i := range widths {
+		if widths[i] == 0 {
+			widths[i] = share
+		}
+	}
+
+	return widths
+}
+
+// distributeSizes converts the widths into grid sizes which sum the grid size, every
+// column has at least size 1, the columns that don't fit in the grid are removed.
+func distributeSizes(widths []float64, gridSize int) []int {
+	if len(widths) > gridSize {
+		widths = widths[:gridSize]
+	}
+
+	total := 0.0
+	for _, width := range widths {
+		total += width
+	}
+
+	sizes := make([]int, len(widths))
+	remainders := make([]float64, len(widths))
+	assigned := 0
+	for i, width := range widths {
+		exact := width / total * float64(gridSize)
+		sizes[i] = max(1, int(math.Floor(exact)))
+		remainders[i] = exact - float64(sizes[i])
+		assigned += sizes[i]
+	}
+
+	indexes := make([]int, len(widths))
+	for i := range indexes {
+		indexes[i] = i
+	}
+	sort.SliceStable(indexes, func(i, j int) bool {
+		return remainders[indexes[i]] > remainders[indexes[j]]
+	})
+
+	for i := 0; assigned < gridSize; i = (i + 1) % len(indexes) {
+		sizes[indexes[i]]++
+		assigned++
+	}
+
+	for i := len(indexes) - 1; assigned > gridSize; i = (i - 1 + len(indexes)) % len(indexes) {
+		if sizes[indexes[i]] > 1 {
+			sizes[indexes[i]]--
+			assigned--
+		}
+	}
+
+	return sizes
+}
+
+func allZero(values []float64) bool {
+	for _, value := range values {
+		if value != 0 {
+			return false
+		}
+	}
+
+	return true
+}
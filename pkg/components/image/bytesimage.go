@@ -80,7 +80,7 @@ func (b *BytesImage) GetStructure() *node.Node[core.Structure] {
 
 // GetHeight returns the height that the image will have in the PDF
 func (b *BytesImage) GetHeight(provider core.Provider, cell *entity.Cell) float64 {
-	dimensions, err := provider.GetDimensionsByImageByte(b.bytes, b.extension)
+	dimensions, err := provider.GetDimensionsByImageByte(b.bytes, b.extension, b.prop.Frame)
 	if err != nil {
 		return 0
 	}
@@ -9,6 +9,7 @@ import (
 	"github.com/johnfercher/maroto/v2/pkg/components/image"
 	"github.com/johnfercher/maroto/v2/pkg/consts/extension"
 	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
 	"github.com/johnfercher/maroto/v2/pkg/test"
 	"github.com/stretchr/testify/assert"
 )
@@ -120,7 +121,7 @@ func TestBytesImage_GetHeight(t *testing.T) {
 		img := fixture.ImageEntity()
 
 		provider := mocks.NewProvider(t)
-		provider.EXPECT().GetDimensionsByImageByte(img.Bytes, img.Extension).Return(nil, errors.New("anyError2"))
+		provider.EXPECT().GetDimensionsByImageByte(img.Bytes, img.Extension, 0).Return(nil, errors.New("anyError2"))
 
 		sut := image.NewFromBytes(img.Bytes, img.Extension)
 
@@ -134,7 +135,7 @@ func TestBytesImage_GetHeight(t *testing.T) {
 		img := fixture.ImageEntity()
 
 		provider := mocks.NewProvider(t)
-		provider.EXPECT().GetDimensionsByImageByte(img.Bytes, img.Extension).Return(&entity.Dimensions{Width: 10, Height: 5}, nil)
+		provider.EXPECT().GetDimensionsByImageByte(img.Bytes, img.Extension, 0).Return(&entity.Dimensions{Width: 10, Height: 5}, nil)
 
 		sut := image.NewFromBytes(img.Bytes, img.Extension)
 
@@ -142,4 +143,17 @@ func TestBytesImage_GetHeight(t *testing.T) {
 		height := sut.GetHeight(provider, &cell)
 		assert.Equal(t, height, cell.Width/2)
 	})
+	t.Run("When the image has a frame, should measure the frame", func(t *testing.T) {
+		cell := fixture.CellEntity()
+		img := fixture.ImageEntity()
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().GetDimensionsByImageByte(img.Bytes, img.Extension, 1).Return(&entity.Dimensions{Width: 10, Height: 20}, nil)
+
+		sut := image.NewFromBytes(img.Bytes, img.Extension, props.Rect{Frame: 1})
+
+		// Act
+		height := sut.GetHeight(provider, &cell)
+		assert.Equal(t, height, cell.Width*2)
+	})
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/johnfercher/maroto/v2/pkg/components/col"
 	"github.com/johnfercher/maroto/v2/pkg/components/image"
 	"github.com/johnfercher/maroto/v2/pkg/consts/extension"
+	"github.com/johnfercher/maroto/v2/pkg/props"
 )
 
 // ExampleNewFromBytes demonstrates how to create an image component reading bytes.
@@ -75,3 +76,28 @@ func ExampleNewFromFileRow() {
 	m.AddRows(imageRow)
 	// generate document
 }
+
+// ExampleNewFromFile_svg demonstrates how to create an image component from a svg file, which is drawn as vectors.
+func ExampleNewFromFile_svg() {
+	m := maroto.New()
+
+	image := image.NewFromFile("image.svg")
+	col := col.New(12).Add(image)
+	m.AddAutoRow(col)
+
+	// generate document
+}
+
+// ExampleNewFromBytes_tiff demonstrates how to create an image component from a page of a multi-page tiff,
+// which is converted to png.
+func ExampleNewFromBytes_tiff() {
+	m := maroto.New()
+
+	bytes, _ := os.ReadFile("scan.tiff")
+
+	image := image.NewFromBytes(bytes, extension.Tiff, props.Rect{Frame: 1})
+	col := col.New(12).Add(image)
+	m.AddAutoRow(col)
+
+	// generate document
+}
@@ -69,7 +69,7 @@ func (f *FileImage) GetStructure() *node.Node[core.Structure] {
 
 // GetHeight returns the height that the image will have in the PDF
 func (f *FileImage) GetHeight(provider core.Provider, cell *entity.Cell) float64 {
-	dimensions, err := provider.GetDimensionsByImage(f.path)
+	dimensions, err := provider.GetDimensionsByImage(f.path, f.prop.Frame)
 	if err != nil {
 		return 0.0
 	}
@@ -117,7 +117,7 @@ func TestFileImage_GetHeight(t *testing.T) {
 		cell := fixture.CellEntity()
 
 		provider := mocks.NewProvider(t)
-		provider.EXPECT().GetDimensionsByImage("path").Return(nil, errors.New("anyError2"))
+		provider.EXPECT().GetDimensionsByImage("path", 0).Return(nil, errors.New("anyError2"))
 
 		sut := image.NewFromFile("path")
 
@@ -130,7 +130,7 @@ func TestFileImage_GetHeight(t *testing.T) {
 		cell := fixture.CellEntity()
 
 		provider := mocks.NewProvider(t)
-		provider.EXPECT().GetDimensionsByImage("path").Return(&entity.Dimensions{Width: 10, Height: 5}, nil)
+		provider.EXPECT().GetDimensionsByImage("path", 0).Return(&entity.Dimensions{Width: 10, Height: 5}, nil)
 
 		sut := image.NewFromFile("path")
 
@@ -0,0 +1,100 @@
+package index
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/johnfercher/go-tree/node"
+
+	"github.com/johnfercher/maroto/v2/pkg/components/text"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+// Entry is a term of an Index followed by the pages where it is marked.
+type Entry struct {
+	term    string
+	anchors []string
+	prop    props.Index
+	config  *entity.Config
+}
+
+// GetStructure returns the Structure of an Entry.
+func (e *Entry) GetStructure() *node.Node[core.Structure] {
+	details := e.prop.ToMap()
+	details["marks"] = len(e.anchors)
+
+	str := core.Structure{
+		Type:    "index_entry",
+		Value:   e.term,
+		Details: details,
+	}
+
+	return node.New(str)
+}
+
+// GetHeight returns the height of the text of the Entry.
+func (e *Entry) GetHeight(provider core.Provider, cell *entity.Cell) float64 {
+	return e.getText(provider).GetHeight(provider, cell)
+}
+
+// SetConfig sets the config.
+func (e *Entry) SetConfig(config *entity.Config) {
+	e.config = config
+	e.prop.MakeValid(config.DefaultFont)
+}
+
+// Render renders the text of the Entry into a PDF context.
+func (e *Entry) Render(provider core.Provider, cell *entity.Cell) {
+	e.getText(provider).Render(provider, cell)
+}
+
+// getText returns the term followed by the pages of its marks, where consecutive pages are merged
+// in ranges, ex: "term, 12–14, 30".
+func (e *Entry) getText(provider core.Provider) core.Component {
+	var pages []int
+	unknown := 0
+	for _, anchor := range e.anchors {
+		if page, ok := provider.GetAnchorPage(anchor); ok {
+			pages = append(pages, page)
+		} else {
+			unknown++
+		}
+	}
+
+	values := append([]string{e.term}, mergePages(pages, e.prop.RangeSeparator)...)
+	for ; unknown > 0; unknown-- {
+		values = append(values, core.UnknownPage)
+	}
+
+	t := text.New(strings.Join(values, ", "), e.prop.ToTextProp())
+	t.SetConfig(e.config)
+
+	return t
+}
+
+// mergePages returns the sorted pages without repetition, where consecutive pages are written
+// as a range from the first to the last.
+func mergePages(pages []int, separator string) []string {
+	sort.Ints(pages)
+
+	var ranges []string
+	for i := 0; i < len(pages); {
+		last := i
+		for last+1 < len(pages) && pages[last+1]-pages[last] <= 1 {
+			last++
+		}
+
+		if pages[last] == pages[i] {
+			ranges = append(ranges, strconv.Itoa(pages[i]))
+		} else {
+			ranges = append(ranges, strconv.Itoa(pages[i])+separator+strconv.Itoa(pages[last]))
+		}
+
+		i = last + 1
+	}
+
+	return ranges
+}
@@ -0,0 +1,24 @@
+package index_test
+
+import (
+	"github.com/johnfercher/maroto/v2"
+	"github.com/johnfercher/maroto/v2/pkg/components/col"
+	"github.com/johnfercher/maroto/v2/pkg/components/index"
+	"github.com/johnfercher/maroto/v2/pkg/components/text"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+// ExampleIndex_GetRows demonstrates how to create a back-of-book index.
+func ExampleIndex_GetRows() {
+	m := maroto.New()
+
+	idx := index.New(props.Index{VerticalPadding: 2})
+
+	m.AddRow(10, col.New(12).Add(idx.Mark("pump"), text.New("The pump moves the water.")))
+	m.AddRow(10, col.New(12).Add(idx.Mark("pump", "valve"), text.New("The valve controls the pump.")))
+
+	m.AddRows(text.NewRow(10, "Index"))
+	m.AddRows(idx.GetRows()...)
+
+	// generate document
+}
@@ -0,0 +1,119 @@
+// Package index implements creation of back-of-book indexes.
+package index
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/google/uuid"
+
+	"github.com/johnfercher/maroto/v2/pkg/components/col"
+	"github.com/johnfercher/maroto/v2/pkg/components/row"
+	"github.com/johnfercher/maroto/v2/pkg/components/text"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+// otherInitial is the group of the terms which do not start with a letter.
+const otherInitial = "#"
+
+// Index is a back-of-book index, it lists the terms marked in the document sorted alphabetically,
+// each one with the pages where it is marked.
+type Index struct {
+	id    string
+	marks int
+	terms map[string][]string
+	prop  props.Index
+}
+
+// New is responsible to create an instance of an Index.
+func New(ps ...props.Index) *Index {
+	prop := props.Index{}
+	if len(ps) > 0 {
+		prop = ps[0]
+	}
+
+	return &Index{
+		id:    uuid.NewString(),
+		terms: make(map[string][]string),
+		prop:  prop,
+	}
+}
+
+// Mark is responsible to mark terms in the page where the returned component is rendered, it
+// should be added to the col of the text which mentions the terms. The terms must be marked
+// before the rows of the index are added to the document, since they create its entries.
+func (i *Index) Mark(terms ...string) core.Component {
+	anchor := fmt.Sprintf("index-%s-%d", i.id, i.marks)
+	i.marks++
+
+	var marked []string
+	for _, term := range terms {
+		term = strings.TrimSpace(term)
+		if term == "" {
+			continue
+		}
+
+		i.terms[term] = append(i.terms[term], anchor)
+		marked = append(marked, term)
+	}
+
+	return &Mark{
+		terms:  marked,
+		anchor: anchor,
+	}
+}
+
+// GetRows returns the rows of the index, the terms are sorted alphabetically and grouped by their
+// first letter, each group starts with a row with the letter.
+func (i *Index) GetRows() []core.Row {
+	terms := make([]string, 0, len(i.terms))
+	for term := range i.terms {
+		terms = append(terms, term)
+	}
+
+	sort.Slice(terms, func(a, b int) bool {
+		lowerA, lowerB := strings.ToLower(terms[a]), strings.ToLower(terms[b])
+		if lowerA != lowerB {
+			return lowerA < lowerB
+		}
+		return terms[a] < terms[b]
+	})
+
+	var rows []core.Row
+	group := ""
+	for _, term := range terms {
+		if initial := getInitial(term); initial != group {
+			group = initial
+			rows = append(rows, text.NewAutoRow(initial, props.Text{
+				Family: i.prop.Family,
+				Style:  fontstyle.Bold,
+				Size:   i.prop.Size,
+				Color:  i.prop.Color,
+				Top:    i.prop.VerticalPadding,
+			}))
+		}
+
+		entry := &Entry{
+			term:    term,
+			anchors: i.terms[term],
+			prop:    i.prop,
+		}
+		rows = append(rows, row.New().Add(col.New().Add(entry)))
+	}
+
+	return rows
+}
+
+// getInitial returns the upper case first letter of a term, or the other group when it is not a letter.
+func getInitial(term string) string {
+	initial := []rune(term)[0]
+	if !unicode.IsLetter(initial) {
+		return otherInitial
+	}
+
+	return string(unicode.ToUpper(initial))
+}
@@ -0,0 +1,141 @@
+package index_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/internal/providers/locator"
+	"github.com/johnfercher/maroto/v2/mocks"
+	"github.com/johnfercher/maroto/v2/pkg/components/index"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+	"github.com/johnfercher/maroto/v2/pkg/test"
+)
+
+func TestIndex_GetRows(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Arrange
+		sut := index.New()
+		sut.Mark("valve", "Pump")
+		sut.Mark("pump")
+
+		// Act
+		rows := sut.GetRows()
+
+		// Assert
+		assert.Len(t, rows, 5)
+		test.New(t).Assert(rows[0].GetStructure()).Equals("components/indexes/get_rows_group.json")
+		test.New(t).Assert(rows[1].GetStructure()).Equals("components/indexes/get_rows_default_prop.json")
+		assert.Equal(t, "pump", rows[2].GetStructure().GetNexts()[0].GetNexts()[0].GetData().Value)
+		assert.Equal(t, "V", rows[3].GetStructure().GetNexts()[0].GetNexts()[0].GetData().Value)
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Arrange
+		sut := index.New(fixture.IndexProp())
+		sut.Mark("pump")
+
+		// Act
+		rows := sut.GetRows()
+
+		// Assert
+		assert.Len(t, rows, 2)
+		test.New(t).Assert(rows[1].GetStructure()).Equals("components/indexes/get_rows_custom_prop.json")
+	})
+	t.Run("when terms do not start with a letter, should group them together", func(t *testing.T) {
+		// Arrange
+		sut := index.New()
+		sut.Mark("2-stroke", "#include", " ")
+
+		// Act
+		rows := sut.GetRows()
+
+		// Assert
+		assert.Len(t, rows, 3)
+		assert.Equal(t, "#", rows[0].GetStructure().GetNexts()[0].GetNexts()[0].GetData().Value)
+	})
+	t.Run("when there are no marks, should return no rows", func(t *testing.T) {
+		// Arrange
+		sut := index.New()
+
+		// Act
+		rows := sut.GetRows()
+
+		// Assert
+		assert.Empty(t, rows)
+	})
+}
+
+func TestIndex_Mark(t *testing.T) {
+	t.Run("should mark the terms without empty ones", func(t *testing.T) {
+		// Arrange
+		sut := index.New()
+
+		// Act
+		mark := sut.Mark("pump", "", " valve ")
+
+		// Assert
+		test.New(t).Assert(mark.GetStructure()).Equals("components/indexes/mark.json")
+	})
+}
+
+func TestEntry_Render(t *testing.T) {
+	t.Run("should write the pages of the marks merging consecutive pages", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		font := fixture.FontProp()
+		config := &entity.Config{DefaultFont: &font, MaxGridSize: 12}
+		prop := props.Text{}
+		prop.MakeValid(&font)
+
+		sut := index.New()
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().AddText("pump, 1–3, 5", &cell, &prop)
+
+		located := locator.New(provider)
+		for _, page := range []int{3, 1, 2, 5, 2} {
+			located.SetPage(page)
+			sut.Mark("pump").Render(located, &cell)
+		}
+
+		entry := sut.GetRows()[1]
+		entry.SetConfig(config)
+
+		// Act
+		entry.GetColumns()[0].Render(located.Resolve(), cell, false)
+
+		// Assert
+		provider.AssertNumberOfCalls(t, "AddText", 1)
+	})
+}
+
+func TestEntry_GetHeight(t *testing.T) {
+	t.Run("when pages are unknown, should measure the text with three digits pages", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		font := fixture.FontProp()
+		config := &entity.Config{DefaultFont: &font, MaxGridSize: 12}
+		prop := props.Text{}
+		prop.MakeValid(&font)
+
+		sut := index.New()
+		sut.Mark("pump")
+		sut.Mark("pump")
+		entry := sut.GetRows()[1]
+		entry.SetConfig(config)
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().GetAnchorPage(mock.Anything).Return(0, false)
+		provider.EXPECT().GetLinesQuantity("pump, 000, 000", &prop, 100.0).Return(2)
+		provider.EXPECT().GetFontHeight(&props.Font{Family: font.Family, Style: font.Style, Size: font.Size, Color: font.Color}).
+			Return(5.0)
+
+		// Act
+		height := entry.GetHeight(provider, &cell)
+
+		// Assert
+		assert.Equal(t, 10.0, height)
+	})
+}
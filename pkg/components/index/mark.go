@@ -0,0 +1,42 @@
+package index
+
+import (
+	"strings"
+
+	"github.com/johnfercher/go-tree/node"
+
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+)
+
+// Mark marks the page where terms of an Index are mentioned.
+type Mark struct {
+	terms  []string
+	anchor string
+	config *entity.Config
+}
+
+// GetStructure returns the Structure of a Mark.
+func (m *Mark) GetStructure() *node.Node[core.Structure] {
+	str := core.Structure{
+		Type:  "index_mark",
+		Value: strings.Join(m.terms, ", "),
+	}
+
+	return node.New(str)
+}
+
+// GetHeight returns the height of a Mark, which does not take space in the PDF.
+func (m *Mark) GetHeight(provider core.Provider, cell *entity.Cell) float64 {
+	return 0
+}
+
+// SetConfig sets the config.
+func (m *Mark) SetConfig(config *entity.Config) {
+	m.config = config
+}
+
+// Render adds the anchor of the Mark, so the Index knows the page where it is rendered.
+func (m *Mark) Render(provider core.Provider, cell *entity.Cell) {
+	provider.AddAnchor(m.anchor, cell)
+}
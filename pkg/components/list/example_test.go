@@ -2,6 +2,7 @@ package list_test
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/johnfercher/maroto/v2"
 	"github.com/johnfercher/maroto/v2/pkg/components/list"
@@ -127,3 +128,24 @@ func ExampleBuildFromPointer() {
 
 	// generate document
 }
+
+// ExampleBuildFromTags demonstrates how to create a table from the maroto tags of a struct.
+func ExampleBuildFromTags() {
+	type Invoice struct {
+		ID      int       `maroto:"header=#,size=2,align=C"`
+		DueDate time.Time `maroto:"header=Due date,size=4,format=02/01/2006"`
+		Amount  float64   `maroto:"header=Amount,size=6,align=R,format=%.2f"`
+	}
+
+	invoices := []Invoice{
+		{ID: 1, DueDate: time.Now(), Amount: 10.5},
+		{ID: 2, DueDate: time.Now(), Amount: 1234},
+	}
+
+	rows, _ := list.BuildFromTags(invoices)
+
+	m := maroto.New()
+	m.AddRows(rows...)
+
+	// generate document
+}
@@ -0,0 +1,248 @@
+package list
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/johnfercher/maroto/v2/pkg/components/table"
+	"github.com/johnfercher/maroto/v2/pkg/consts/align"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+const (
+	tagName           = "maroto"
+	defaultTimeLayout = "2006-01-02"
+)
+
+var (
+	timeType     = reflect.TypeOf(time.Time{})
+	stringerType = reflect.TypeOf((*fmt.Stringer)(nil)).Elem()
+)
+
+// tagField is a struct field, maybe nested in other structs, that becomes a column.
+type tagField struct {
+	path   []int
+	column table.Column
+	format string
+}
+
+// BuildFromTags is responsible to receive a collection of structs, or pointers to structs,
+// and build the rows of a table.Table from the `maroto` tags of its fields.
+//
+// The tag is a comma separated list of options, e.g. `maroto:"header=Amount,size=2,align=R,format=%.2f"`:
+//   - header: the column title, by default the field name.
+//   - size: the column grid size, it is required.
+//   - align: the column alignment, L, C, R or J.
+//   - format: a fmt verb to format the value, or a layout when the field is a time.Time
+//     (default 2006-01-02). It must be the last option, as it may contain commas.
+//
+// Fields without tag are ignored, unless they are structs or pointers to structs,
+// in this case their tagged fields are added as columns. The tag `maroto:"-"` ignores a field.
+// Nil pointers are written as empty values.
+func BuildFromTags[T any](arr []T, ps ...props.Table) ([]core.Row, error) {
+	if len(arr) == 0 {
+		return nil, errors.New("empty array")
+	}
+
+	typ := reflect.TypeOf(arr).Elem()
+	if typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+
+	if typ.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("type %s is not a struct", typ)
+	}
+
+	fields, err := getTagFields(typ, nil, map[reflect.Type]bool{})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("type %s has no field with maroto tag", typ)
+	}
+
+	var columns []table.Column
+	for _, field := range fields {
+		columns = append(columns, field.column)
+	}
+
+	tbl := table.New(columns, ps...)
+	for i, element := range arr {
+		value := reflect.ValueOf(element)
+		if value.Kind() == reflect.Pointer {
+			if value.IsNil() {
+				return nil, fmt.Errorf("nil element in array at index %d", i)
+			}
+			value = value.Elem()
+		}
+
+		var values []string
+		for _, field := range fields {
+			values = append(values, field.getValue(value))
+		}
+		tbl.Add(values)
+	}
+
+	return tbl.Build()
+}
+
+func getTagFields(typ reflect.Type, path []int, visiting map[reflect.Type]bool) ([]tagField, error) {
+	if visiting[typ] {
+		return nil, fmt.Errorf("type %s is recursive", typ)
+	}
+
+	visiting[typ] = true
+	defer delete(visiting, typ)
+
+	var fields []tagField
+	for i := 0; i < typ.NumField(); i++ {
+		structField := typ.Field(i)
+		tag, tagged := structField.Tag.Lookup(tagName)
+		if !structField.IsExported() || tag == "-" {
+			continue
+		}
+
+		fieldPath := append(append([]int{}, path...), i)
+		fieldType := structField.Type
+		if fieldType.Kind() == reflect.Pointer {
+			fieldType = fieldType.Elem()
+		}
+
+		if !tagged {
+			if fieldType.Kind() != reflect.Struct || isLeafType(fieldType) {
+				continue
+			}
+
+			nested, err := getTagFields(fieldType, fieldPath, visiting)
+			if err != nil {
+				return nil, err
+			}
+
+			fields = append(fields, nested...)
+			continue
+		}
+
+		if !isLeafType(fieldType) {
+			return nil, fmt.Errorf("field %s has unsupported type %s", structField.Name, structField.Type)
+		}
+
+		field, err := parseTag(structField.Name, tag)
+		if err != nil {
+			return nil, err
+		}
+
+		field.path = fieldPath
+		fields = append(fields, field)
+	}
+
+	return fields, nil
+}
+
+func isLeafType(typ reflect.Type) bool {
+	if typ == timeType || typ.Implements(stringerType) || reflect.PointerTo(typ).Implements(stringerType) {
+		return true
+	}
+
+	switch typ.Kind() {
+	case reflect.String, reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	default:
+		return false
+	}
+}
+
+func parseTag(name, tag string) (tagField, error) {
+	field := tagField{
+		column: table.Column{Title: name},
+	}
+
+	options := tag
+	if index := strings.Index(tag, "format="); index >= 0 {
+		field.format = tag[index+len("format="):]
+		options = strings.TrimSuffix(tag[:index], ",")
+	}
+
+	for _, option := range strings.Split(options, ",") {
+		if option == "" {
+			continue
+		}
+
+		key, value, _ := strings.Cut(option, "=")
+		switch key {
+		case "header":
+			field.column.Title = value
+		case "size":
+			size, err := strconv.Atoi(value)
+			if err != nil || size <= 0 {
+				return tagField{}, fmt.Errorf("field %s has invalid size %q", name, value)
+			}
+			field.column.Size = size
+		case "align":
+			alignType := align.Type(value)
+			if alignType != align.Left && alignType != align.Center && alignType != align.Right && alignType != align.Justify {
+				return tagField{}, fmt.Errorf("field %s has invalid align %q", name, value)
+			}
+			field.column.Align = alignType
+		default:
+			return tagField{}, fmt.Errorf("field %s has unknown tag option %q", name, key)
+		}
+	}
+
+	if field.column.Size == 0 {
+		return tagField{}, fmt.Errorf("field %s must define a size", name)
+	}
+
+	return field, nil
+}
+
+// getValue walks the field path from the element and formats the value,
+// a nil pointer in the way results in an empty value.
+func (f tagField) getValue(element reflect.Value) string {
+	value := element
+	for _, index := range f.path {
+		if value.Kind() == reflect.Pointer {
+			if value.IsNil() {
+				return ""
+			}
+			value = value.Elem()
+		}
+		value = value.Field(index)
+	}
+
+	if value.Kind() == reflect.Pointer {
+		if value.IsNil() {
+			return ""
+		}
+		value = value.Elem()
+	}
+
+	if value.Type() == timeType {
+		layout := f.format
+		if layout == "" {
+			layout = defaultTimeLayout
+		}
+		return value.Interface().(time.Time).Format(layout)
+	}
+
+	if f.format != "" {
+		return fmt.Sprintf(f.format, value.Interface())
+	}
+
+	// Stringer implemented by the pointer receiver needs an addressable value.
+	if !value.Type().Implements(stringerType) && reflect.PointerTo(value.Type()).Implements(stringerType) {
+		pointer := reflect.New(value.Type())
+		pointer.Elem().Set(value)
+		return pointer.Interface().(fmt.Stringer).String()
+	}
+
+	return fmt.Sprint(value.Interface())
+}
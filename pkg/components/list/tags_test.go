@@ -0,0 +1,205 @@
+package list_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnfercher/maroto/v2/pkg/components/list"
+	"github.com/johnfercher/maroto/v2/pkg/components/page"
+	"github.com/johnfercher/maroto/v2/pkg/test"
+)
+
+type customer struct {
+	Name string `maroto:"header=Customer,size=3"`
+}
+
+type status int
+
+func (s status) String() string {
+	if s == 0 {
+		return "open"
+	}
+
+	return "paid"
+}
+
+type invoice struct {
+	ID       int `maroto:"header=#,size=1,align=C"`
+	Customer *customer
+	Amount   float64    `maroto:"header=Amount,size=2,align=R,format=%.2f"`
+	Discount *float64   `maroto:"size=2,align=R,format=%.1f%%"`
+	DueDate  time.Time  `maroto:"header=Due,size=2"`
+	PaidAt   *time.Time `maroto:"header=Paid,size=1,format=Jan 2, 2006"`
+	Status   status     `maroto:"size=1"`
+	Internal string     `maroto:"-"`
+	ignored  string
+}
+
+func TestBuildFromTags(t *testing.T) {
+	t.Run("when arr is empty, should return error", func(t *testing.T) {
+		// Act
+		r, err := list.BuildFromTags[invoice](nil)
+
+		// Assert
+		assert.NotNil(t, err)
+		assert.Nil(t, r)
+	})
+	t.Run("when type is not a struct, should return error", func(t *testing.T) {
+		// Act
+		r, err := list.BuildFromTags([]string{"value"})
+
+		// Assert
+		assert.Equal(t, "type string is not a struct", err.Error())
+		assert.Nil(t, r)
+	})
+	t.Run("when type has no tagged field, should return error", func(t *testing.T) {
+		// Act
+		r, err := list.BuildFromTags([]anyType{{Key: "key"}})
+
+		// Assert
+		assert.Equal(t, "type list_test.anyType has no field with maroto tag", err.Error())
+		assert.Nil(t, r)
+	})
+	t.Run("when field type is not supported, should return error", func(t *testing.T) {
+		// Arrange
+		type unsupported struct {
+			Items []string `maroto:"size=2"`
+		}
+
+		// Act
+		r, err := list.BuildFromTags([]unsupported{{}})
+
+		// Assert
+		assert.Equal(t, "field Items has unsupported type []string", err.Error())
+		assert.Nil(t, r)
+	})
+	t.Run("when size is not defined, should return error", func(t *testing.T) {
+		// Arrange
+		type withoutSize struct {
+			Name string `maroto:"header=Name"`
+		}
+
+		// Act
+		r, err := list.BuildFromTags([]withoutSize{{}})
+
+		// Assert
+		assert.Equal(t, "field Name must define a size", err.Error())
+		assert.Nil(t, r)
+	})
+	t.Run("when size is invalid, should return error", func(t *testing.T) {
+		// Arrange
+		type invalidSize struct {
+			Name string `maroto:"size=big"`
+		}
+
+		// Act
+		r, err := list.BuildFromTags([]invalidSize{{}})
+
+		// Assert
+		assert.Equal(t, "field Name has invalid size \"big\"", err.Error())
+		assert.Nil(t, r)
+	})
+	t.Run("when align is invalid, should return error", func(t *testing.T) {
+		// Arrange
+		type invalidAlign struct {
+			Name string `maroto:"size=2,align=X"`
+		}
+
+		// Act
+		r, err := list.BuildFromTags([]invalidAlign{{}})
+
+		// Assert
+		assert.Equal(t, "field Name has invalid align \"X\"", err.Error())
+		assert.Nil(t, r)
+	})
+	t.Run("when option is unknown, should return error", func(t *testing.T) {
+		// Arrange
+		type unknownOption struct {
+			Name string `maroto:"size=2,color=red"`
+		}
+
+		// Act
+		r, err := list.BuildFromTags([]unknownOption{{}})
+
+		// Assert
+		assert.Equal(t, "field Name has unknown tag option \"color\"", err.Error())
+		assert.Nil(t, r)
+	})
+	t.Run("when type is recursive, should return error", func(t *testing.T) {
+		// Arrange
+		type node struct {
+			Name string `maroto:"size=2"`
+			Next *node
+		}
+
+		// Act
+		r, err := list.BuildFromTags([]node{{}})
+
+		// Assert
+		assert.NotNil(t, err)
+		assert.Nil(t, r)
+	})
+	t.Run("when arr has a nil element, should return error", func(t *testing.T) {
+		// Arrange
+		arr := buildInvoices()
+		pointers := []*invoice{&arr[0], nil}
+
+		// Act
+		r, err := list.BuildFromTags(pointers)
+
+		// Assert
+		assert.Equal(t, "nil element in array at index 1", err.Error())
+		assert.Nil(t, r)
+	})
+	t.Run("when arr is valid, should return rows", func(t *testing.T) {
+		// Arrange
+		arr := buildInvoices()
+
+		// Act
+		r, err := list.BuildFromTags(arr)
+		p := page.New().Add(r...)
+
+		// Assert
+		assert.Nil(t, err)
+		test.New(t).Assert(p.GetStructure()).Equals("components/list/build_from_tags.json")
+	})
+	t.Run("when arr of pointers is valid, should return the same rows", func(t *testing.T) {
+		// Arrange
+		arr := buildInvoices()
+		pointers := []*invoice{&arr[0], &arr[1]}
+
+		// Act
+		r, err := list.BuildFromTags(pointers)
+		p := page.New().Add(r...)
+
+		// Assert
+		assert.Nil(t, err)
+		test.New(t).Assert(p.GetStructure()).Equals("components/list/build_from_tags.json")
+	})
+}
+
+func buildInvoices() []invoice {
+	discount := 12.5
+	paidAt := time.Date(2024, time.March, 5, 0, 0, 0, 0, time.UTC)
+
+	return []invoice{
+		{
+			ID:       1,
+			Customer: &customer{Name: "John"},
+			Amount:   10.5,
+			Discount: &discount,
+			DueDate:  time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC),
+			PaidAt:   &paidAt,
+			Status:   1,
+			Internal: "internal",
+		},
+		{
+			ID:      2,
+			Amount:  1234,
+			DueDate: time.Date(2024, time.April, 1, 0, 0, 0, 0, time.UTC),
+			ignored: "ignored",
+		},
+	}
+}
@@ -0,0 +1,28 @@
+package markdown_test
+
+import (
+	"github.com/johnfercher/maroto/v2"
+	"github.com/johnfercher/maroto/v2/pkg/components/markdown"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+// ExampleBuild demonstrates how to convert a markdown document into rows.
+func ExampleBuild() {
+	m := maroto.New()
+
+	content := `# Release notes
+
+Maroto now converts **markdown** into rows, see the [docs](https://maroto.io).
+
+- headings, paragraphs and lists
+- links and ` + "`inline code`" + `
+`
+
+	rows := markdown.Build(content, props.Markdown{
+		Heading1: props.Text{Size: 20, Style: fontstyle.Bold, Bottom: 5},
+	})
+	m.AddRows(rows...)
+
+	// generate document
+}
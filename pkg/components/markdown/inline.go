@@ -0,0 +1,194 @@
+package markdown
+
+import (
+	"strings"
+
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+// inline converts the inline markdown of a block into spans, supporting emphasis,
+// inline code, links and backslash escapes. Inline images are written as their alt text.
+type inline struct {
+	base   props.Span
+	code   props.Span
+	spans  []entity.Span
+	buffer strings.Builder
+	bold   bool
+	italic bool
+}
+
+func parseInline(text string, base, code props.Span) []entity.Span {
+	i := &inline{base: base, code: code}
+	i.parse(text)
+	i.flush()
+	return i.spans
+}
+
+func (i *inline) parse(text string) {
+	for position := 0; position < len(text); {
+		c := text[position]
+
+		switch {
+		case c == '\\' && position+1 < len(text) && strings.IndexByte(escapable, text[position+1]) >= 0:
+			i.buffer.WriteByte(text[position+1])
+			position += 2
+		case c == '`':
+			position += i.parseCode(text[position:])
+		case c == '!' && strings.HasPrefix(text[position+1:], "["):
+			label, _, size, ok := parseLink(text[position+1:])
+			if !ok {
+				i.buffer.WriteByte(c)
+				position++
+				continue
+			}
+			i.buffer.WriteString(label)
+			position += size + 1
+		case c == '[':
+			position += i.parseLink(text[position:])
+		case c == '*' || c == '_':
+			position += i.parseEmphasis(text, position)
+		default:
+			i.buffer.WriteByte(c)
+			position++
+		}
+	}
+}
+
+const escapable = "\\`*_{}[]()#+-.!~|<>\""
+
+func (i *inline) parseCode(text string) int {
+	end := strings.IndexByte(text[1:], '`')
+	if end < 0 {
+		i.buffer.WriteByte('`')
+		return 1
+	}
+
+	i.flush()
+	i.spans = append(i.spans, entity.Span{Value: text[1 : end+1], Prop: i.code})
+	return end + 2
+}
+
+func (i *inline) parseLink(text string) int {
+	label, url, size, ok := parseLink(text)
+	if !ok {
+		i.buffer.WriteByte('[')
+		return 1
+	}
+
+	i.flush()
+	for _, span := range parseInline(label, i.current(), i.code) {
+		hyperlink := url
+		span.Prop.Hyperlink = &hyperlink
+		i.spans = append(i.spans, span)
+	}
+
+	return size
+}
+
+// parseEmphasis handles a run of * or _, one character toggles italic, two toggle
+// bold and three both. A run that can't open or close an emphasis is kept as text.
+func (i *inline) parseEmphasis(text string, position int) int {
+	delimiter := text[position]
+	size := 1
+	for position+size < len(text) && text[position+size] == delimiter {
+		size++
+	}
+
+	run := text[position : position+size]
+	before := position > 0 && isAlphanumeric(text[position-1])
+	after := position+size < len(text) && isAlphanumeric(text[position+size])
+	if delimiter == '_' && before && after {
+		i.buffer.WriteString(run)
+		return size
+	}
+
+	bold, italic := size >= 2, size != 2
+	closing := (!bold || i.bold) && (!italic || i.italic) && position > 0 && text[position-1] != ' '
+	opening := position+size < len(text) && text[position+size] != ' ' && strings.Contains(text[position+size:], run)
+
+	switch {
+	case closing:
+		i.flush()
+		i.bold = i.bold && !bold
+		i.italic = i.italic && !italic
+	case opening:
+		i.flush()
+		i.bold = i.bold || bold
+		i.italic = i.italic || italic
+	default:
+		i.buffer.WriteString(run)
+	}
+
+	return size
+}
+
+func (i *inline) current() props.Span {
+	prop := i.base
+	prop.Style = combineStyle(prop.Style, i.bold, i.italic)
+	return prop
+}
+
+func (i *inline) flush() {
+	if i.buffer.Len() == 0 {
+		return
+	}
+
+	i.spans = append(i.spans, entity.Span{Value: i.buffer.String(), Prop: i.current()})
+	i.buffer.Reset()
+}
+
+// parseLink parses [label](url "title"), returning the label, the url and the
+// amount of characters read.
+func parseLink(text string) (string, string, int, bool) {
+	depth := 0
+	end := -1
+	for position := 0; position < len(text) && end < 0; position++ {
+		switch text[position] {
+		case '[':
+			depth++
+		case ']':
+			depth--
+			if depth == 0 {
+				end = position
+			}
+		}
+	}
+
+	if end < 0 || !strings.HasPrefix(text[end+1:], "(") {
+		return "", "", 0, false
+	}
+
+	closing := strings.IndexByte(text[end+2:], ')')
+	if closing < 0 {
+		return "", "", 0, false
+	}
+
+	fields := strings.Fields(text[end+2 : end+2+closing])
+	if len(fields) == 0 {
+		return "", "", 0, false
+	}
+
+	return text[1:end], fields[0], end + closing + 3, true
+}
+
+func combineStyle(style fontstyle.Type, bold, italic bool) fontstyle.Type {
+	bold = bold || style == fontstyle.Bold || style == fontstyle.BoldItalic
+	italic = italic || style == fontstyle.Italic || style == fontstyle.BoldItalic
+
+	switch {
+	case bold && italic:
+		return fontstyle.BoldItalic
+	case bold:
+		return fontstyle.Bold
+	case italic:
+		return fontstyle.Italic
+	default:
+		return fontstyle.Normal
+	}
+}
+
+func isAlphanumeric(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9'
+}
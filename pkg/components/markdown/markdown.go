@@ -0,0 +1,114 @@
+// Package markdown implements the conversion of markdown documents into rows.
+package markdown
+
+import (
+	"github.com/johnfercher/maroto/v2/pkg/components/col"
+	"github.com/johnfercher/maroto/v2/pkg/components/image"
+	"github.com/johnfercher/maroto/v2/pkg/components/line"
+	"github.com/johnfercher/maroto/v2/pkg/components/richtext"
+	"github.com/johnfercher/maroto/v2/pkg/components/row"
+	"github.com/johnfercher/maroto/v2/pkg/components/text"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+// Build is responsible to convert a markdown document into rows, which can be added
+// to maroto and are paginated as any other row.
+//
+// It supports headings, paragraphs, emphasis, bullet and numbered lists, links,
+// inline code, fenced code blocks, images from a local path and horizontal rules.
+// Each block becomes at least one row, code blocks become a row per line, so
+// they can be split between pages.
+func Build(content string, ps ...props.Markdown) []core.Row {
+	prop := props.Markdown{}
+	if len(ps) > 0 {
+		prop = ps[0]
+	}
+	prop.MakeValid()
+
+	var rows []core.Row
+	for _, b := range parseBlocks(content) {
+		switch b.kind {
+		case headingBlock:
+			rows = append(rows, buildRichText(b.text, getHeading(prop, b.level), prop))
+		case paragraphBlock:
+			rows = append(rows, buildRichText(b.text, prop.Paragraph, prop))
+		case listItemBlock:
+			rows = append(rows, buildListItem(b, prop))
+		case codeBlock:
+			rows = append(rows, buildCode(b.lines, prop)...)
+		case ruleBlock:
+			rows = append(rows, line.NewRow(prop.RuleHeight, prop.Rule))
+		case imageBlock:
+			rows = append(rows, image.NewAutoFromFileRow(b.text, prop.Image))
+		}
+	}
+
+	return rows
+}
+
+func getHeading(prop props.Markdown, level int) props.Text {
+	headings := []props.Text{prop.Heading1, prop.Heading2, prop.Heading3, prop.Heading4, prop.Heading5, prop.Heading6}
+	return headings[level-1]
+}
+
+func buildRichText(content string, textProp props.Text, prop props.Markdown) core.Row {
+	spans := parseInline(content, toSpan(textProp), toSpan(prop.Code))
+	return richtext.NewAutoRow(spans, toRichText(textProp))
+}
+
+func buildListItem(b block, prop props.Markdown) core.Row {
+	spanProp := toSpan(prop.ListItem)
+	spans := append([]entity.Span{{Value: b.marker + " ", Prop: spanProp}}, parseInline(b.text, spanProp, toSpan(prop.Code))...)
+
+	richTextProp := toRichText(prop.ListItem)
+	richTextProp.Left += float64(b.level) * prop.ListIndent
+
+	return richtext.NewAutoRow(spans, richTextProp)
+}
+
+// buildCode creates a row for each line of a code block, the top space of the block
+// is applied to the first line and the bottom space to the last one.
+func buildCode(lines []string, prop props.Markdown) []core.Row {
+	if len(lines) == 0 {
+		lines = []string{""}
+	}
+
+	var rows []core.Row
+	for i, content := range lines {
+		textProp := prop.Code
+		if i > 0 {
+			textProp.Top = 0
+		}
+
+		if i < len(lines)-1 {
+			textProp.Bottom = 0
+		}
+
+		r := row.New().Add(col.New().Add(text.New(content, textProp)))
+		rows = append(rows, r.WithStyle(&props.Cell{BackgroundColor: prop.CodeBackground}))
+	}
+
+	return rows
+}
+
+func toSpan(textProp props.Text) props.Span {
+	return props.Span{
+		Family: textProp.Family,
+		Style:  textProp.Style,
+		Size:   textProp.Size,
+		Color:  textProp.Color,
+	}
+}
+
+func toRichText(textProp props.Text) props.RichText {
+	return props.RichText{
+		Top:             textProp.Top,
+		Bottom:          textProp.Bottom,
+		Left:            textProp.Left,
+		Right:           textProp.Right,
+		Align:           textProp.Align,
+		VerticalPadding: textProp.VerticalPadding,
+	}
+}
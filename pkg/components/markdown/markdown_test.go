@@ -0,0 +1,91 @@
+package markdown_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/pkg/components/markdown"
+	"github.com/johnfercher/maroto/v2/pkg/components/page"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+	"github.com/johnfercher/maroto/v2/pkg/test"
+)
+
+const document = `# Terms
+
+This is the **first** paragraph,
+written in *two* lines.
+
+## Items
+
+- first item
+  continues here
+  - nested with ` + "`code`" + `
+1. numbered
+2) second
+
+---
+
+` + "```go" + `
+func main() {
+    fmt.Println("maroto")
+}
+` + "```" + `
+
+![logo](docs/assets/images/logo.png)
+
+###### Small heading ######
+`
+
+func TestBuild(t *testing.T) {
+	t.Run("when content is empty, should return no rows", func(t *testing.T) {
+		// Act
+		rows := markdown.Build("\n\n")
+
+		// Assert
+		assert.Empty(t, rows)
+	})
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		rows := markdown.Build(document)
+
+		// Assert
+		p := page.New().Add(rows...)
+		test.New(t).Assert(p.GetStructure()).Equals("components/markdown/build_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Arrange
+		textProp := fixture.TextProp()
+		prop := props.Markdown{
+			Heading1:       textProp,
+			Paragraph:      textProp,
+			ListItem:       textProp,
+			ListIndent:     10,
+			Code:           textProp,
+			CodeBackground: &props.Color{Red: 10, Green: 20, Blue: 30},
+			Rule:           fixture.LineProp(),
+			RuleHeight:     8,
+			Image:          fixture.RectProp(),
+		}
+
+		// Act
+		rows := markdown.Build(document, prop)
+
+		// Assert
+		p := page.New().Add(rows...)
+		test.New(t).Assert(p.GetStructure()).Equals("components/markdown/build_custom_prop.json")
+	})
+	t.Run("when paragraph has inline elements, should split it in spans", func(t *testing.T) {
+		// Arrange
+		content := "Text with **bold**, *italic*, ***both***, __bold__ and _italic_, `code`, " +
+			"[a **link**](https://maroto.io \"title\"), ![image](image.png), \\*escaped\\*, 5 * 3 and snake_case_name."
+
+		// Act
+		rows := markdown.Build(content)
+
+		// Assert
+		p := page.New().Add(rows...)
+		test.New(t).Assert(p.GetStructure()).Equals("components/markdown/build_inline.json")
+	})
+}
@@ -0,0 +1,174 @@
+package markdown
+
+import (
+	"regexp"
+	"strings"
+)
+
+type blockType int
+
+const (
+	headingBlock blockType = iota
+	paragraphBlock
+	listItemBlock
+	codeBlock
+	ruleBlock
+	imageBlock
+)
+
+// block is a markdown block element, text holds the inline content of headings,
+// paragraphs and list items, the path of images and the lines of code blocks.
+type block struct {
+	kind   blockType
+	level  int
+	marker string
+	text   string
+	lines  []string
+}
+
+var imageLine = regexp.MustCompile(`^!\[[^\]]*\]\(\s*([^)\s]+)(?:\s+"[^"]*")?\s*\)$`)
+
+// parseBlocks splits a markdown document into block elements. Lines that don't
+// start a new block are joined to the paragraph, or list item, above them.
+func parseBlocks(content string) []block {
+	content = strings.ReplaceAll(content, "\r\n", "\n")
+	content = strings.ReplaceAll(content, "\t", "    ")
+	lines := strings.Split(content, "\n")
+
+	var blocks []block
+	var paragraph []string
+	continuesItem := false
+
+	flush := func() {
+		if len(paragraph) > 0 {
+			blocks = append(blocks, block{kind: paragraphBlock, text: strings.Join(paragraph, " ")})
+			paragraph = nil
+		}
+	}
+
+	for i := 0; i < len(lines); i++ {
+		line := strings.TrimRight(lines[i], " ")
+		trimmed := strings.TrimLeft(line, " ")
+		indent := len(line) - len(trimmed)
+
+		if trimmed == "" {
+			flush()
+			continuesItem = false
+			continue
+		}
+
+		if fence, ok := parseFence(trimmed); ok {
+			flush()
+			code := block{kind: codeBlock}
+			for i++; i < len(lines) && !strings.HasPrefix(strings.TrimSpace(lines[i]), fence); i++ {
+				code.lines = append(code.lines, removeIndent(strings.TrimRight(lines[i], " "), indent))
+			}
+			blocks = append(blocks, code)
+			continuesItem = false
+			continue
+		}
+
+		if isRule(trimmed) {
+			flush()
+			blocks = append(blocks, block{kind: ruleBlock})
+			continuesItem = false
+			continue
+		}
+
+		if level, text, ok := parseHeading(trimmed); ok {
+			flush()
+			blocks = append(blocks, block{kind: headingBlock, level: level, text: text})
+			continuesItem = false
+			continue
+		}
+
+		if marker, text, ok := parseListItem(trimmed); ok {
+			flush()
+			blocks = append(blocks, block{kind: listItemBlock, level: indent / 2, marker: marker, text: text})
+			continuesItem = true
+			continue
+		}
+
+		if match := imageLine.FindStringSubmatch(trimmed); match != nil {
+			flush()
+			blocks = append(blocks, block{kind: imageBlock, text: match[1]})
+			continuesItem = false
+			continue
+		}
+
+		if continuesItem {
+			blocks[len(blocks)-1].text += " " + trimmed
+			continue
+		}
+
+		paragraph = append(paragraph, trimmed)
+	}
+
+	flush()
+	return blocks
+}
+
+// parseFence returns the fence which opens a code block, ``` or ~~~.
+func parseFence(line string) (string, bool) {
+	for _, fence := range []string{"```", "~~~"} {
+		if strings.HasPrefix(line, fence) {
+			return fence, true
+		}
+	}
+
+	return "", false
+}
+
+// removeIndent removes up to indent spaces from the start of a code line.
+func removeIndent(line string, indent int) string {
+	for i := 0; i < indent && strings.HasPrefix(line, " "); i++ {
+		line = line[1:]
+	}
+
+	return line
+}
+
+// isRule checks if the line is a thematic break, three or more -, * or _.
+func isRule(line string) bool {
+	line = strings.ReplaceAll(line, " ", "")
+	if len(line) < 3 {
+		return false
+	}
+
+	return strings.Count(line, line[:1]) == len(line) && strings.ContainsAny(line[:1], "-*_")
+}
+
+func parseHeading(line string) (int, string, bool) {
+	level := 0
+	for level < len(line) && line[level] == '#' {
+		level++
+	}
+
+	if level == 0 || level > 6 || (level < len(line) && line[level] != ' ') {
+		return 0, "", false
+	}
+
+	text := strings.TrimSpace(line[level:])
+	text = strings.TrimSpace(strings.TrimRight(text, "#"))
+
+	return level, text, true
+}
+
+// parseListItem returns the marker written before the item text, a bullet for
+// unordered lists or the number for ordered ones.
+func parseListItem(line string) (string, string, bool) {
+	if len(line) > 1 && strings.ContainsAny(line[:1], "-*+") && line[1] == ' ' {
+		return "•", strings.TrimSpace(line[2:]), true
+	}
+
+	digits := 0
+	for digits < len(line) && digits < 9 && line[digits] >= '0' && line[digits] <= '9' {
+		digits++
+	}
+
+	if digits == 0 || digits+1 >= len(line) || !strings.ContainsAny(line[digits:digits+1], ".)") || line[digits+1] != ' ' {
+		return "", "", false
+	}
+
+	return line[:digits+1], strings.TrimSpace(line[digits+2:]), true
+}
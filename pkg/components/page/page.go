@@ -10,11 +10,12 @@ import (
 )
 
 type Page struct {
-	number int
-	total  int
-	rows   []core.Row
-	config *entity.Config
-	prop   props.PageNumber
+	number    int
+	total     int
+	rows      []core.Row
+	config    *entity.Config
+	prop      props.PageNumber
+	watermark *entity.Watermark
 }
 
 // New is responsible to create a core.Page.
@@ -40,6 +41,11 @@ func (p *Page) Render(provider core.Provider, cell entity.Cell) {
 		provider.AddBackgroundImageFromBytes(p.config.BackgroundImage.Bytes, &innerCell, prop, p.config.BackgroundImage.Extension)
 	}
 
+	watermark := p.getWatermark()
+	if watermark != nil && !watermark.Prop.Foreground {
+		provider.AddWatermark(watermark, &cell)
+	}
+
 	for _, row := range p.rows {
 		row.Render(provider, innerCell)
 		innerCell.Y += row.GetHeight(provider, &innerCell)
@@ -48,11 +54,28 @@ func (p *Page) Render(provider core.Provider, cell entity.Cell) {
 	if p.prop.Pattern != "" {
 		provider.AddText(p.prop.GetPageString(p.number, p.total), &cell, p.prop.GetNumberTextProp(cell.Height))
 	}
+
+	if watermark != nil && watermark.Prop.Foreground {
+		provider.AddWatermark(watermark, &cell)
+	}
+}
+
+// getWatermark returns the watermark of the Page, or the watermark of the document when the Page has none.
+func (p *Page) getWatermark() *entity.Watermark {
+	if p.watermark != nil {
+		return p.watermark
+	}
+
+	return p.config.Watermark
 }
 
 // SetConfig sets the Page configuration.
 func (p *Page) SetConfig(config *entity.Config) {
 	p.config = config
+	if p.watermark != nil {
+		p.watermark.Prop.MakeValid(config.DefaultFont)
+	}
+
 	for _, row := range p.rows {
 		row.SetConfig(config)
 	}
@@ -64,6 +87,17 @@ func (p *Page) SetNumber(number int, total int) {
 	p.total = total
 }
 
+// WithWatermark sets a text or an image drawn across the Page, instead of the watermark of the document.
+func (p *Page) WithWatermark(watermark *entity.Watermark) core.Page {
+	p.watermark = watermark
+	return p
+}
+
+// GetWatermark returns the watermark of the Page.
+func (p *Page) GetWatermark() *entity.Watermark {
+	return p.watermark
+}
+
 // GetNumber returns the Page number.
 func (p *Page) GetNumber() int {
 	return p.number
@@ -86,6 +120,10 @@ func (p *Page) GetStructure() *node.Node[core.Structure] {
 		Type: "page",
 	}
 
+	if p.watermark != nil {
+		str.Details = p.watermark.AppendMap(make(map[string]interface{}))
+	}
+
 	n := node.New(str)
 	for _, r := range p.rows {
 		inner := r.GetStructure()
@@ -137,6 +137,74 @@ func TestPage_Render(t *testing.T) {
 		row.AssertNumberOfCalls(t, "Render", 1)
 		row.AssertNumberOfCalls(t, "GetHeight", 1)
 	})
+	t.Run("when there is watermark, should draw it below the rows", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		font := fixture.FontProp()
+		watermark := entity.NewTextWatermark("DRAFT")
+		cfg := &entity.Config{DefaultFont: &font, Watermark: watermark}
+
+		var calls []string
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().AddWatermark(watermark, &cell).Run(func(*entity.Watermark, *entity.Cell) {
+			calls = append(calls, "watermark")
+		})
+		row := mocks.NewRow(t)
+		row.EXPECT().Render(provider, cell).Run(func(core.Provider, entity.Cell) {
+			calls = append(calls, "row")
+		})
+		row.EXPECT().GetHeight(provider, &cell).Return(10.0)
+		row.EXPECT().SetConfig(cfg)
+
+		sut := page.New()
+		sut.Add(row)
+		sut.SetConfig(cfg)
+
+		// Act
+		sut.Render(provider, cell)
+
+		// Assert
+		assert.Equal(t, []string{"watermark", "row"}, calls)
+	})
+	t.Run("when page has its own watermark in foreground, should draw it above the rows instead of the document one", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		font := fixture.FontProp()
+		watermark := entity.NewTextWatermark("PAID", props.Watermark{Foreground: true})
+		cfg := &entity.Config{DefaultFont: &font, Watermark: entity.NewTextWatermark("DRAFT")}
+
+		var calls []string
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().AddWatermark(watermark, &cell).Run(func(*entity.Watermark, *entity.Cell) {
+			calls = append(calls, "watermark")
+		})
+		row := mocks.NewRow(t)
+		row.EXPECT().Render(provider, cell).Run(func(core.Provider, entity.Cell) {
+			calls = append(calls, "row")
+		})
+		row.EXPECT().GetHeight(provider, &cell).Return(10.0)
+		row.EXPECT().SetConfig(cfg)
+
+		sut := page.New().WithWatermark(watermark)
+		sut.Add(row)
+		sut.SetConfig(cfg)
+
+		// Act
+		sut.Render(provider, cell)
+
+		// Assert
+		assert.Equal(t, []string{"row", "watermark"}, calls)
+		assert.Equal(t, 0.3, watermark.Prop.Opacity)
+	})
+}
+
+func TestPage_WithWatermark(t *testing.T) {
+	// Act
+	sut := page.New().WithWatermark(entity.NewTextWatermark("PAID", props.Watermark{Angle: 45}))
+
+	// Assert
+	assert.Equal(t, "PAID", sut.GetWatermark().Text)
+	test.New(t).Assert(sut.GetStructure()).Equals("components/lines/new_page_with_watermark.json")
 }
 
 func TestPage_SetNumber(t *testing.T) {
@@ -0,0 +1,55 @@
+package richtext_test
+
+import (
+	"github.com/johnfercher/maroto/v2"
+	"github.com/johnfercher/maroto/v2/pkg/components/col"
+	"github.com/johnfercher/maroto/v2/pkg/components/richtext"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+// ExampleNew demonstrates how to create a rich text component.
+func ExampleNew() {
+	m := maroto.New()
+
+	link := "https://github.com/johnfercher/maroto"
+	richText := richtext.New([]entity.Span{
+		richtext.NewSpan("Maroto ", props.Span{Style: fontstyle.Bold}),
+		richtext.NewSpan("is a library to create PDFs, see more "),
+		richtext.NewSpan("here", props.Span{Hyperlink: &link}),
+		richtext.NewSpan(". E = mc"),
+		richtext.NewSpan("2", props.Span{Size: 6, BaselineShift: 1.5}),
+	})
+	col := col.New(12).Add(richText)
+	m.AddRow(10, col)
+
+	// generate document
+}
+
+// ExampleNewAutoRow demonstrates how to create a rich text wrapped in an automatic row.
+func ExampleNewAutoRow() {
+	m := maroto.New()
+
+	richTextRow := richtext.NewAutoRow([]entity.Span{
+		richtext.NewSpan("H"),
+		richtext.NewSpan("2", props.Span{Size: 6, BaselineShift: -1}),
+		richtext.NewSpan("O"),
+	})
+	m.AddRows(richTextRow)
+
+	// generate document
+}
+
+// ExampleNewSpan_lineBreak demonstrates how to start new lines inside a rich text.
+func ExampleNewSpan_lineBreak() {
+	m := maroto.New()
+
+	richTextRow := richtext.NewAutoRow([]entity.Span{
+		richtext.NewSpan("Address:\n", props.Span{Style: fontstyle.Bold}),
+		richtext.NewSpan("221B Baker Street\nLondon"),
+	})
+	m.AddRows(richTextRow)
+
+	// generate document
+}
@@ -0,0 +1,106 @@
+// Package richtext implements creation of rich texts.
+package richtext
+
+import (
+	"github.com/johnfercher/go-tree/node"
+
+	"github.com/johnfercher/maroto/v2/pkg/components/col"
+	"github.com/johnfercher/maroto/v2/pkg/components/row"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+type RichText struct {
+	spans  []entity.Span
+	prop   props.RichText
+	config *entity.Config
+}
+
+// NewSpan is responsible to create a piece of text to be used in a RichText,
+// a line break in the value starts a new line.
+func NewSpan(value string, ps ...props.Span) entity.Span {
+	spanProp := props.Span{}
+	if len(ps) > 0 {
+		spanProp = ps[0]
+	}
+
+	return entity.Span{
+		Value: value,
+		Prop:  spanProp,
+	}
+}
+
+// New is responsible to create an instance of a RichText, the words of the spans wrap together
+// and a line break in a span value starts a new line, two line breaks leave an empty line
+// as high as the font of the span. A line break at the end of the spans doesn't add a line.
+func New(spans []entity.Span, ps ...props.RichText) core.Component {
+	richTextProp := props.RichText{}
+	if len(ps) > 0 {
+		richTextProp = ps[0]
+	}
+
+	return &RichText{
+		spans: append([]entity.Span{}, spans...),
+		prop:  richTextProp,
+	}
+}
+
+// NewCol is responsible to create an instance of a RichText wrapped in a Col.
+func NewCol(size int, spans []entity.Span, ps ...props.RichText) core.Col {
+	richText := New(spans, ps...)
+	return col.New(size).Add(richText)
+}
+
+// NewAutoRow is responsible for creating an instance of RichText grouped in a Line with automatic height.
+func NewAutoRow(spans []entity.Span, ps ...props.RichText) core.Row {
+	r := New(spans, ps...)
+	c := col.New().Add(r)
+	return row.New().Add(c)
+}
+
+// NewRow is responsible to create an instance of a RichText wrapped in a Row.
+func NewRow(height float64, spans []entity.Span, ps ...props.RichText) core.Row {
+	r := New(spans, ps...)
+	c := col.New().Add(r)
+	return row.New(height).Add(c)
+}
+
+// GetStructure returns the Structure of a RichText.
+func (r *RichText) GetStructure() *node.Node[core.Structure] {
+	str := core.Structure{
+		Type:    "richtext",
+		Details: r.prop.ToMap(),
+	}
+
+	n := node.New(str)
+	for _, span := range r.spans {
+		n.AddNext(node.New(core.Structure{
+			Type:    "span",
+			Value:   span.Value,
+			Details: span.Prop.ToMap(),
+		}))
+	}
+
+	return n
+}
+
+// GetHeight returns the height that the rich text will have in the PDF.
+func (r *RichText) GetHeight(provider core.Provider, cell *entity.Cell) float64 {
+	textHeight := provider.GetRichTextHeight(r.spans, &r.prop, cell.Width-r.prop.Left-r.prop.Right)
+	return textHeight + r.prop.Top + r.prop.Bottom
+}
+
+// SetConfig sets the config.
+func (r *RichText) SetConfig(config *entity.Config) {
+	r.config = config
+	r.prop.MakeValid()
+	for i := range r.spans {
+		r.spans[i].Prop.MakeValid(r.config.DefaultFont)
+	}
+}
+
+// Render renders a RichText into a PDF context.
+func (r *RichText) Render(provider core.Provider, cell *entity.Cell) {
+	provider.AddRichText(r.spans, cell, &r.prop)
+}
@@ -0,0 +1,184 @@
+package richtext_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/mocks"
+	"github.com/johnfercher/maroto/v2/pkg/components/richtext"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+	"github.com/johnfercher/maroto/v2/pkg/test"
+)
+
+func spans() []entity.Span {
+	return []entity.Span{
+		richtext.NewSpan("H"),
+		richtext.NewSpan("2", fixture.SpanProp()),
+		richtext.NewSpan("O is water"),
+	}
+}
+
+func TestNewSpan(t *testing.T) {
+	t.Run("when prop is not sent, should use empty prop", func(t *testing.T) {
+		// Act
+		sut := richtext.NewSpan("span")
+
+		// Assert
+		assert.Equal(t, entity.Span{Value: "span"}, sut)
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Arrange
+		prop := fixture.SpanProp()
+
+		// Act
+		sut := richtext.NewSpan("span", prop)
+
+		// Assert
+		assert.Equal(t, entity.Span{Value: "span", Prop: prop}, sut)
+	})
+}
+
+func TestNew(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := richtext.New(spans())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/richtexts/new_richtext_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := richtext.New(spans(), fixture.RichTextProp())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/richtexts/new_richtext_custom_prop.json")
+	})
+}
+
+func TestNewCol(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := richtext.NewCol(12, spans())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/richtexts/new_richtext_col_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := richtext.NewCol(12, spans(), fixture.RichTextProp())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/richtexts/new_richtext_col_custom_prop.json")
+	})
+}
+
+func TestNewRow(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := richtext.NewRow(10, spans())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/richtexts/new_richtext_row_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := richtext.NewRow(10, spans(), fixture.RichTextProp())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/richtexts/new_richtext_row_custom_prop.json")
+	})
+}
+
+func TestNewAutoRow(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := richtext.NewAutoRow(spans())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/richtexts/new_richtext_auto_row_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := richtext.NewAutoRow(spans(), fixture.RichTextProp())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/richtexts/new_richtext_auto_row_custom_prop.json")
+	})
+}
+
+func TestRichText_SetConfig(t *testing.T) {
+	t.Run("should fill spans without font with the default font", func(t *testing.T) {
+		// Arrange
+		sut := richtext.New(spans())
+		fontProp := fixture.FontProp()
+		spanProp := fixture.SpanProp()
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().AddRichText(mock.Anything, mock.Anything, mock.Anything)
+
+		// Act
+		sut.SetConfig(&entity.Config{DefaultFont: &fontProp})
+
+		// Assert
+		cell := fixture.CellEntity()
+		sut.Render(provider, &cell)
+		rendered := provider.Calls[0].Arguments.Get(0).([]entity.Span)
+		assert.Equal(t, fontProp.Family, rendered[0].Prop.Family)
+		assert.Equal(t, fontProp.Size, rendered[0].Prop.Size)
+		assert.Equal(t, spanProp, rendered[1].Prop)
+		assert.Equal(t, fontProp.Family, rendered[2].Prop.Family)
+	})
+}
+
+func TestRichText_Render(t *testing.T) {
+	t.Run("should call provider correctly", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		fontProp := fixture.FontProp()
+		prop := fixture.RichTextProp()
+		values := spans()
+		sut := richtext.New(values, prop)
+		sut.SetConfig(&entity.Config{DefaultFont: &fontProp})
+		for i := range values {
+			values[i].Prop.MakeValid(&fontProp)
+		}
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().AddRichText(values, &cell, &prop)
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		provider.AssertNumberOfCalls(t, "AddRichText", 1)
+	})
+}
+
+func TestRichText_GetHeight(t *testing.T) {
+	t.Run("should sum the lines height with top and bottom", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		fontProp := fixture.FontProp()
+		prop := props.RichText{Top: 2, Bottom: 3, Left: 5, Right: 5}
+		values := spans()
+		sut := richtext.New(values, prop)
+		sut.SetConfig(&entity.Config{DefaultFont: &fontProp})
+		for i := range values {
+			values[i].Prop.MakeValid(&fontProp)
+		}
+		prop.MakeValid()
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().GetRichTextHeight(values, &prop, 90.0).Return(12.0)
+
+		// Act
+		height := sut.GetHeight(provider, &cell)
+
+		// Assert
+		assert.Equal(t, 17.0, height)
+	})
+}
@@ -0,0 +1,34 @@
+package shape
+
+import (
+	"github.com/johnfercher/maroto/v2/pkg/components/col"
+	"github.com/johnfercher/maroto/v2/pkg/components/row"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+// NewCircle is responsible to create an instance of a Circle, which diameter is the Percent of the smaller side
+// of the cell, or of the width when JustReferenceWidth is true.
+func NewCircle(prop props.Shape, ps ...props.Rect) core.Component {
+	return newShape(circle, nil, prop, ps...)
+}
+
+// NewCircleCol is responsible to create an instance of a Circle wrapped in a Col.
+func NewCircleCol(size int, prop props.Shape, ps ...props.Rect) core.Col {
+	shape := NewCircle(prop, ps...)
+	return col.New(size).Add(shape)
+}
+
+// NewCircleRow is responsible to create an instance of a Circle wrapped in a Row.
+func NewCircleRow(height float64, prop props.Shape, ps ...props.Rect) core.Row {
+	shape := NewCircle(prop, ps...)
+	c := col.New().Add(shape)
+	return row.New(height).Add(c)
+}
+
+// NewAutoCircleRow is responsible to create an instance of a Circle wrapped in a automatic Row.
+func NewAutoCircleRow(prop props.Shape, ps ...props.Rect) core.Row {
+	shape := NewCircle(prop, ps...)
+	c := col.New().Add(shape)
+	return row.New().Add(c)
+}
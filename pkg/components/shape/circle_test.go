@@ -0,0 +1,51 @@
+package shape_test
+
+import (
+	"testing"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/pkg/components/shape"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+	"github.com/johnfercher/maroto/v2/pkg/test"
+)
+
+func TestNewCircle(t *testing.T) {
+	t.Run("when rect is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := shape.NewCircle(props.Shape{FillColor: &props.RedColor})
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/shapes/new_circle_default_prop.json")
+	})
+	t.Run("when props are sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := shape.NewCircle(fixture.ShapeProp(), fixture.RectProp())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/shapes/new_circle_custom_prop.json")
+	})
+}
+
+func TestNewCircleCol(t *testing.T) {
+	// Act
+	sut := shape.NewCircleCol(12, fixture.ShapeProp(), fixture.RectProp())
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/shapes/new_circle_col.json")
+}
+
+func TestNewCircleRow(t *testing.T) {
+	// Act
+	sut := shape.NewCircleRow(10, fixture.ShapeProp(), fixture.RectProp())
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/shapes/new_circle_row.json")
+}
+
+func TestNewAutoCircleRow(t *testing.T) {
+	// Act
+	sut := shape.NewAutoCircleRow(fixture.ShapeProp(), fixture.RectProp())
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/shapes/new_circle_auto_row.json")
+}
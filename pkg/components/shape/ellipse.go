@@ -0,0 +1,34 @@
+package shape
+
+import (
+	"github.com/johnfercher/maroto/v2/pkg/components/col"
+	"github.com/johnfercher/maroto/v2/pkg/components/row"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+// NewEllipse is responsible to create an instance of a Ellipse, which occupies the Percent of the width
+// and height of the cell, and it is at least as tall as the Percent of the width when the cell allows it.
+func NewEllipse(prop props.Shape, ps ...props.Rect) core.Component {
+	return newShape(ellipse, nil, prop, ps...)
+}
+
+// NewEllipseCol is responsible to create an instance of a Ellipse wrapped in a Col.
+func NewEllipseCol(size int, prop props.Shape, ps ...props.Rect) core.Col {
+	shape := NewEllipse(prop, ps...)
+	return col.New(size).Add(shape)
+}
+
+// NewEllipseRow is responsible to create an instance of a Ellipse wrapped in a Row.
+func NewEllipseRow(height float64, prop props.Shape, ps ...props.Rect) core.Row {
+	shape := NewEllipse(prop, ps...)
+	c := col.New().Add(shape)
+	return row.New(height).Add(c)
+}
+
+// NewAutoEllipseRow is responsible to create an instance of a Ellipse wrapped in a automatic Row.
+func NewAutoEllipseRow(prop props.Shape, ps ...props.Rect) core.Row {
+	shape := NewEllipse(prop, ps...)
+	c := col.New().Add(shape)
+	return row.New().Add(c)
+}
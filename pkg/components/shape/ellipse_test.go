@@ -0,0 +1,51 @@
+package shape_test
+
+import (
+	"testing"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/pkg/components/shape"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+	"github.com/johnfercher/maroto/v2/pkg/test"
+)
+
+func TestNewEllipse(t *testing.T) {
+	t.Run("when rect is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := shape.NewEllipse(props.Shape{FillColor: &props.RedColor})
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/shapes/new_ellipse_default_prop.json")
+	})
+	t.Run("when props are sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := shape.NewEllipse(fixture.ShapeProp(), fixture.RectProp())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/shapes/new_ellipse_custom_prop.json")
+	})
+}
+
+func TestNewEllipseCol(t *testing.T) {
+	// Act
+	sut := shape.NewEllipseCol(12, fixture.ShapeProp(), fixture.RectProp())
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/shapes/new_ellipse_col.json")
+}
+
+func TestNewEllipseRow(t *testing.T) {
+	// Act
+	sut := shape.NewEllipseRow(10, fixture.ShapeProp(), fixture.RectProp())
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/shapes/new_ellipse_row.json")
+}
+
+func TestNewAutoEllipseRow(t *testing.T) {
+	// Act
+	sut := shape.NewAutoEllipseRow(fixture.ShapeProp(), fixture.RectProp())
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/shapes/new_ellipse_auto_row.json")
+}
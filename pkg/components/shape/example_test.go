@@ -0,0 +1,54 @@
+package shape_test
+
+import (
+	"github.com/johnfercher/maroto/v2"
+	"github.com/johnfercher/maroto/v2/pkg/components/col"
+	"github.com/johnfercher/maroto/v2/pkg/components/shape"
+	"github.com/johnfercher/maroto/v2/pkg/components/text"
+	"github.com/johnfercher/maroto/v2/pkg/consts/align"
+	"github.com/johnfercher/maroto/v2/pkg/consts/linestyle"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+// ExampleNewRectangle demonstrates how to create a badge, a rounded rectangle with a text over it.
+func ExampleNewRectangle() {
+	m := maroto.New()
+
+	badge := shape.NewRectangle(props.Shape{FillColor: &props.GreenColor, CornerRadius: 2})
+	label := text.New("PAID", props.Text{Top: 1.5, Align: align.Center, Color: &props.WhiteColor})
+	m.AddRow(8, col.New(2).Add(badge, label))
+
+	// generate document
+}
+
+// ExampleNewCircleCol demonstrates how to create a status dot wrapped into a column.
+func ExampleNewCircleCol() {
+	m := maroto.New()
+
+	dot := shape.NewCircleCol(1, props.Shape{FillColor: &props.RedColor}, props.Rect{Center: true, Percent: 50})
+	m.AddRow(5, dot, text.NewCol(11, "Service unavailable"))
+
+	// generate document
+}
+
+// ExampleNewEllipseRow demonstrates how to create a dashed ellipse wrapped into a row.
+func ExampleNewEllipseRow() {
+	m := maroto.New()
+
+	ellipseRow := shape.NewEllipseRow(20, props.Shape{BorderColor: &props.BlackColor, LineStyle: linestyle.Dashed})
+	m.AddRows(ellipseRow)
+
+	// generate document
+}
+
+// ExampleNewPolygon demonstrates how to create a triangle, the points are percentages of the shape area.
+func ExampleNewPolygon() {
+	m := maroto.New()
+
+	triangle := shape.NewPolygon([]entity.Point{{X: 50, Y: 0}, {X: 100, Y: 100}, {X: 0, Y: 100}},
+		props.Shape{FillColor: &props.BlueColor}, props.Rect{Center: true, Percent: 80})
+	m.AddRow(15, col.New(2).Add(triangle))
+
+	// generate document
+}
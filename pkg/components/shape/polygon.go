@@ -0,0 +1,35 @@
+package shape
+
+import (
+	"github.com/johnfercher/maroto/v2/pkg/components/col"
+	"github.com/johnfercher/maroto/v2/pkg/components/row"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+// NewPolygon is responsible to create an instance of a Polygon, the X and Y of the points are
+// percentages of the width and height of the polygon area, which is the Percent of the cell.
+func NewPolygon(points []entity.Point, prop props.Shape, ps ...props.Rect) core.Component {
+	return newShape(polygon, points, prop, ps...)
+}
+
+// NewPolygonCol is responsible to create an instance of a Polygon wrapped in a Col.
+func NewPolygonCol(size int, points []entity.Point, prop props.Shape, ps ...props.Rect) core.Col {
+	shape := NewPolygon(points, prop, ps...)
+	return col.New(size).Add(shape)
+}
+
+// NewPolygonRow is responsible to create an instance of a Polygon wrapped in a Row.
+func NewPolygonRow(height float64, points []entity.Point, prop props.Shape, ps ...props.Rect) core.Row {
+	shape := NewPolygon(points, prop, ps...)
+	c := col.New().Add(shape)
+	return row.New(height).Add(c)
+}
+
+// NewAutoPolygonRow is responsible to create an instance of a Polygon wrapped in a automatic Row.
+func NewAutoPolygonRow(points []entity.Point, prop props.Shape, ps ...props.Rect) core.Row {
+	shape := NewPolygon(points, prop, ps...)
+	c := col.New().Add(shape)
+	return row.New().Add(c)
+}
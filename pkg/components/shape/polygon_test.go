@@ -0,0 +1,54 @@
+package shape_test
+
+import (
+	"testing"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/pkg/components/shape"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+	"github.com/johnfercher/maroto/v2/pkg/test"
+)
+
+var triangle = []entity.Point{{X: 50, Y: 0}, {X: 100, Y: 100}, {X: 0, Y: 100}}
+
+func TestNewPolygon(t *testing.T) {
+	t.Run("when rect is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := shape.NewPolygon(triangle, props.Shape{FillColor: &props.RedColor})
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/shapes/new_polygon_default_prop.json")
+	})
+	t.Run("when props are sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := shape.NewPolygon(triangle, fixture.ShapeProp(), fixture.RectProp())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/shapes/new_polygon_custom_prop.json")
+	})
+}
+
+func TestNewPolygonCol(t *testing.T) {
+	// Act
+	sut := shape.NewPolygonCol(12, triangle, fixture.ShapeProp(), fixture.RectProp())
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/shapes/new_polygon_col.json")
+}
+
+func TestNewPolygonRow(t *testing.T) {
+	// Act
+	sut := shape.NewPolygonRow(10, triangle, fixture.ShapeProp(), fixture.RectProp())
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/shapes/new_polygon_row.json")
+}
+
+func TestNewAutoPolygonRow(t *testing.T) {
+	// Act
+	sut := shape.NewAutoPolygonRow(triangle, fixture.ShapeProp(), fixture.RectProp())
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/shapes/new_polygon_auto_row.json")
+}
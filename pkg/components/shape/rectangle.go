@@ -0,0 +1,35 @@
+package shape
+
+import (
+	"github.com/johnfercher/maroto/v2/pkg/components/col"
+	"github.com/johnfercher/maroto/v2/pkg/components/row"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+// NewRectangle is responsible to create an instance of a Rectangle, which has rounded corners when
+// the prop has a CornerRadius. The rectangle occupies the Percent of the width and height of the cell,
+// and it is at least as tall as the Percent of the width when the cell allows it.
+func NewRectangle(prop props.Shape, ps ...props.Rect) core.Component {
+	return newShape(rectangle, nil, prop, ps...)
+}
+
+// NewRectangleCol is responsible to create an instance of a Rectangle wrapped in a Col.
+func NewRectangleCol(size int, prop props.Shape, ps ...props.Rect) core.Col {
+	shape := NewRectangle(prop, ps...)
+	return col.New(size).Add(shape)
+}
+
+// NewRectangleRow is responsible to create an instance of a Rectangle wrapped in a Row.
+func NewRectangleRow(height float64, prop props.Shape, ps ...props.Rect) core.Row {
+	shape := NewRectangle(prop, ps...)
+	c := col.New().Add(shape)
+	return row.New(height).Add(c)
+}
+
+// NewAutoRectangleRow is responsible to create an instance of a Rectangle wrapped in a automatic Row.
+func NewAutoRectangleRow(prop props.Shape, ps ...props.Rect) core.Row {
+	shape := NewRectangle(prop, ps...)
+	c := col.New().Add(shape)
+	return row.New().Add(c)
+}
@@ -0,0 +1,51 @@
+package shape_test
+
+import (
+	"testing"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/pkg/components/shape"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+	"github.com/johnfercher/maroto/v2/pkg/test"
+)
+
+func TestNewRectangle(t *testing.T) {
+	t.Run("when rect is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := shape.NewRectangle(props.Shape{FillColor: &props.RedColor})
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/shapes/new_rectangle_default_prop.json")
+	})
+	t.Run("when props are sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := shape.NewRectangle(fixture.ShapeProp(), fixture.RectProp())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/shapes/new_rectangle_custom_prop.json")
+	})
+}
+
+func TestNewRectangleCol(t *testing.T) {
+	// Act
+	sut := shape.NewRectangleCol(12, fixture.ShapeProp(), fixture.RectProp())
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/shapes/new_rectangle_col.json")
+}
+
+func TestNewRectangleRow(t *testing.T) {
+	// Act
+	sut := shape.NewRectangleRow(10, fixture.ShapeProp(), fixture.RectProp())
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/shapes/new_rectangle_row.json")
+}
+
+func TestNewAutoRectangleRow(t *testing.T) {
+	// Act
+	sut := shape.NewAutoRectangleRow(fixture.ShapeProp(), fixture.RectProp())
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/shapes/new_rectangle_auto_row.json")
+}
@@ -0,0 +1,135 @@
+// Package shape implements creation of rectangles, circles, ellipses and polygons.
+package shape
+
+import (
+	"github.com/johnfercher/go-tree/node"
+
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+type kind string
+
+const (
+	rectangle kind = "rectangle"
+	ellipse   kind = "ellipse"
+	circle    kind = "circle"
+	polygon   kind = "polygon"
+)
+
+type Shape struct {
+	kind   kind
+	points []entity.Point
+	prop   props.Shape
+	rect   props.Rect
+	config *entity.Config
+}
+
+func newShape(shapeKind kind, points []entity.Point, prop props.Shape, ps ...props.Rect) *Shape {
+	rect := props.Rect{}
+	if len(ps) > 0 {
+		rect = ps[0]
+	}
+	rect.MakeValid()
+	prop.MakeValid()
+
+	return &Shape{
+		kind:   shapeKind,
+		points: points,
+		prop:   prop,
+		rect:   rect,
+	}
+}
+
+// GetStructure returns the Structure of a Shape.
+func (s *Shape) GetStructure() *node.Node[core.Structure] {
+	details := s.prop.ToMap()
+	for key, value := range s.rect.ToMap() {
+		details[key] = value
+	}
+
+	if len(s.points) > 0 {
+		points := make([][]float64, len(s.points))
+		for i, point := range s.points {
+			points[i] = []float64{point.X, point.Y}
+		}
+		details["points"] = points
+	}
+
+	str := core.Structure{
+		Type:    "shape",
+		Value:   string(s.kind),
+		Details: details,
+	}
+
+	return node.New(str)
+}
+
+// GetHeight returns the height that the Shape will have in the PDF, shapes are as tall as wide in automatic rows.
+func (s *Shape) GetHeight(_ core.Provider, cell *entity.Cell) float64 {
+	return s.rect.Percent/100*cell.Width + s.rect.Top
+}
+
+// SetConfig sets the config.
+func (s *Shape) SetConfig(config *entity.Config) {
+	s.config = config
+}
+
+// Render renders a Shape into a PDF context.
+func (s *Shape) Render(provider core.Provider, cell *entity.Cell) {
+	area := s.getArea(cell)
+
+	switch s.kind {
+	case rectangle:
+		provider.AddRectangle(area, &s.prop)
+	case ellipse, circle:
+		provider.AddEllipse(area, &s.prop)
+	case polygon:
+		points := make([]entity.Point, len(s.points))
+		for i, point := range s.points {
+			points[i] = entity.Point{
+				X: area.X + point.X/100*area.Width,
+				Y: area.Y + point.Y/100*area.Height,
+			}
+		}
+		provider.AddPolygon(points, &s.prop)
+	}
+}
+
+// getArea returns the area of the shape inside the cell, the area of a circle is a square. The area
+// is never shorter than the height reserved by GetHeight, so a shape fills the automatic row it sizes.
+func (s *Shape) getArea(cell *entity.Cell) *entity.Cell {
+	width := cell.Width * s.rect.Percent / 100
+	height := max(cell.Height*s.rect.Percent/100, width)
+
+	if s.rect.Center {
+		height = min(height, cell.Height)
+	} else {
+		width = min(width, cell.Width-s.rect.Left)
+		height = min(height, cell.Height-s.rect.Top)
+	}
+
+	if s.kind == circle {
+		if !s.rect.JustReferenceWidth {
+			width = min(width, height)
+		}
+		height = width
+	}
+
+	if s.rect.Center {
+		return &entity.Cell{
+			X:      cell.X + (cell.Width-width)/2,
+			Y:      cell.Y + (cell.Height-height)/2,
+			Width:  width,
+			Height: height,
+		}
+	}
+
+	return &entity.Cell{
+		X:      cell.X + s.rect.Left,
+		Y:      cell.Y + s.rect.Top,
+		Width:  width,
+		Height: height,
+	}
+}
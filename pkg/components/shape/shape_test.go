@@ -0,0 +1,180 @@
+package shape_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/mocks"
+	"github.com/johnfercher/maroto/v2/pkg/components/shape"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+func TestShape_GetHeight(t *testing.T) {
+	t.Run("when rect is not sent, should be as tall as the cell width", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		sut := shape.NewRectangle(fixture.ShapeProp())
+
+		// Act
+		height := sut.GetHeight(nil, &cell)
+
+		// Assert
+		assert.Equal(t, 100.0, height)
+	})
+	t.Run("when rect is sent, should use percent and top", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		sut := shape.NewCircle(fixture.ShapeProp(), fixture.RectProp())
+
+		// Act
+		height := sut.GetHeight(nil, &cell)
+
+		// Assert
+		assert.Equal(t, 108.0, height)
+	})
+}
+
+func TestShape_Render(t *testing.T) {
+	t.Run("when rectangle has left and top, should draw inside the cell", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		prop := fixture.ShapeProp()
+		sut := shape.NewRectangle(prop, fixture.RectProp())
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().AddRectangle(&entity.Cell{X: 20, Y: 25, Width: 90, Height: 140}, &prop)
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		provider.AssertNumberOfCalls(t, "AddRectangle", 1)
+	})
+	t.Run("when ellipse is centered, should draw in the middle of the cell", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		prop := fixture.ShapeProp()
+		sut := shape.NewEllipse(prop, props.Rect{Center: true, Percent: 50})
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().AddEllipse(&entity.Cell{X: 35, Y: 52.5, Width: 50, Height: 75}, &prop)
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		provider.AssertNumberOfCalls(t, "AddEllipse", 1)
+	})
+	t.Run("when circle is centered, should use the smaller side", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		prop := fixture.ShapeProp()
+		sut := shape.NewCircle(prop, props.Rect{Center: true, Percent: 50})
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().AddEllipse(&entity.Cell{X: 35, Y: 65, Width: 50, Height: 50}, &prop)
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		provider.AssertNumberOfCalls(t, "AddEllipse", 1)
+	})
+	t.Run("when circle just references width, should use the width", func(t *testing.T) {
+		// Arrange
+		cell := entity.Cell{X: 10, Y: 15, Width: 100, Height: 50}
+		prop := fixture.ShapeProp()
+		sut := shape.NewCircle(prop, props.Rect{JustReferenceWidth: true})
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().AddEllipse(&entity.Cell{X: 10, Y: 15, Width: 100, Height: 100}, &prop)
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		provider.AssertNumberOfCalls(t, "AddEllipse", 1)
+	})
+	t.Run("when polygon is drawn, should scale points to the cell", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		prop := fixture.ShapeProp()
+		points := []entity.Point{{X: 50, Y: 0}, {X: 100, Y: 100}, {X: 0, Y: 100}}
+		sut := shape.NewPolygon(points, prop)
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().AddPolygon([]entity.Point{{X: 60, Y: 15}, {X: 110, Y: 165}, {X: 10, Y: 165}}, &prop)
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		provider.AssertNumberOfCalls(t, "AddPolygon", 1)
+	})
+}
+
+func TestShape_Render_AutoRow(t *testing.T) {
+	t.Run("when circle sizes an automatic row, should be as tall as the reserved height", func(t *testing.T) {
+		// Arrange
+		prop := fixture.ShapeProp()
+		sut := shape.NewCircle(prop, props.Rect{Percent: 50})
+		cell := entity.Cell{X: 10, Y: 15, Width: 100}
+		cell.Height = sut.GetHeight(nil, &cell)
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().AddEllipse(&entity.Cell{X: 10, Y: 15, Width: 50, Height: 50}, &prop)
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		assert.Equal(t, 50.0, cell.Height)
+		provider.AssertNumberOfCalls(t, "AddEllipse", 1)
+	})
+	t.Run("when ellipse with top sizes an automatic row, should fill the reserved height below the top", func(t *testing.T) {
+		// Arrange
+		prop := fixture.ShapeProp()
+		sut := shape.NewEllipse(prop, props.Rect{Percent: 50, Top: 5, Left: 5})
+		cell := entity.Cell{X: 10, Y: 15, Width: 100}
+		cell.Height = sut.GetHeight(nil, &cell)
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().AddEllipse(&entity.Cell{X: 15, Y: 20, Width: 50, Height: 50}, &prop)
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		assert.Equal(t, 55.0, cell.Height)
+		provider.AssertNumberOfCalls(t, "AddEllipse", 1)
+	})
+	t.Run("when centered rectangle sizes an automatic row, should fill the row", func(t *testing.T) {
+		// Arrange
+		prop := fixture.ShapeProp()
+		sut := shape.NewRectangle(prop, props.Rect{Percent: 40, Center: true})
+		cell := entity.Cell{X: 10, Y: 15, Width: 100}
+		cell.Height = sut.GetHeight(nil, &cell)
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().AddRectangle(&entity.Cell{X: 40, Y: 15, Width: 40, Height: 40}, &prop)
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		provider.AssertNumberOfCalls(t, "AddRectangle", 1)
+	})
+}
+
+func TestShape_SetConfig(t *testing.T) {
+	t.Run("should call correctly", func(t *testing.T) {
+		// Arrange
+		sut := shape.NewRectangle(fixture.ShapeProp())
+
+		// Act
+		sut.SetConfig(&entity.Config{})
+	})
+}
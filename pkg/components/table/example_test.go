@@ -0,0 +1,35 @@
+package table_test
+
+import (
+	"github.com/johnfercher/maroto/v2"
+	"github.com/johnfercher/maroto/v2/pkg/components/table"
+	"github.com/johnfercher/maroto/v2/pkg/consts/align"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+// ExampleNew demonstrates how to create a table with a striped content and a footer with totals.
+func ExampleNew() {
+	columns := []table.Column{
+		{Title: "Product", Size: 8},
+		{Title: "Price", Size: 4, Align: align.Right},
+	}
+
+	prop := props.Table{
+		HeaderFontStyle: fontstyle.Bold,
+		StripeColor:     &props.Color{Red: 240, Green: 240, Blue: 240},
+		FooterFontStyle: fontstyle.Bold,
+		RowHeight:       6,
+	}
+
+	tbl := table.New(columns, prop).
+		Add([]string{"Coffee", "10.00"}, []string{"Tea", "5.00"}).
+		SetFooter("Total", "15.00")
+
+	rows, _ := tbl.Build()
+
+	m := maroto.New()
+	m.AddRows(rows...)
+
+	// generate document
+}
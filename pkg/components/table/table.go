@@ -0,0 +1,178 @@
+// Package table implements creation of tables.
+package table
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/johnfercher/maroto/v2/pkg/components/row"
+	"github.com/johnfercher/maroto/v2/pkg/components/text"
+	"github.com/johnfercher/maroto/v2/pkg/consts/align"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+// Column is the definition of a Table column.
+type Column struct {
+	// Title is the text of the column in the header row.
+	Title string
+	// Size is the grid size of the column.
+	Size int
+	// Align is the alignment of the column text in header, content and footer rows.
+	// When empty, the alignment from Text is used.
+	Align align.Type
+	// Text is the text prop of the column content.
+	Text props.Text
+	// Cell is the cell style of the column content.
+	Cell *props.Cell
+}
+
+// Table is a set of rows with a header that is repeated on every page
+// the table occupies.
+type Table struct {
+	columns []Column
+	values  [][]string
+	footer  []string
+	prop    props.Table
+}
+
+// tableRow is a Row of a Table, it keeps a reference to the table header
+// so it can be repeated when the row is moved to a new page.
+type tableRow struct {
+	core.Row
+	header core.Row
+}
+
+// New is responsible to create an instance of a Table.
+func New(columns []Column, ps ...props.Table) *Table {
+	prop := props.Table{}
+	if len(ps) > 0 {
+		prop = ps[0]
+	}
+	prop.MakeValid()
+
+	return &Table{
+		columns: columns,
+		prop:    prop,
+	}
+}
+
+// Add is responsible to add content rows to a Table, each row must have
+// one value per column.
+func (t *Table) Add(values ...[]string) *Table {
+	t.values = append(t.values, values...)
+	return t
+}
+
+// SetFooter is responsible to define the footer row of a Table, it must have
+// one value per column. It can be used to show totals.
+func (t *Table) SetFooter(values ...string) *Table {
+	t.footer = values
+	return t
+}
+
+// Build is responsible to build the rows of a Table: the header row, the
+// content rows and the footer row. When the table is split by a page break,
+// the header row is repeated on the new page.
+func (t *Table) Build() ([]core.Row, error) {
+	if len(t.columns) == 0 {
+		return nil, errors.New("table must have at least one column")
+	}
+
+	for i, column := range t.columns {
+		if column.Size <= 0 {
+			return nil, fmt.Errorf("column %d must have a size greater than zero", i)
+		}
+	}
+
+	for i, values := range t.values {
+		if len(values) != len(t.columns) {
+			return nil, fmt.Errorf("row %d has %d values, but table has %d columns", i, len(values), len(t.columns))
+		}
+	}
+
+	if t.footer != nil && len(t.footer) != len(t.columns) {
+		return nil, fmt.Errorf("footer has %d values, but table has %d columns", len(t.footer), len(t.columns))
+	}
+
+	header := t.buildHeader()
+	rows := []core.Row{header}
+
+	for i, values := range t.values {
+		rows = append(rows, &tableRow{Row: t.buildContent(i, values), header: header})
+	}
+
+	if t.footer != nil {
+		rows = append(rows, &tableRow{Row: t.buildFooter(), header: header})
+	}
+
+	return rows, nil
+}
+
+// GetHeader returns the header rows of the Table that the row belongs to.
+func (r *tableRow) GetHeader() []core.Row {
+	return []core.Row{r.header}
+}
+
+func (t *Table) buildHeader() core.Row {
+	var cols []core.Col
+	for _, column := range t.columns {
+		prop := t.getTextProp(column)
+		prop.Style = t.prop.HeaderFontStyle
+		cols = append(cols, text.NewCol(column.Size, column.Title, prop).WithStyle(t.prop.HeaderStyle))
+	}
+
+	return t.newRow().Add(cols...)
+}
+
+func (t *Table) buildContent(index int, values []string) core.Row {
+	var cols []core.Col
+	for i, column := range t.columns {
+		style := column.Cell
+		if t.prop.StripeColor != nil && index%2 == 1 {
+			style = t.getStripeStyle(column.Cell)
+		}
+
+		cols = append(cols, text.NewCol(column.Size, values[i], t.getTextProp(column)).WithStyle(style))
+	}
+
+	return t.newRow().Add(cols...)
+}
+
+func (t *Table) buildFooter() core.Row {
+	var cols []core.Col
+	for i, column := range t.columns {
+		prop := t.getTextProp(column)
+		prop.Style = t.prop.FooterFontStyle
+		cols = append(cols, text.NewCol(column.Size, t.footer[i], prop).WithStyle(t.prop.FooterStyle))
+	}
+
+	return t.newRow().Add(cols...)
+}
+
+func (t *Table) newRow() core.Row {
+	if t.prop.RowHeight == 0 {
+		return row.New()
+	}
+
+	return row.New(t.prop.RowHeight)
+}
+
+func (t *Table) getTextProp(column Column) props.Text {
+	prop := column.Text
+	if column.Align != "" {
+		prop.Align = column.Align
+	}
+
+	return prop
+}
+
+func (t *Table) getStripeStyle(style *props.Cell) *props.Cell {
+	stripe := props.Cell{}
+	if style != nil {
+		stripe = *style
+	}
+
+	stripe.BackgroundColor = t.prop.StripeColor
+	return &stripe
+}
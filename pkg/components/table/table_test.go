@@ -0,0 +1,115 @@
+package table_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/pkg/components/page"
+	"github.com/johnfercher/maroto/v2/pkg/components/table"
+	"github.com/johnfercher/maroto/v2/pkg/consts/align"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/test"
+)
+
+func TestTable_Build(t *testing.T) {
+	t.Run("when there are no columns, should return error", func(t *testing.T) {
+		// Act
+		rows, err := table.New(nil).Build()
+
+		// Assert
+		assert.Nil(t, rows)
+		assert.NotNil(t, err)
+	})
+	t.Run("when a column has no size, should return error", func(t *testing.T) {
+		// Act
+		rows, err := table.New([]table.Column{{Title: "Key"}}).Build()
+
+		// Assert
+		assert.Nil(t, rows)
+		assert.Equal(t, "column 0 must have a size greater than zero", err.Error())
+	})
+	t.Run("when a row has a wrong amount of values, should return error", func(t *testing.T) {
+		// Arrange
+		sut := table.New(buildColumns()).Add([]string{"key"})
+
+		// Act
+		rows, err := sut.Build()
+
+		// Assert
+		assert.Nil(t, rows)
+		assert.Equal(t, "row 0 has 1 values, but table has 2 columns", err.Error())
+	})
+	t.Run("when footer has a wrong amount of values, should return error", func(t *testing.T) {
+		// Arrange
+		sut := table.New(buildColumns()).SetFooter("total")
+
+		// Act
+		rows, err := sut.Build()
+
+		// Assert
+		assert.Nil(t, rows)
+		assert.Equal(t, "footer has 1 values, but table has 2 columns", err.Error())
+	})
+	t.Run("when prop is not sent, should build rows with default prop", func(t *testing.T) {
+		// Arrange
+		sut := table.New(buildColumns()).Add(buildValues(4)...)
+
+		// Act
+		rows, err := sut.Build()
+		p := page.New().Add(rows...)
+
+		// Assert
+		assert.Nil(t, err)
+		test.New(t).Assert(p.GetStructure()).Equals("components/tables/build_default_prop.json")
+	})
+	t.Run("when prop is sent, should build striped rows and footer", func(t *testing.T) {
+		// Arrange
+		sut := table.New(buildColumns(), fixture.TableProp()).Add(buildValues(4)...).SetFooter("total", "10")
+
+		// Act
+		rows, err := sut.Build()
+		p := page.New().Add(rows...)
+
+		// Assert
+		assert.Nil(t, err)
+		test.New(t).Assert(p.GetStructure()).Equals("components/tables/build_custom_prop.json")
+	})
+	t.Run("when rows are built, content and footer rows should reference the header", func(t *testing.T) {
+		// Arrange
+		sut := table.New(buildColumns()).Add(buildValues(2)...).SetFooter("total", "10")
+
+		// Act
+		rows, err := sut.Build()
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Len(t, rows, 4)
+		_, isHeadered := rows[0].(core.HeaderedRow)
+		assert.False(t, isHeadered)
+		for _, r := range rows[1:] {
+			headeredRow, ok := r.(core.HeaderedRow)
+			assert.True(t, ok)
+			assert.Equal(t, []core.Row{rows[0]}, headeredRow.GetHeader())
+		}
+	})
+}
+
+func buildColumns() []table.Column {
+	cell := fixture.CellProp()
+	return []table.Column{
+		{Title: "Key", Size: 8, Text: fixture.TextProp()},
+		{Title: "Value", Size: 4, Align: align.Right, Cell: &cell},
+	}
+}
+
+func buildValues(amount int) [][]string {
+	var values [][]string
+	for i := 0; i < amount; i++ {
+		values = append(values, []string{fmt.Sprintf("key %d", i), fmt.Sprintf("%d", i)})
+	}
+
+	return values
+}
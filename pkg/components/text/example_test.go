@@ -4,6 +4,9 @@ import (
 	"github.com/johnfercher/maroto/v2"
 	"github.com/johnfercher/maroto/v2/pkg/components/col"
 	"github.com/johnfercher/maroto/v2/pkg/components/text"
+	"github.com/johnfercher/maroto/v2/pkg/consts/align"
+	"github.com/johnfercher/maroto/v2/pkg/consts/overflow"
+	"github.com/johnfercher/maroto/v2/pkg/props"
 )
 
 // ExampleNew demonstrates how to create a text component.
@@ -36,3 +39,24 @@ func ExampleNewRow() {
 
 	// generate document
 }
+
+// ExampleNew_rotated demonstrates how to create a vertical text, the auto row grows to fit it.
+func ExampleNew_rotated() {
+	m := maroto.New()
+
+	header := text.New("quarterly revenue", props.Text{Rotation: 90, Align: align.Center})
+	m.AddAutoRow(col.New(1).Add(header))
+
+	// generate document
+}
+
+// ExampleNew_overflow demonstrates how to keep long texts inside a fixed height row.
+func ExampleNew_overflow() {
+	m := maroto.New()
+
+	name := text.New("a product name which varies wildly in length", props.Text{MaxLines: 2, Overflow: overflow.Ellipsis})
+	price := text.New("a price which shrinks to fit", props.Text{ShrinkToFit: true, MinSize: 6})
+	m.AddRow(10, col.New(6).Add(name), col.New(6).Add(price))
+
+	// generate document
+}
@@ -2,15 +2,25 @@
 package text
 
 import (
+	"math"
+
 	"github.com/johnfercher/go-tree/node"
 
 	"github.com/johnfercher/maroto/v2/pkg/components/col"
 	"github.com/johnfercher/maroto/v2/pkg/components/row"
+	"github.com/johnfercher/maroto/v2/pkg/consts/align"
 	"github.com/johnfercher/maroto/v2/pkg/core"
 	"github.com/johnfercher/maroto/v2/pkg/core/entity"
 	"github.com/johnfercher/maroto/v2/pkg/props"
 )
 
+// rotationTolerance absorbs the float error between the length measured for a rotated text
+// and the length computed back from the height of its row.
+const rotationTolerance = 1e-6
+
+// shrinkStep is how much the font size is lowered on each attempt to fit the text in its cell.
+const shrinkStep = 0.5
+
 type Text struct {
 	value  string
 	prop   props.Text
@@ -63,10 +73,44 @@ func (t *Text) GetStructure() *node.Node[core.Structure] {
 
 // GetHeight returns the height that the text will have in the PDF
 func (t *Text) GetHeight(provider core.Provider, cell *entity.Cell) float64 {
-	amountLines := provider.GetLinesQuantity(t.value, &t.prop, cell.Width-t.prop.Left-t.prop.Right)
-	fontHeight := provider.GetFontHeight(&props.Font{Family: t.prop.Family, Style: t.prop.Style, Size: t.prop.Size, Color: t.prop.Color})
-	textHeight := float64(amountLines)*fontHeight + float64(amountLines-1)*t.prop.VerticalPadding
-	return textHeight + t.prop.Top + t.prop.Bottom
+	if t.prop.Rotation != 0 {
+		return t.getRotatedBox(provider, cell).height + t.prop.Top + t.prop.Bottom
+	}
+
+	prop := t.getFittedProp(provider, cell)
+	return t.getTextHeight(provider, cell, &prop) + t.prop.Top + t.prop.Bottom
+}
+
+// getTextHeight returns the height of the lines written with the prop, limited by MaxLines.
+func (t *Text) getTextHeight(provider core.Provider, cell *entity.Cell, prop *props.Text) float64 {
+	amountLines := provider.GetLinesQuantity(t.value, prop, cell.Width-prop.Left-prop.Right)
+	if prop.MaxLines > 0 {
+		amountLines = min(amountLines, prop.MaxLines)
+	}
+
+	fontHeight := provider.GetFontHeight(&props.Font{Family: prop.Family, Style: prop.Style, Size: prop.Size, Color: prop.Color})
+	return float64(amountLines)*fontHeight + float64(amountLines-1)*prop.VerticalPadding
+}
+
+// getFittedProp returns the prop of the text, when ShrinkToFit is set the font size is lowered
+// until all lines fit the height of the cell and MaxLines, or until the font reaches MinSize.
+func (t *Text) getFittedProp(provider core.Provider, cell *entity.Cell) props.Text {
+	prop := t.prop
+	if !prop.ShrinkToFit {
+		return prop
+	}
+
+	for prop.Size > prop.MinSize {
+		amountLines := provider.GetLinesQuantity(t.value, &prop, cell.Width-prop.Left-prop.Right)
+		fits := prop.MaxLines == 0 || amountLines <= prop.MaxLines
+		if fits && t.getTextHeight(provider, cell, &prop) <= cell.Height-prop.Top-prop.Bottom {
+			return prop
+		}
+
+		prop.Size = max(prop.Size-shrinkStep, prop.MinSize)
+	}
+
+	return prop
 }
 
 // SetConfig sets the config.
@@ -77,5 +121,96 @@ func (t *Text) SetConfig(config *entity.Config) {
 
 // Render renders a Text into a PDF context.
 func (t *Text) Render(provider core.Provider, cell *entity.Cell) {
-	provider.AddText(t.value, cell, &t.prop)
+	if t.prop.Rotation != 0 {
+		t.renderRotated(provider, cell)
+		return
+	}
+
+	prop := t.getFittedProp(provider, cell)
+	provider.AddText(t.value, cell, &prop)
+
+	if t.prop.InternalLink != nil {
+		area := entity.Cell{
+			X:      cell.X + t.prop.Left,
+			Y:      cell.Y + t.prop.Top,
+			Width:  cell.Width - t.prop.Left - t.prop.Right,
+			Height: t.GetHeight(provider, cell) - t.prop.Top - t.prop.Bottom,
+		}
+		provider.AddInternalLink(*t.prop.InternalLink, &area)
+	}
+}
+
+// rotatedBox is the layout of a rotated text, its lines are written in a box of length by thickness
+// which is rotated around its center, width and height are the dimensions of the rotated box.
+type rotatedBox struct {
+	length    float64
+	thickness float64
+	width     float64
+	height    float64
+}
+
+// getRotatedBox wraps the text against the dimension of the cell in which it flows, a text closer
+// to the horizontal wraps against the width of the cell and a text closer to the vertical against
+// its height. A text which fits in a single line keeps the length of the line.
+func (t *Text) getRotatedBox(provider core.Provider, cell *entity.Cell) rotatedBox {
+	font := &props.Font{Family: t.prop.Family, Style: t.prop.Style, Size: t.prop.Size, Color: t.prop.Color}
+	fontHeight := provider.GetFontHeight(font)
+
+	radians := t.prop.Rotation * math.Pi / 180
+	sin, cos := math.Abs(math.Sin(radians)), math.Abs(math.Cos(radians))
+
+	var length float64
+	if cos >= sin {
+		length = (cell.Width - t.prop.Left - t.prop.Right - fontHeight*sin) / cos
+	} else {
+		length = (cell.Height - t.prop.Top - t.prop.Bottom - fontHeight*cos) / sin
+	}
+	length = max(length, 0)
+
+	amountLines := 1
+	if textWidth := provider.GetTextWidth(t.value, font); textWidth <= length+rotationTolerance {
+		length = textWidth
+	} else {
+		amountLines = provider.GetLinesQuantity(t.value, &t.prop, length)
+	}
+
+	thickness := float64(amountLines)*fontHeight + float64(amountLines-1)*t.prop.VerticalPadding
+
+	return rotatedBox{
+		length:    length,
+		thickness: thickness,
+		width:     length*cos + thickness*sin,
+		height:    length*sin + thickness*cos,
+	}
+}
+
+// renderRotated writes the text in a box rotated around its center, the box is placed at the top
+// of the cell and the align of the text places it horizontally.
+func (t *Text) renderRotated(provider core.Provider, cell *entity.Cell) {
+	box := t.getRotatedBox(provider, cell)
+
+	x := cell.X + t.prop.Left
+	switch t.prop.Align {
+	case align.Center:
+		x += (cell.Width - t.prop.Left - t.prop.Right - box.width) / 2
+	case align.Right:
+		x += cell.Width - t.prop.Left - t.prop.Right - box.width
+	}
+	y := cell.Y + t.prop.Top
+
+	centerX, centerY := x+box.width/2, y+box.height/2
+	lines := entity.Cell{
+		X:      centerX - box.length/2,
+		Y:      centerY - box.thickness/2,
+		Width:  box.length + rotationTolerance,
+		Height: box.thickness,
+	}
+
+	prop := t.prop
+	prop.Top, prop.Bottom, prop.Left, prop.Right = 0, 0, 0, 0
+	provider.AddText(t.value, &lines, &prop)
+
+	if t.prop.InternalLink != nil {
+		provider.AddInternalLink(*t.prop.InternalLink, &entity.Cell{X: x, Y: y, Width: box.width, Height: box.height})
+	}
 }
@@ -1,15 +1,20 @@
 package text_test
 
 import (
+	"math"
 	"testing"
 
 	"github.com/johnfercher/maroto/v2/internal/fixture"
 	"github.com/johnfercher/maroto/v2/mocks"
+	"github.com/johnfercher/maroto/v2/pkg/components/col"
+	"github.com/johnfercher/maroto/v2/pkg/components/row"
 	"github.com/johnfercher/maroto/v2/pkg/components/text"
+	"github.com/johnfercher/maroto/v2/pkg/consts/align"
 	"github.com/johnfercher/maroto/v2/pkg/core/entity"
 	"github.com/johnfercher/maroto/v2/pkg/props"
 	"github.com/johnfercher/maroto/v2/pkg/test"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func TestNew(t *testing.T) {
@@ -98,6 +103,28 @@ func TestText_Render(t *testing.T) {
 		// Assert
 		provider.AssertNumberOfCalls(t, "AddText", 1)
 	})
+	t.Run("when internal link is sent, should add the link over the text", func(t *testing.T) {
+		// Arrange
+		value := "textValue"
+		name := "summary"
+		cell := fixture.CellEntity()
+		font := fixture.FontProp()
+		prop := props.Text{Top: 2, Left: 3, Right: 4, InternalLink: &name}
+		prop.MakeValid(&font)
+		sut := text.New(value, prop)
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().AddText(value, &cell, &prop)
+		provider.EXPECT().GetLinesQuantity(value, &prop, 93.0).Return(2)
+		provider.EXPECT().GetFontHeight(&font).Return(5.0)
+		provider.EXPECT().AddInternalLink(name, &entity.Cell{X: 13, Y: 17, Width: 93, Height: 10})
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		provider.AssertNumberOfCalls(t, "AddInternalLink", 1)
+	})
 }
 
 func TestText_SetConfig(t *testing.T) {
@@ -166,3 +193,235 @@ func TestText_GetHeight(t *testing.T) {
 		assert.Equal(t, 10.0, height)
 	})
 }
+
+func TestText_GetHeight_Rotated(t *testing.T) {
+	t.Run("when text is vertical and fits the cell, should be as high as the text is wide", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		font := fixture.FontProp()
+		textProp := props.Text{Top: 2, Rotation: 90}
+		textProp.MakeValid(&font)
+
+		sut := text.New("text", textProp)
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().GetFontHeight(&font).Return(4.0)
+		provider.EXPECT().GetTextWidth("text", &font).Return(30.0)
+
+		// Act
+		height := sut.GetHeight(provider, &cell)
+
+		// Assert
+		assert.InDelta(t, 32.0, height, 1e-9)
+	})
+	t.Run("when text is vertical and is longer than the cell, should wrap against the height of the cell", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		font := fixture.FontProp()
+		textProp := props.Text{Rotation: 270}
+		textProp.MakeValid(&font)
+
+		sut := text.New("text", textProp)
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().GetFontHeight(&font).Return(4.0)
+		provider.EXPECT().GetTextWidth("text", &font).Return(200.0)
+		provider.EXPECT().GetLinesQuantity("text", &textProp, mock.Anything).Return(2)
+
+		// Act
+		height := sut.GetHeight(provider, &cell)
+
+		// Assert
+		assert.InDelta(t, 150.0, height, 1e-9)
+	})
+	t.Run("when text is upside down, should be as high as the font", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		font := fixture.FontProp()
+		textProp := props.Text{Rotation: 180}
+		textProp.MakeValid(&font)
+
+		sut := text.New("text", textProp)
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().GetFontHeight(&font).Return(4.0)
+		provider.EXPECT().GetTextWidth("text", &font).Return(30.0)
+
+		// Act
+		height := sut.GetHeight(provider, &cell)
+
+		// Assert
+		assert.InDelta(t, 4.0, height, 1e-9)
+	})
+	t.Run("when text is rotated by 30 degrees, should be as high as the rotated box", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		font := fixture.FontProp()
+		textProp := props.Text{Rotation: 30}
+		textProp.MakeValid(&font)
+
+		sut := text.New("text", textProp)
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().GetFontHeight(&font).Return(4.0)
+		provider.EXPECT().GetTextWidth("text", &font).Return(30.0)
+
+		// Act
+		height := sut.GetHeight(provider, &cell)
+
+		// Assert
+		assert.InDelta(t, 30*0.5+4*math.Sqrt(3)/2, height, 1e-9)
+	})
+}
+
+func TestText_Render_Rotated(t *testing.T) {
+	t.Run("when text is vertical, should write it in a box centered by the align", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		font := fixture.FontProp()
+		textProp := props.Text{Align: align.Center, Rotation: 90}
+		textProp.MakeValid(&font)
+
+		sut := text.New("text", textProp)
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().GetFontHeight(&font).Return(4.0)
+		provider.EXPECT().GetTextWidth("text", &font).Return(30.0)
+		provider.EXPECT().AddText("text", mock.Anything, &textProp)
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		provider.AssertNumberOfCalls(t, "AddText", 1)
+		lines := provider.Calls[len(provider.Calls)-1].Arguments.Get(1).(*entity.Cell)
+		assert.InDelta(t, 45.0, lines.X, 1e-6)
+		assert.InDelta(t, 28.0, lines.Y, 1e-6)
+		assert.InDelta(t, 30.0, lines.Width, 1e-5)
+		assert.InDelta(t, 4.0, lines.Height, 1e-6)
+	})
+}
+
+func TestText_GetHeight_Overflow(t *testing.T) {
+	t.Run("when max lines is sent, should not count the lines beyond it", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		font := fixture.FontProp()
+		textProp := props.Text{MaxLines: 2}
+		textProp.MakeValid(&font)
+
+		sut := text.New("text", textProp)
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().GetLinesQuantity("text", &textProp, 100.0).Return(5)
+		provider.EXPECT().GetFontHeight(&font).Return(2.0)
+
+		// Act
+		height := sut.GetHeight(provider, &cell)
+
+		// Assert
+		assert.Equal(t, 4.0, height)
+	})
+}
+
+func TestText_GetHeight_ShrinkToFit(t *testing.T) {
+	t.Run("when text is in an automatic row, should keep the font size", func(t *testing.T) {
+		// Arrange
+		r := row.New().Add(col.New().Add(text.New("text", props.Text{ShrinkToFit: true, MinSize: 8})))
+		r.SetConfig(&entity.Config{DefaultFont: &props.Font{Size: 12}, MaxGridSize: 12})
+		cell := fixture.CellEntity()
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().GetLinesQuantity("text", mock.Anything, 100.0).Return(2)
+		provider.EXPECT().GetFontHeight(mock.Anything).RunAndReturn(func(font *props.Font) float64 {
+			return font.Size / 2
+		})
+
+		// Act
+		height := r.GetHeight(provider, &cell)
+
+		// Assert
+		assert.Equal(t, 12.0, height)
+	})
+	t.Run("when text in an automatic row has max lines, should lower the font size until it fits the lines", func(t *testing.T) {
+		// Arrange
+		r := row.New().Add(col.New().Add(text.New("text", props.Text{ShrinkToFit: true, MinSize: 8, MaxLines: 1})))
+		r.SetConfig(&entity.Config{DefaultFont: &props.Font{Size: 12}, MaxGridSize: 12})
+		cell := fixture.CellEntity()
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().GetLinesQuantity("text", mock.Anything, 100.0).RunAndReturn(
+			func(_ string, prop *props.Text, _ float64) int {
+				if prop.Size > 10 {
+					return 2
+				}
+				return 1
+			})
+		provider.EXPECT().GetFontHeight(mock.Anything).RunAndReturn(func(font *props.Font) float64 {
+			return font.Size / 2
+		})
+
+		// Act
+		height := r.GetHeight(provider, &cell)
+
+		// Assert
+		assert.Equal(t, 5.0, height)
+	})
+}
+
+func TestText_Render_ShrinkToFit(t *testing.T) {
+	t.Run("when text doesn't fit the cell, should lower the font size until it fits", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		cell.Height = 4
+		font := fixture.FontProp()
+		textProp := props.Text{ShrinkToFit: true, MinSize: 8}
+		textProp.MakeValid(&font)
+
+		shrunk := textProp
+		shrunk.Size = 9
+
+		sut := text.New("text", textProp)
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().GetLinesQuantity("text", mock.Anything, 100.0).RunAndReturn(
+			func(_ string, prop *props.Text, _ float64) int {
+				if prop.Size > 9 {
+					return 2
+				}
+				return 1
+			})
+		provider.EXPECT().GetFontHeight(mock.Anything).Return(4.0)
+		provider.EXPECT().AddText("text", &cell, &shrunk)
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		provider.AssertNumberOfCalls(t, "AddText", 1)
+	})
+	t.Run("when text doesn't fit the cell even with the min size, should use the min size", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		cell.Height = 4
+		font := fixture.FontProp()
+		textProp := props.Text{ShrinkToFit: true, MinSize: 9}
+		textProp.MakeValid(&font)
+
+		shrunk := textProp
+		shrunk.Size = 9
+
+		sut := text.New("text", textProp)
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().GetLinesQuantity("text", mock.Anything, 100.0).Return(3)
+		provider.EXPECT().GetFontHeight(mock.Anything).Return(4.0)
+		provider.EXPECT().AddText("text", &cell, &shrunk)
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		provider.AssertNumberOfCalls(t, "AddText", 1)
+	})
+}
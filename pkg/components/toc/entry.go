@@ -0,0 +1,128 @@
+package toc
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/johnfercher/go-tree/node"
+
+	"github.com/johnfercher/maroto/v2/pkg/consts/align"
+	"github.com/johnfercher/maroto/v2/pkg/consts/breakline"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+// Entry is a line of a TOC, with the title of a heading, the leader and the page of the heading.
+type Entry struct {
+	title  string
+	level  int
+	anchor string
+	prop   props.TOC
+	config *entity.Config
+}
+
+// GetStructure returns the Structure of an Entry.
+func (e *Entry) GetStructure() *node.Node[core.Structure] {
+	details := e.prop.ToMap()
+	details["level"] = e.level
+
+	str := core.Structure{
+		Type:    "toc_entry",
+		Value:   e.title,
+		Details: details,
+	}
+
+	return node.New(str)
+}
+
+// GetHeight returns the height of an Entry, the lines of the title with the vertical padding.
+func (e *Entry) GetHeight(provider core.Provider, cell *entity.Cell) float64 {
+	lines := e.getLines(provider, cell, e.getTitleProp(provider))
+	return float64(lines)*provider.GetFontHeight(e.prop.ToFontProp()) + e.prop.VerticalPadding
+}
+
+// SetConfig sets the config.
+func (e *Entry) SetConfig(config *entity.Config) {
+	e.config = config
+	e.prop.MakeValid(config.DefaultFont)
+}
+
+// Render renders an Entry into a PDF context, the page is unknown while the document
+// is located, so the page of the heading is written only when the document is generated.
+// A long title is wrapped and the leader and the page are written in its last line.
+func (e *Entry) Render(provider core.Provider, cell *entity.Cell) {
+	font := e.prop.ToFontProp()
+
+	page := ""
+	if number, ok := provider.GetAnchorPage(e.anchor); ok {
+		page = strconv.Itoa(number)
+	}
+
+	titleProp := e.getTitleProp(provider)
+	provider.AddText(e.title, cell, titleProp)
+
+	lines := e.getLines(provider, cell, titleProp)
+	top := float64(lines-1) * provider.GetFontHeight(font)
+	lastLine := e.title
+	if lines > 1 {
+		lastLine = e.getLastLine(provider, titleProp, cell.Width-titleProp.Left-titleProp.Right, lines)
+	}
+
+	space := provider.GetTextWidth(" ", font)
+	pageWidth := provider.GetTextWidth(page, font)
+
+	// the leader fills the space between the title and the page
+	free := cell.Width - titleProp.Left - provider.GetTextWidth(lastLine, font) - pageWidth - 2*space
+	leaderWidth := provider.GetTextWidth(e.prop.Leader, font)
+	if leaderWidth > 0 && free >= leaderWidth {
+		leader := strings.Repeat(e.prop.Leader, int(free/leaderWidth))
+		provider.AddText(leader, cell, e.getTextProp(align.Right, 0, pageWidth+space, top))
+	}
+
+	if page != "" {
+		provider.AddText(page, cell, e.getTextProp(align.Right, 0, 0, top))
+	}
+
+	provider.AddInternalLink(e.anchor, cell)
+}
+
+// getTitleProp returns the prop of the title, indented by its level, the space of the page on the
+// right is as wide as an unknown page, so the lines of the title are the same when the page is known.
+func (e *Entry) getTitleProp(provider core.Provider) *props.Text {
+	font := e.prop.ToFontProp()
+	pageWidth := provider.GetTextWidth(core.UnknownPage, font) + provider.GetTextWidth(" ", font)
+	return e.getTextProp(align.Left, float64(e.level)*e.prop.Indent, pageWidth, 0)
+}
+
+// getLines returns the quantity of lines of the title written in the cell.
+func (e *Entry) getLines(provider core.Provider, cell *entity.Cell, titleProp *props.Text) int {
+	return max(provider.GetLinesQuantity(e.title, titleProp, cell.Width-titleProp.Left-titleProp.Right), 1)
+}
+
+// getLastLine returns the last line of the title wrapped in the width, it starts at the first word
+// which the provider writes in a new line when the title is written without the words after it.
+func (e *Entry) getLastLine(provider core.Provider, titleProp *props.Text, width float64, lines int) string {
+	words := strings.Split(e.title, " ")
+	for i := len(words) - 1; i > 0; i-- {
+		if provider.GetLinesQuantity(strings.Join(words[:i], " "), titleProp, width) < lines {
+			return strings.Join(words[i:], " ")
+		}
+	}
+
+	return e.title
+}
+
+func (e *Entry) getTextProp(alignment align.Type, left, right, top float64) *props.Text {
+	return &props.Text{
+		Family:            e.prop.Family,
+		Style:             e.prop.Style,
+		Size:              e.prop.Size,
+		Color:             e.prop.Color,
+		Align:             alignment,
+		Left:              left,
+		Right:             right,
+		Top:               top,
+		BreakLineStrategy: breakline.EmptySpaceStrategy,
+	}
+}
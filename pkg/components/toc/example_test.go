@@ -0,0 +1,22 @@
+package toc_test
+
+import (
+	"github.com/johnfercher/maroto/v2"
+	"github.com/johnfercher/maroto/v2/pkg/components/col"
+	"github.com/johnfercher/maroto/v2/pkg/components/text"
+	"github.com/johnfercher/maroto/v2/pkg/components/toc"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+// ExampleNew demonstrates how to create a table of contents.
+func ExampleNew() {
+	m := maroto.New()
+
+	m.AddRows(text.NewRow(10, "Contents"))
+	m.AddRows(toc.New(props.TOC{Leader: "."}))
+
+	m.AddRow(10, col.New(12).Add(toc.NewHeading("Introduction", 0), text.New("Introduction")))
+	m.AddRow(10, col.New(12).Add(toc.NewHeading("Scope", 1), text.New("Scope")))
+
+	// generate document
+}
@@ -0,0 +1,61 @@
+package toc
+
+import (
+	"github.com/google/uuid"
+	"github.com/johnfercher/go-tree/node"
+
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+)
+
+// Heading marks the position of a heading listed in a TOC.
+type Heading struct {
+	title  string
+	level  int
+	anchor string
+	config *entity.Config
+}
+
+// NewHeading is responsible to create an instance of a Heading, which lists the heading in the
+// tables of contents of the document, it should be added to the col of the heading.
+func NewHeading(title string, level int) core.Component {
+	return &Heading{
+		title:  title,
+		level:  max(level, 0),
+		anchor: "toc-" + uuid.NewString(),
+	}
+}
+
+// GetStructure returns the Structure of a Heading.
+func (h *Heading) GetStructure() *node.Node[core.Structure] {
+	str := core.Structure{
+		Type:  "toc_heading",
+		Value: h.title,
+		Details: map[string]interface{}{
+			"level": h.level,
+		},
+	}
+
+	return node.New(str)
+}
+
+// GetHeight returns the height of a Heading, which does not take space in the PDF.
+func (h *Heading) GetHeight(provider core.Provider, cell *entity.Cell) float64 {
+	return 0
+}
+
+// SetConfig sets the config.
+func (h *Heading) SetConfig(config *entity.Config) {
+	h.config = config
+}
+
+// Render adds the anchor of the Heading at the top of the cell, which is the target of its entry,
+// and adds the Heading to the headings listed by the tables of contents.
+func (h *Heading) Render(provider core.Provider, cell *entity.Cell) {
+	provider.AddAnchor(h.anchor, cell)
+	provider.AddHeading(&entity.Heading{
+		Title:  h.title,
+		Level:  h.level,
+		Anchor: h.anchor,
+	})
+}
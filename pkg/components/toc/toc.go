@@ -0,0 +1,110 @@
+// Package toc implements creation of tables of contents.
+package toc
+
+import (
+	"github.com/johnfercher/go-tree/node"
+
+	"github.com/johnfercher/maroto/v2/pkg/components/col"
+	"github.com/johnfercher/maroto/v2/pkg/components/row"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+// TOC is a table of contents, it lists the headings of the document with the pages where they
+// are rendered, and each entry is a link to its heading. The headings are collected while the
+// document is located, so the TOC may be added before them.
+type TOC struct {
+	prop   props.TOC
+	style  *props.Cell
+	config *entity.Config
+}
+
+// New is responsible to create an instance of a TOC, a row which is replaced by an entry
+// for each heading of the document when it is added to the document.
+func New(ps ...props.TOC) core.Row {
+	prop := props.TOC{}
+	if len(ps) > 0 {
+		prop = ps[0]
+	}
+
+	return &TOC{
+		prop: prop,
+	}
+}
+
+// Expand returns a row with an entry for each heading known by the provider, in the order
+// the headings are rendered.
+func (t *TOC) Expand(provider core.Provider) []core.Row {
+	headings := provider.GetHeadings()
+	rows := make([]core.Row, 0, len(headings))
+	for _, heading := range headings {
+		entry := &Entry{
+			title:  heading.Title,
+			level:  heading.Level,
+			anchor: heading.Anchor,
+			prop:   t.prop,
+		}
+
+		r := row.New().Add(col.New().Add(entry))
+		if t.style != nil {
+			r.WithStyle(t.style)
+		}
+
+		if t.config != nil {
+			r.SetConfig(t.config)
+		}
+
+		rows = append(rows, r)
+	}
+
+	return rows
+}
+
+// Add does nothing, the cols of a TOC are created for its entries.
+func (t *TOC) Add(_ ...core.Col) core.Row {
+	return t
+}
+
+// GetColumns returns no col, the cols are created when the TOC is expanded.
+func (t *TOC) GetColumns() []core.Col {
+	return nil
+}
+
+// GetHeight returns the sum of the heights of the entries.
+func (t *TOC) GetHeight(provider core.Provider, cell *entity.Cell) float64 {
+	height := 0.0
+	for _, r := range t.Expand(provider) {
+		height += r.GetHeight(provider, cell)
+	}
+	return height
+}
+
+// GetStructure returns the Structure of a TOC.
+func (t *TOC) GetStructure() *node.Node[core.Structure] {
+	str := core.Structure{
+		Type:    "toc",
+		Details: t.prop.ToMap(),
+	}
+
+	return node.New(str)
+}
+
+// SetConfig sets the config.
+func (t *TOC) SetConfig(config *entity.Config) {
+	t.config = config
+}
+
+// WithStyle sets the style of the rows of the entries.
+func (t *TOC) WithStyle(style *props.Cell) core.Row {
+	t.style = style
+	return t
+}
+
+// Render renders the entries of a TOC into a PDF context, one below the other.
+func (t *TOC) Render(provider core.Provider, cell entity.Cell) {
+	for _, r := range t.Expand(provider) {
+		r.Render(provider, cell)
+		cell.Y += r.GetHeight(provider, &cell)
+	}
+}
@@ -0,0 +1,248 @@
+package toc_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/internal/providers/locator"
+	"github.com/johnfercher/maroto/v2/mocks"
+	"github.com/johnfercher/maroto/v2/pkg/components/toc"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+	"github.com/johnfercher/maroto/v2/pkg/test"
+)
+
+// locateHeadings renders the headings without drawing them, as they are found when the document is located.
+func locateHeadings(t *testing.T, headings ...core.Component) core.Provider {
+	cell := fixture.CellEntity()
+	located := locator.New(mocks.NewProvider(t))
+	for _, heading := range headings {
+		heading.Render(located, &cell)
+	}
+
+	return located.Resolve()
+}
+
+func TestNew(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := toc.New()
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/tocs/new_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := toc.New(fixture.TOCProp())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/tocs/new_custom_prop.json")
+	})
+}
+
+func TestTOC_Expand(t *testing.T) {
+	t.Run("when prop is not sent, should create an entry for each heading located", func(t *testing.T) {
+		// Arrange
+		provider := locateHeadings(t, toc.NewHeading("Introduction", 0), toc.NewHeading("Scope", 1))
+		sut := toc.New().(core.ExpandableRow)
+
+		// Act
+		rows := sut.Expand(provider)
+
+		// Assert
+		assert.Len(t, rows, 2)
+		assert.Equal(t, "Introduction", rows[0].GetStructure().GetNexts()[0].GetNexts()[0].GetData().Value)
+		test.New(t).Assert(rows[1].GetStructure()).Equals("components/tocs/expand_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Arrange
+		provider := locateHeadings(t, toc.NewHeading("Introduction", 0))
+		sut := toc.New(fixture.TOCProp()).(core.ExpandableRow)
+
+		// Act
+		rows := sut.Expand(provider)
+
+		// Assert
+		assert.Len(t, rows, 1)
+		test.New(t).Assert(rows[0].GetStructure()).Equals("components/tocs/expand_custom_prop.json")
+	})
+	t.Run("when a heading is rendered again, should list it once", func(t *testing.T) {
+		// Arrange
+		heading := toc.NewHeading("Introduction", 0)
+		provider := locateHeadings(t, heading, heading)
+		sut := toc.New().(core.ExpandableRow)
+
+		// Act
+		rows := sut.Expand(provider)
+
+		// Assert
+		assert.Len(t, rows, 1)
+	})
+	t.Run("when there are no headings, should return no rows", func(t *testing.T) {
+		// Arrange
+		sut := toc.New().(core.ExpandableRow)
+
+		// Act
+		rows := sut.Expand(locateHeadings(t))
+
+		// Assert
+		assert.Empty(t, rows)
+	})
+}
+
+func TestNewHeading(t *testing.T) {
+	t.Run("when level is negative, should use the top level", func(t *testing.T) {
+		// Act
+		sut := toc.NewHeading("Introduction", -1)
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/tocs/new_heading.json")
+	})
+}
+
+func TestHeading_Render(t *testing.T) {
+	t.Run("should add an anchor which is the target of the entry and list the heading", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		sut := toc.NewHeading("Introduction", 2)
+
+		var anchor string
+		var heading *entity.Heading
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().AddAnchor(mock.Anything, &cell).Run(func(name string, _ *entity.Cell) {
+			anchor = name
+		})
+		provider.EXPECT().AddHeading(mock.Anything).Run(func(h *entity.Heading) {
+			heading = h
+		})
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		assert.Equal(t, 0.0, sut.GetHeight(provider, &cell))
+		assert.Equal(t, &entity.Heading{Title: "Introduction", Level: 2, Anchor: anchor}, heading)
+	})
+}
+
+func TestEntry_Render(t *testing.T) {
+	t.Run("when page is known, should write title, leader and page", func(t *testing.T) {
+		// Arrange
+		cell := entity.Cell{Width: 20, Height: 5}
+		sut := toc.New(props.TOC{Leader: "."}).(core.ExpandableRow)
+		entry := sut.Expand(locateHeadings(t, toc.NewHeading("Scope", 1)))[0].GetColumns()[0]
+		entry.SetConfig(&entity.Config{DefaultFont: &props.Font{Family: "arial", Size: 10}})
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().GetAnchorPage(mock.Anything).Return(12, true)
+		provider.EXPECT().GetTextWidth(" ", mock.Anything).Return(1.0)
+		provider.EXPECT().GetTextWidth("000", mock.Anything).Return(3.0)
+		provider.EXPECT().GetTextWidth("12", mock.Anything).Return(2.0)
+		provider.EXPECT().GetTextWidth("Scope", mock.Anything).Return(5.0)
+		provider.EXPECT().GetTextWidth(".", mock.Anything).Return(1.0)
+		provider.EXPECT().GetLinesQuantity("Scope", mock.Anything, 11.0).Return(1)
+		provider.EXPECT().GetFontHeight(mock.Anything).Return(4.0)
+		provider.EXPECT().AddText("Scope", mock.Anything, mock.Anything).Run(
+			func(_ string, _ *entity.Cell, prop *props.Text) {
+				assert.Equal(t, 5.0, prop.Left)
+				assert.Equal(t, 4.0, prop.Right)
+			})
+		provider.EXPECT().AddText("......", mock.Anything, mock.Anything).Run(
+			func(_ string, _ *entity.Cell, prop *props.Text) {
+				assert.Equal(t, 0.0, prop.Top)
+			})
+		provider.EXPECT().AddText("12", mock.Anything, mock.Anything)
+		provider.EXPECT().AddInternalLink(mock.Anything, mock.Anything)
+		provider.EXPECT().CreateCol(mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+
+		// Act
+		entry.Render(provider, cell, true)
+
+		// Assert
+		provider.AssertNumberOfCalls(t, "AddText", 3)
+		provider.AssertNumberOfCalls(t, "AddInternalLink", 1)
+	})
+	t.Run("when title is wrapped, should write leader and page in its last line", func(t *testing.T) {
+		// Arrange
+		cell := entity.Cell{Width: 20, Height: 8}
+		sut := toc.New(props.TOC{Leader: "."}).(core.ExpandableRow)
+		entry := sut.Expand(locateHeadings(t, toc.NewHeading("General scope", 0)))[0].GetColumns()[0]
+		entry.SetConfig(&entity.Config{DefaultFont: &props.Font{Family: "arial", Size: 10}})
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().GetAnchorPage(mock.Anything).Return(3, true)
+		provider.EXPECT().GetTextWidth(" ", mock.Anything).Return(1.0)
+		provider.EXPECT().GetTextWidth("000", mock.Anything).Return(3.0)
+		provider.EXPECT().GetTextWidth("3", mock.Anything).Return(1.0)
+		provider.EXPECT().GetTextWidth("scope", mock.Anything).Return(6.0)
+		provider.EXPECT().GetTextWidth(".", mock.Anything).Return(1.0)
+		provider.EXPECT().GetLinesQuantity("General scope", mock.Anything, 16.0).Return(2)
+		provider.EXPECT().GetLinesQuantity("General", mock.Anything, 16.0).Return(1)
+		provider.EXPECT().GetFontHeight(mock.Anything).Return(4.0)
+		provider.EXPECT().AddText("General scope", mock.Anything, mock.Anything)
+		provider.EXPECT().AddText("...........", mock.Anything, mock.Anything).Run(
+			func(_ string, _ *entity.Cell, prop *props.Text) {
+				assert.Equal(t, 4.0, prop.Top)
+			})
+		provider.EXPECT().AddText("3", mock.Anything, mock.Anything).Run(
+			func(_ string, _ *entity.Cell, prop *props.Text) {
+				assert.Equal(t, 4.0, prop.Top)
+			})
+		provider.EXPECT().AddInternalLink(mock.Anything, mock.Anything)
+		provider.EXPECT().CreateCol(mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+
+		// Act
+		entry.Render(provider, cell, true)
+
+		// Assert
+		provider.AssertNumberOfCalls(t, "AddText", 3)
+	})
+}
+
+func TestEntry_GetHeight(t *testing.T) {
+	t.Run("should return the height of the lines of the title with the vertical padding", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		prop := fixture.TOCProp()
+		sut := toc.New(prop).(core.ExpandableRow)
+		entry := sut.Expand(locateHeadings(t, toc.NewHeading("Introduction", 0)))[0]
+		entry.SetConfig(&entity.Config{DefaultFont: &props.Font{}, MaxGridSize: 12})
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().GetTextWidth(mock.Anything, prop.ToFontProp()).Return(2.0)
+		provider.EXPECT().GetLinesQuantity("Introduction", mock.Anything, 96.0).Return(3)
+		provider.EXPECT().GetFontHeight(prop.ToFontProp()).Return(5.0)
+
+		// Act
+		height := entry.GetHeight(provider, &cell)
+
+		// Assert
+		assert.Equal(t, 16.0, height)
+	})
+}
+
+func TestTOC_GetHeight(t *testing.T) {
+	t.Run("should return the sum of the heights of the entries", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		prop := fixture.TOCProp()
+		sut := toc.New(prop)
+		sut.SetConfig(&entity.Config{DefaultFont: &props.Font{}, MaxGridSize: 12})
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().GetHeadings().Return([]*entity.Heading{{Title: "Introduction"}, {Title: "Scope", Level: 1}})
+		provider.EXPECT().GetTextWidth(mock.Anything, prop.ToFontProp()).Return(2.0)
+		provider.EXPECT().GetLinesQuantity(mock.Anything, mock.Anything, mock.Anything).Return(1)
+		provider.EXPECT().GetFontHeight(prop.ToFontProp()).Return(5.0)
+
+		// Act
+		height := sut.GetHeight(provider, &cell)
+
+		// Assert
+		assert.Equal(t, 12.0, height)
+	})
+}
@@ -43,6 +43,8 @@ type Builder interface {
 	WithBackgroundImage([]byte, extension.Type) Builder
 	WithDisableAutoPageBreak(disabled bool) Builder
 	WithKeywords(keywordsStr string, isUTF8 bool) Builder
+	WithFootnote(footnote props.Footnote) Builder
+	WithWatermark(watermark *entity.Watermark) Builder
 	Build() *entity.Config
 }
 
@@ -63,6 +65,8 @@ type CfgBuilder struct {
 	metadata             *entity.Metadata
 	backgroundImage      *entity.Image
 	disableAutoPageBreak bool
+	footnote             *props.Footnote
+	watermark            *entity.Watermark
 }
 
 // NewBuilder is responsible to create an instance of Builder.
@@ -362,12 +366,37 @@ func (b *CfgBuilder) WithKeywords(keywordsStr string, isUTF8 bool) Builder {
 	return b
 }
 
+// WithFootnote defines the numbering and the font of the footnotes written at the bottom of the pages.
+func (b *CfgBuilder) WithFootnote(footnote props.Footnote) Builder {
+	b.footnote = &footnote
+	return b
+}
+
+// WithWatermark defines a text or an image drawn across every page, pages with their own watermark
+// use it instead of this one.
+func (b *CfgBuilder) WithWatermark(watermark *entity.Watermark) Builder {
+	if watermark == nil {
+		return b
+	}
+
+	b.watermark = watermark
+	return b
+}
+
 // Build finalizes the customization returning the entity.Config.
 func (b *CfgBuilder) Build() *entity.Config {
 	if b.pageNumber != nil {
 		b.pageNumber.WithFont(b.defaultFont)
 	}
 
+	if b.footnote != nil {
+		b.footnote.MakeValid(b.defaultFont)
+	}
+
+	if b.watermark != nil {
+		b.watermark.Prop.MakeValid(b.defaultFont)
+	}
+
 	return &entity.Config{
 		ProviderType:         b.providerType,
 		Dimensions:           b.getDimensions(),
@@ -383,6 +412,8 @@ func (b *CfgBuilder) Build() *entity.Config {
 		CustomFonts:          b.customFonts,
 		BackgroundImage:      b.backgroundImage,
 		DisableAutoPageBreak: b.disableAutoPageBreak,
+		Footnote:             b.footnote,
+		Watermark:            b.watermark,
 	}
 }
 
@@ -708,3 +708,65 @@ func TestBuilder_WithKeywords(t *testing.T) {
 		assert.Equal(t, true, cfg.Metadata.KeywordsStr.UTF8)
 	})
 }
+
+func TestBuilder_WithFootnote(t *testing.T) {
+	t.Run("when footnote is not defined, should not create it", func(t *testing.T) {
+		// Arrange
+		sut := config.NewBuilder()
+
+		// Act
+		cfg := sut.Build()
+
+		// Assert
+		assert.Nil(t, cfg.Footnote)
+	})
+	t.Run("when footnote is empty, should apply default", func(t *testing.T) {
+		// Arrange
+		sut := config.NewBuilder()
+
+		// Act
+		cfg := sut.WithFootnote(props.Footnote{}).Build()
+
+		// Assert
+		assert.Equal(t, props.PerDocument, cfg.Footnote.Numbering)
+		assert.Equal(t, fontfamily.Arial, cfg.Footnote.Family)
+		assert.Equal(t, fontstyle.Normal, cfg.Footnote.Style)
+		assert.Equal(t, 8.0, cfg.Footnote.Size)
+	})
+	t.Run("when footnote is numbered per page, should apply", func(t *testing.T) {
+		// Arrange
+		sut := config.NewBuilder()
+
+		// Act
+		cfg := sut.WithFootnote(props.Footnote{Numbering: props.PerPage, Size: 7}).Build()
+
+		// Assert
+		assert.Equal(t, props.PerPage, cfg.Footnote.Numbering)
+		assert.Equal(t, 7.0, cfg.Footnote.Size)
+	})
+}
+
+func TestBuilder_WithWatermark(t *testing.T) {
+	t.Run("when watermark is nil, should not apply", func(t *testing.T) {
+		// Arrange
+		sut := config.NewBuilder()
+
+		// Act
+		cfg := sut.WithWatermark(nil).Build()
+
+		// Assert
+		assert.Nil(t, cfg.Watermark)
+	})
+	t.Run("when watermark is sent, should apply with default props", func(t *testing.T) {
+		// Arrange
+		sut := config.NewBuilder()
+
+		// Act
+		cfg := sut.WithWatermark(entity.NewTextWatermark("DRAFT")).Build()
+
+		// Assert
+		assert.Equal(t, "DRAFT", cfg.Watermark.Text)
+		assert.Equal(t, fontfamily.Arial, cfg.Watermark.Prop.Family)
+		assert.Equal(t, 0.3, cfg.Watermark.Prop.Opacity)
+	})
+}
@@ -0,0 +1,21 @@
+// Package barcode contains all barcode symbologies.
+package barcode
+
+// Type is a representation of a barcode symbology.
+type Type string
+
+const (
+	// Code128 represents the Code 128 symbology, it encodes the full ASCII table.
+	Code128 Type = "code128"
+	// EAN13 represents the EAN-13 symbology, it encodes 12 digits and a check digit.
+	EAN13 Type = "ean13"
+	// UPCA represents the UPC-A symbology, it encodes 11 digits and a check digit.
+	UPCA Type = "upca"
+	// ITF represents the Interleaved 2 of 5 symbology, it encodes an even amount of digits.
+	ITF Type = "itf"
+)
+
+// IsValid checks if the symbology is valid.
+func (t Type) IsValid() bool {
+	return t == Code128 || t == EAN13 || t == UPCA || t == ITF
+}
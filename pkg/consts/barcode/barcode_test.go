@@ -0,0 +1,28 @@
+package barcode_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnfercher/maroto/v2/pkg/consts/barcode"
+)
+
+func TestType_IsValid(t *testing.T) {
+	t.Run("when type is not valid, should return false", func(t *testing.T) {
+		// Arrange
+		sut := barcode.Type("invalid")
+
+		// Act & Assert
+		assert.False(t, sut.IsValid())
+	})
+	t.Run("when type is valid, should return true", func(t *testing.T) {
+		// Arrange
+		types := []barcode.Type{barcode.Code128, barcode.EAN13, barcode.UPCA, barcode.ITF}
+
+		// Act & Assert
+		for _, sut := range types {
+			assert.True(t, sut.IsValid())
+		}
+	})
+}
@@ -11,9 +11,26 @@ const (
 	Jpeg Type = "jpeg"
 	// Png represents a png extension.
 	Png Type = "png"
+	// Svg represents a svg extension, which is drawn as vectors.
+	Svg Type = "svg"
+	// Gif represents a gif extension, which is converted to png.
+	Gif Type = "gif"
+	// Bmp represents a bmp extension, which is converted to png.
+	Bmp Type = "bmp"
+	// Tiff represents a tiff extension, which is converted to png.
+	Tiff Type = "tiff"
+	// Tif represents a tif extension, which is converted to png.
+	Tif Type = "tif"
+	// Webp represents a webp extension, which is converted to png.
+	Webp Type = "webp"
 )
 
 // IsValid checks if the extension is valid.
 func (t Type) IsValid() bool {
-	return t == Jpg || t == Jpeg || t == Png
+	return t == Jpg || t == Jpeg || t == Png || t == Svg || t.IsConverted()
+}
+
+// IsConverted checks if the extension is not supported by the PDF and is converted to png.
+func (t Type) IsConverted() bool {
+	return t == Gif || t == Bmp || t == Tiff || t == Tif || t == Webp
 }
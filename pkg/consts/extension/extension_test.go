@@ -36,4 +36,33 @@ func TestType_IsValid(t *testing.T) {
 		// Act & Assert
 		assert.True(t, extensionType.IsValid())
 	})
+	t.Run("when type is svg, should be valid", func(t *testing.T) {
+		// Act
+		extensionType := extension.Svg
+
+		// Act & Assert
+		assert.True(t, extensionType.IsValid())
+	})
+	t.Run("when type is converted, should be valid", func(t *testing.T) {
+		for _, extensionType := range []extension.Type{extension.Gif, extension.Bmp, extension.Tiff, extension.Tif, extension.Webp} {
+			// Act & Assert
+			assert.True(t, extensionType.IsValid())
+		}
+	})
+}
+
+func TestType_IsConverted(t *testing.T) {
+	t.Run("when type is supported by pdf, should not be converted", func(t *testing.T) {
+		for _, extensionType := range []extension.Type{extension.Jpg, extension.Jpeg, extension.Png, extension.Svg} {
+			// Act & Assert
+			assert.False(t, extensionType.IsConverted())
+		}
+	})
+	t.Run("when type is webp, should be converted", func(t *testing.T) {
+		// Arrange
+		extensionType := extension.Webp
+
+		// Act & Assert
+		assert.True(t, extensionType.IsConverted())
+	})
 }
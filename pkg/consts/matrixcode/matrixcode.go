@@ -0,0 +1,20 @@
+// Package matrixcode contains all 2D matrix code symbologies.
+package matrixcode
+
+// Symbology is a representation of a 2D matrix code symbology.
+type Symbology string
+
+const (
+	// DataMatrix represents the ECC 200 Data Matrix symbology.
+	DataMatrix Symbology = "datamatrix"
+	// GS1DataMatrix represents the Data Matrix symbology carrying GS1 element strings,
+	// the value must be written as "(AI)data", e.g. "(01)09501101530003(10)AB12".
+	GS1DataMatrix Symbology = "gs1_datamatrix"
+	// PDF417 represents the PDF417 stacked symbology.
+	PDF417 Symbology = "pdf417"
+)
+
+// IsValid checks if the symbology is valid.
+func (s Symbology) IsValid() bool {
+	return s == DataMatrix || s == GS1DataMatrix || s == PDF417
+}
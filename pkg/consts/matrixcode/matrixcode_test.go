@@ -0,0 +1,28 @@
+package matrixcode_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnfercher/maroto/v2/pkg/consts/matrixcode"
+)
+
+func TestSymbology_IsValid(t *testing.T) {
+	t.Run("when symbology is not valid, should return false", func(t *testing.T) {
+		// Arrange
+		sut := matrixcode.Symbology("invalid")
+
+		// Act & Assert
+		assert.False(t, sut.IsValid())
+	})
+	t.Run("when symbology is valid, should return true", func(t *testing.T) {
+		// Arrange
+		symbologies := []matrixcode.Symbology{matrixcode.DataMatrix, matrixcode.GS1DataMatrix, matrixcode.PDF417}
+
+		// Act & Assert
+		for _, sut := range symbologies {
+			assert.True(t, sut.IsValid())
+		}
+	})
+}
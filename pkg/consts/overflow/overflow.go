@@ -0,0 +1,19 @@
+// Package overflow contains all overflow policies of texts.
+package overflow
+
+// Type is a representation of what happens with the lines of a text which don't fit its cell.
+type Type string
+
+const (
+	// Visible writes all lines, even the ones which spill out of the cell.
+	Visible Type = "visible"
+	// Clip hides the lines which don't fit the cell.
+	Clip Type = "clip"
+	// Ellipsis hides the lines which don't fit the cell and ends the last written line with an ellipsis.
+	Ellipsis Type = "ellipsis"
+)
+
+// IsValid checks if the overflow is valid.
+func (t Type) IsValid() bool {
+	return t == Visible || t == Clip || t == Ellipsis
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/johnfercher/maroto/v2/pkg/consts/extension"
 	"github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
+	"github.com/johnfercher/maroto/v2/pkg/consts/matrixcode"
 	"github.com/johnfercher/maroto/v2/pkg/core/entity"
 	"github.com/johnfercher/maroto/v2/pkg/props"
 	"github.com/jung-kurt/gofpdf"
@@ -25,10 +26,30 @@ type Line interface {
 	Add(cell *entity.Cell, prop *props.Line)
 }
 
+// Shape is the abstraction which deals of how to draw vector shapes in a PDF.
+type Shape interface {
+	AddPolygon(points []entity.Point, prop *props.Shape)
+	AddPolyline(points []entity.Point, prop *props.Shape)
+	AddRectangle(cell *entity.Cell, prop *props.Shape)
+	AddEllipse(cell *entity.Cell, prop *props.Shape)
+}
+
+// Code is the abstraction which deals of how to add 1D and 2D codes in a PDF.
+type Code interface {
+	AddQr(value string, cell *entity.Cell, margins *entity.Margins, prop *props.Rect) error
+	GetQrDimensions(value string) (*entity.Dimensions, error)
+	AddBar(value string, cell *entity.Cell, margins *entity.Margins, prop *props.Barcode) (*entity.Cell, error)
+	AddMatrix(value string, symbology matrixcode.Symbology, cell *entity.Cell, margins *entity.Margins, prop *props.Rect) error
+	GetMatrixDimensions(value string, symbology matrixcode.Symbology) (*entity.Dimensions, error)
+}
+
 // Text is the abstraction which deals of how to add text inside PDF.
 type Text interface {
 	Add(text string, cell *entity.Cell, textProp *props.Text)
 	GetLinesQuantity(text string, textProp *props.Text, colWidth float64) int
+	GetWidth(text string, prop *props.Font) float64
+	AddRich(spans []entity.Span, cell *entity.Cell, prop *props.RichText)
+	GetRichHeight(spans []entity.Span, prop *props.RichText, colWidth float64) float64
 }
 
 // Font is the abstraction which deals of how to set fontstyle configurations.
@@ -4,6 +4,7 @@ package core
 import (
 	"github.com/johnfercher/go-tree/node"
 
+	"github.com/johnfercher/maroto/v2/pkg/consts/align"
 	"github.com/johnfercher/maroto/v2/pkg/core/entity"
 	"github.com/johnfercher/maroto/v2/pkg/props"
 )
@@ -49,6 +50,8 @@ type Col interface {
 	GetSize() int
 	GetHeight(provider Provider, cell *entity.Cell) float64
 	WithStyle(style *props.Cell) Col
+	WithStack(gap ...float64) Col
+	WithVerticalAlign(verticalAlign align.Type) Col
 	Render(provider Provider, cell entity.Cell, createCell bool)
 }
 
@@ -62,6 +65,20 @@ type Row interface {
 	Render(provider Provider, cell entity.Cell)
 }
 
+// HeaderedRow is the interface of a Row that belongs to a group of rows, like a table,
+// whose header must be repeated when the Row is moved to a new page.
+type HeaderedRow interface {
+	Row
+	GetHeader() []Row
+}
+
+// ExpandableRow is the interface of a Row which is replaced by rows created from the components
+// located in the document, like a table of contents, which has a row for each heading.
+type ExpandableRow interface {
+	Row
+	Expand(provider Provider) []Row
+}
+
 // Page is the interface that wraps the basic methods of a page.
 type Page interface {
 	Node
@@ -69,5 +86,7 @@ type Page interface {
 	GetRows() []Row
 	GetNumber() int
 	SetNumber(number int, total int)
+	WithWatermark(watermark *entity.Watermark) Page
+	GetWatermark() *entity.Watermark
 	Render(provider Provider, cell entity.Cell)
 }
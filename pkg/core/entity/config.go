@@ -21,6 +21,8 @@ type Config struct {
 	Metadata             *Metadata
 	BackgroundImage      *Image
 	DisableAutoPageBreak bool
+	Footnote             *props.Footnote
+	Watermark            *Watermark
 }
 
 // ToMap converts Config to a map[string]interface{} .
@@ -77,5 +79,13 @@ func (c *Config) ToMap() map[string]interface{} {
 		m["config_disable_auto_page_break"] = c.DisableAutoPageBreak
 	}
 
+	if c.Footnote != nil {
+		m = c.Footnote.AppendMap(m)
+	}
+
+	if c.Watermark != nil {
+		m = c.Watermark.AppendMap(m)
+	}
+
 	return m
 }
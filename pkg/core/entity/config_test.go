@@ -55,6 +55,8 @@ func TestConfig_ToMap(t *testing.T) {
 	assert.Equal(t, 100.0, m["background_dimension_width"])
 	assert.Equal(t, 200.0, m["background_dimension_height"])
 	assert.Equal(t, true, m["config_disable_auto_page_break"])
+	assert.Equal(t, props.PerPage, m["footnote_numbering"])
+	assert.Equal(t, "DRAFT", m["watermark_text"])
 }
 
 func fixtureConfig() Config {
@@ -80,6 +82,8 @@ func fixtureConfig() Config {
 		Metadata:             &metadata,
 		BackgroundImage:      &image,
 		DisableAutoPageBreak: true,
+		Footnote:             &props.Footnote{Numbering: props.PerPage},
+		Watermark:            NewTextWatermark("DRAFT"),
 	}
 }
 
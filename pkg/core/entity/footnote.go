@@ -0,0 +1,8 @@
+package entity
+
+// Footnote is a note written at the bottom of the page where it is referred, its number is
+// given when the row which refers to it is added to a page.
+type Footnote struct {
+	Value  string
+	Number int
+}
@@ -0,0 +1,9 @@
+package entity
+
+// Heading is a title of the document listed by the tables of contents, the anchor is added
+// where the heading is rendered and is the target of its entries.
+type Heading struct {
+	Title  string
+	Level  int
+	Anchor string
+}
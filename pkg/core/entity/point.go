@@ -0,0 +1,7 @@
+package entity
+
+// Point represents a position in the document, in the same coordinates of a Cell.
+type Point struct {
+	X float64
+	Y float64
+}
@@ -0,0 +1,10 @@
+package entity
+
+import "github.com/johnfercher/maroto/v2/pkg/props"
+
+// Span is a piece of text with its own properties, a sequence of spans
+// compose a rich text paragraph.
+type Span struct {
+	Value string
+	Prop  props.Span
+}
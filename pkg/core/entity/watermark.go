@@ -0,0 +1,55 @@
+package entity
+
+import (
+	"github.com/johnfercher/maroto/v2/pkg/consts/extension"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+// Watermark is a text or an image drawn across a page, like a "DRAFT" stamp.
+type Watermark struct {
+	Text  string
+	Image *Image
+	Prop  props.Watermark
+}
+
+// NewTextWatermark is responsible to create a Watermark with a text.
+func NewTextWatermark(text string, ps ...props.Watermark) *Watermark {
+	prop := props.Watermark{}
+	if len(ps) > 0 {
+		prop = ps[0]
+	}
+
+	return &Watermark{
+		Text: text,
+		Prop: prop,
+	}
+}
+
+// NewImageWatermark is responsible to create a Watermark with an image.
+func NewImageWatermark(bytes []byte, ext extension.Type, ps ...props.Watermark) *Watermark {
+	prop := props.Watermark{}
+	if len(ps) > 0 {
+		prop = ps[0]
+	}
+
+	return &Watermark{
+		Image: &Image{
+			Bytes:     bytes,
+			Extension: ext,
+		},
+		Prop: prop,
+	}
+}
+
+// AppendMap adds the Watermark fields to the map.
+func (w *Watermark) AppendMap(m map[string]interface{}) map[string]interface{} {
+	if w.Text != "" {
+		m["watermark_text"] = w.Text
+	}
+
+	if w.Image != nil {
+		m["watermark_image_extension"] = w.Image.Extension
+	}
+
+	return w.Prop.AppendMap(m)
+}
@@ -0,0 +1,44 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnfercher/maroto/v2/pkg/consts/extension"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+func TestNewTextWatermark(t *testing.T) {
+	// Act
+	sut := NewTextWatermark("DRAFT", props.Watermark{Angle: 45})
+
+	// Assert
+	assert.Equal(t, "DRAFT", sut.Text)
+	assert.Nil(t, sut.Image)
+	assert.Equal(t, 45.0, sut.Prop.Angle)
+}
+
+func TestNewImageWatermark(t *testing.T) {
+	// Act
+	sut := NewImageWatermark([]byte{1, 2, 3}, extension.Png)
+
+	// Assert
+	assert.Empty(t, sut.Text)
+	assert.Equal(t, []byte{1, 2, 3}, sut.Image.Bytes)
+	assert.Equal(t, extension.Png, sut.Image.Extension)
+}
+
+func TestWatermark_AppendMap(t *testing.T) {
+	// Arrange
+	sut := NewImageWatermark([]byte{1, 2, 3}, extension.Png, props.Watermark{Opacity: 0.5})
+
+	// Act
+	m := sut.AppendMap(make(map[string]interface{}))
+
+	// Assert
+	assert.Equal(t, map[string]interface{}{
+		"watermark_image_extension": extension.Png,
+		"watermark_opacity":         0.5,
+	}, m)
+}
@@ -2,26 +2,50 @@ package core
 
 import (
 	"github.com/johnfercher/maroto/v2/pkg/consts/extension"
+	"github.com/johnfercher/maroto/v2/pkg/consts/matrixcode"
 	"github.com/johnfercher/maroto/v2/pkg/core/entity"
 	"github.com/johnfercher/maroto/v2/pkg/props"
 )
 
+// UnknownPage is written instead of the page of an anchor while it is unknown, it is as wide as
+// three digits, so the text which contains it does not need more lines when the page is known.
+const UnknownPage = "000"
+
 // Provider is the abstraction of a document creator provider.
 type Provider interface {
 	// Grid
 	CreateRow(height float64)
 	CreateCol(width, height float64, config *entity.Config, prop *props.Cell)
+	OpenGrid(cell *entity.Cell)
+	CloseGrid()
 
 	// Features
+	Shape
 	AddLine(cell *entity.Cell, prop *props.Line)
 	AddText(text string, cell *entity.Cell, prop *props.Text)
 	GetFontHeight(prop *props.Font) float64
+	GetTextWidth(text string, prop *props.Font) float64
 	GetLinesQuantity(text string, textProp *props.Text, colWidth float64) int
-	GetDimensionsByImageByte(bytes []byte, extension extension.Type) (*entity.Dimensions, error)
-	GetDimensionsByImage(file string) (*entity.Dimensions, error)
+	AddRichText(spans []entity.Span, cell *entity.Cell, prop *props.RichText)
+	GetRichTextHeight(spans []entity.Span, prop *props.RichText, colWidth float64) float64
+	GetDimensionsByImageByte(bytes []byte, extension extension.Type, frame int) (*entity.Dimensions, error)
+	GetDimensionsByImage(file string, frame int) (*entity.Dimensions, error)
 	AddImageFromFile(value string, cell *entity.Cell, prop *props.Rect)
 	AddImageFromBytes(bytes []byte, cell *entity.Cell, prop *props.Rect, extension extension.Type)
 	AddBackgroundImageFromBytes(bytes []byte, cell *entity.Cell, prop *props.Rect, extension extension.Type)
+	AddQrCode(code string, cell *entity.Cell, prop *props.Rect)
+	GetDimensionsByQrCode(code string) (*entity.Dimensions, error)
+	AddBarCode(code string, cell *entity.Cell, prop *props.Barcode)
+	AddMatrixCode(code string, symbology matrixcode.Symbology, cell *entity.Cell, prop *props.Rect)
+	GetDimensionsByMatrixCode(code string, symbology matrixcode.Symbology) (*entity.Dimensions, error)
+	AddBookmark(title string, level int, cell *entity.Cell)
+	AddAnchor(name string, cell *entity.Cell)
+	AddInternalLink(name string, cell *entity.Cell)
+	GetAnchorPage(name string) (int, bool)
+	AddFootnote(footnote *entity.Footnote)
+	AddHeading(heading *entity.Heading)
+	GetHeadings() []*entity.Heading
+	AddWatermark(watermark *entity.Watermark, cell *entity.Cell)
 
 	// General
 	GenerateBytes() ([]byte, error)
@@ -0,0 +1,146 @@
+package props
+
+import (
+	"github.com/johnfercher/maroto/v2/pkg/consts/barcode"
+)
+
+// Barcode represents properties from a barcode inside a cell.
+type Barcode struct {
+	// Left is the space between the left cell boundary to the barcode, if center is false.
+	Left float64
+	// Top is space between the upper cell limit to the barcode, if center is false.
+	Top float64
+	// Percent is how much the barcode will occupy the cell,
+	// ex 100%: The barcode will fulfill the entire cell
+	// ex 50%: The greater side from the barcode will have half the size of the cell.
+	Percent float64
+	// Proportion is the proportion between width and height of the bars,
+	// ex: 1x0.2 means that the bars height will be 20% of the barcode width.
+	Proportion Proportion
+	// Center define that the barcode will be vertically and horizontally centralized.
+	Center bool
+	// Type is the symbology used to encode the value, ex: barcode.Code128, barcode.EAN13 and etc.
+	// The default is barcode.Code128, an unknown symbology is written as an error in the cell.
+	Type barcode.Type
+	// QuietZone is the amount of blank modules added on the left and on the right of the bars.
+	QuietZone int
+	// HumanReadable define that the encoded value will be written below the bars.
+	HumanReadable bool
+	// Caption is a custom text written below the bars, it takes precedence over the encoded value.
+	Caption string
+}
+
+// ToMap returns a map with the Barcode fields.
+func (b *Barcode) ToMap() map[string]interface{} {
+	m := make(map[string]interface{})
+
+	if b.Left != 0 {
+		m["prop_left"] = b.Left
+	}
+
+	if b.Top != 0 {
+		m["prop_top"] = b.Top
+	}
+
+	if b.Percent != 0 {
+		m["prop_percent"] = b.Percent
+	}
+
+	if b.Proportion.Width != 0 {
+		m["prop_proportion_width"] = b.Proportion.Width
+	}
+
+	if b.Proportion.Height != 0 {
+		m["prop_proportion_height"] = b.Proportion.Height
+	}
+
+	if b.Center {
+		m["prop_center"] = b.Center
+	}
+
+	if b.Type != "" {
+		m["prop_type"] = b.Type
+	}
+
+	if b.QuietZone != 0 {
+		m["prop_quiet_zone"] = b.QuietZone
+	}
+
+	if b.HumanReadable {
+		m["prop_human_readable"] = b.HumanReadable
+	}
+
+	if b.Caption != "" {
+		m["prop_caption"] = b.Caption
+	}
+
+	return m
+}
+
+// MakeValid from Barcode will make the properties from a barcode reliable to fit inside a cell
+// and define default values for a barcode.
+func (b *Barcode) MakeValid() {
+	minPercentage := 0.0
+	maxPercentage := 100.0
+	minValue := 0.0
+
+	if b.Percent <= minPercentage || b.Percent > maxPercentage {
+		b.Percent = maxPercentage
+	}
+
+	if b.Center {
+		b.Left = 0
+		b.Top = 0
+	}
+
+	if b.Left < minValue {
+		b.Left = minValue
+	}
+
+	if b.Top < minValue {
+		b.Top = minValue
+	}
+
+	if b.Proportion.Width <= 0 {
+		b.Proportion.Width = 1
+	}
+
+	if b.Proportion.Height <= 0 {
+		b.Proportion.Height = 0.20
+	}
+
+	if b.Proportion.Height > b.Proportion.Width {
+		b.Proportion.Height = b.Proportion.Width
+	}
+
+	if b.Type == "" {
+		b.Type = barcode.Code128
+	}
+
+	if b.QuietZone < 0 {
+		b.QuietZone = 0
+	}
+}
+
+// ToRectProp from Barcode return a Rect based on Barcode.
+func (b *Barcode) ToRectProp() *Rect {
+	return &Rect{
+		Left:    b.Left,
+		Top:     b.Top,
+		Percent: b.Percent,
+		Center:  b.Center,
+	}
+}
+
+// GetCaption returns the text that will be written below the bars, if any.
+func (b *Barcode) GetCaption(value string) string {
+	if b.Caption != "" {
+		return b.Caption
+	}
+
+	if b.HumanReadable {
+		return value
+	}
+
+	return ""
+}
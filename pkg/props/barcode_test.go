@@ -0,0 +1,128 @@
+package props_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/pkg/consts/barcode"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+func TestBarcode_MakeValid(t *testing.T) {
+	t.Run("when percent is out of range, should become 100", func(t *testing.T) {
+		// Arrange
+		prop := props.Barcode{Percent: -2}
+
+		// Act
+		prop.MakeValid()
+
+		// Assert
+		assert.Equal(t, 100.0, prop.Percent)
+	})
+	t.Run("when is center, top and left should become 0", func(t *testing.T) {
+		// Arrange
+		prop := props.Barcode{Center: true, Top: 5, Left: 5}
+
+		// Act
+		prop.MakeValid()
+
+		// Assert
+		assert.Equal(t, 0.0, prop.Top)
+		assert.Equal(t, 0.0, prop.Left)
+	})
+	t.Run("when proportion is not defined, should use default", func(t *testing.T) {
+		// Arrange
+		prop := props.Barcode{}
+
+		// Act
+		prop.MakeValid()
+
+		// Assert
+		assert.Equal(t, 1.0, prop.Proportion.Width)
+		assert.Equal(t, 0.20, prop.Proportion.Height)
+	})
+	t.Run("when height is greater than width, should become equal to width", func(t *testing.T) {
+		// Arrange
+		prop := props.Barcode{Proportion: props.Proportion{Width: 10, Height: 20}}
+
+		// Act
+		prop.MakeValid()
+
+		// Assert
+		assert.Equal(t, 10.0, prop.Proportion.Height)
+	})
+	t.Run("when type is not defined, should use code128", func(t *testing.T) {
+		// Arrange
+		prop := props.Barcode{}
+
+		// Act
+		prop.MakeValid()
+
+		// Assert
+		assert.Equal(t, barcode.Code128, prop.Type)
+	})
+	t.Run("when type is not valid, should keep it to report the error", func(t *testing.T) {
+		// Arrange
+		prop := props.Barcode{Type: "invalid"}
+
+		// Act
+		prop.MakeValid()
+
+		// Assert
+		assert.Equal(t, barcode.Type("invalid"), prop.Type)
+	})
+	t.Run("when quiet zone is negative, should become 0", func(t *testing.T) {
+		// Arrange
+		prop := props.Barcode{QuietZone: -1}
+
+		// Act
+		prop.MakeValid()
+
+		// Assert
+		assert.Equal(t, 0, prop.QuietZone)
+	})
+}
+
+func TestBarcode_ToMap(t *testing.T) {
+	// Arrange
+	sut := fixture.BarcodeProp()
+
+	// Act
+	m := sut.ToMap()
+
+	// Assert
+	assert.Equal(t, 10.0, m["prop_left"])
+	assert.Equal(t, 10.0, m["prop_top"])
+	assert.Equal(t, 98.0, m["prop_percent"])
+	assert.Equal(t, 16.0, m["prop_proportion_width"])
+	assert.Equal(t, 9.0, m["prop_proportion_height"])
+	assert.Equal(t, barcode.EAN13, m["prop_type"])
+	assert.Equal(t, 10, m["prop_quiet_zone"])
+	assert.Equal(t, true, m["prop_human_readable"])
+}
+
+func TestBarcode_GetCaption(t *testing.T) {
+	t.Run("when caption is defined, should return caption", func(t *testing.T) {
+		// Arrange
+		sut := props.Barcode{Caption: "caption", HumanReadable: true}
+
+		// Act & Assert
+		assert.Equal(t, "caption", sut.GetCaption("value"))
+	})
+	t.Run("when human readable is defined, should return value", func(t *testing.T) {
+		// Arrange
+		sut := props.Barcode{HumanReadable: true}
+
+		// Act & Assert
+		assert.Equal(t, "value", sut.GetCaption("value"))
+	})
+	t.Run("when nothing is defined, should return empty", func(t *testing.T) {
+		// Arrange
+		sut := props.Barcode{}
+
+		// Act & Assert
+		assert.Equal(t, "", sut.GetCaption("value"))
+	})
+}
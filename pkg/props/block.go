@@ -0,0 +1,26 @@
+package props
+
+// Block represents properties from a Block, a set of rows with cells that span rows and columns.
+type Block struct {
+	// RowHeight defines the minimum height of each row, when zero the rows
+	// are as tall as their content.
+	RowHeight float64
+}
+
+// ToMap returns a map with the Block fields.
+func (b *Block) ToMap() map[string]interface{} {
+	m := make(map[string]interface{})
+
+	if b.RowHeight != 0 {
+		m["prop_row_height"] = b.RowHeight
+	}
+
+	return m
+}
+
+// MakeValid from Block define default values for a Block.
+func (b *Block) MakeValid() {
+	if b.RowHeight < 0 {
+		b.RowHeight = 0
+	}
+}
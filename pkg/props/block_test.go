@@ -0,0 +1,46 @@
+package props_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+func TestBlock_ToMap(t *testing.T) {
+	t.Run("when prop is empty, should return empty map", func(t *testing.T) {
+		// Arrange
+		sut := props.Block{}
+
+		// Act
+		m := sut.ToMap()
+
+		// Assert
+		assert.Empty(t, m)
+	})
+	t.Run("when prop is filled, should return filled map", func(t *testing.T) {
+		// Arrange
+		sut := fixture.BlockProp()
+
+		// Act
+		m := sut.ToMap()
+
+		// Assert
+		assert.Equal(t, 7.0, m["prop_row_height"])
+	})
+}
+
+func TestBlock_MakeValid(t *testing.T) {
+	t.Run("when row height is less than zero, should become zero", func(t *testing.T) {
+		// Arrange
+		sut := props.Block{RowHeight: -1}
+
+		// Act
+		sut.MakeValid()
+
+		// Assert
+		assert.Equal(t, 0.0, sut.RowHeight)
+	})
+}
@@ -0,0 +1,26 @@
+package props
+
+// Bookmark represents properties from a Bookmark, an entry of the document outline.
+type Bookmark struct {
+	// Level is the depth of the bookmark in the outline, zero is the top level. A bookmark
+	// is nested in the last bookmark with a lower level.
+	Level int
+}
+
+// ToMap returns a map with the Bookmark fields.
+func (b *Bookmark) ToMap() map[string]interface{} {
+	m := make(map[string]interface{})
+
+	if b.Level != 0 {
+		m["prop_level"] = b.Level
+	}
+
+	return m
+}
+
+// MakeValid from Bookmark define default values for a Bookmark.
+func (b *Bookmark) MakeValid() {
+	if b.Level < 0 {
+		b.Level = 0
+	}
+}
@@ -0,0 +1,46 @@
+package props_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+func TestBookmark_ToMap(t *testing.T) {
+	t.Run("when prop is empty, should return empty map", func(t *testing.T) {
+		// Arrange
+		sut := props.Bookmark{}
+
+		// Act
+		m := sut.ToMap()
+
+		// Assert
+		assert.Empty(t, m)
+	})
+	t.Run("when prop is filled, should return filled map", func(t *testing.T) {
+		// Arrange
+		sut := fixture.BookmarkProp()
+
+		// Act
+		m := sut.ToMap()
+
+		// Assert
+		assert.Equal(t, 1, m["prop_level"])
+	})
+}
+
+func TestBookmark_MakeValid(t *testing.T) {
+	t.Run("when level is less than zero, should become zero", func(t *testing.T) {
+		// Arrange
+		sut := props.Bookmark{Level: -1}
+
+		// Act
+		sut.MakeValid()
+
+		// Assert
+		assert.Equal(t, 0, sut.Level)
+	})
+}
@@ -0,0 +1,408 @@
+package props
+
+// BarChart represents properties from a bar chart.
+type BarChart struct {
+	// Palette define the colors of the series, it repeats when there are more series than colors.
+	Palette []Color
+	// Horizontal draws the bars from the left to the right, instead of from the bottom to the top.
+	Horizontal bool
+	// Stacked draws the values of the series of a label one over the other.
+	Stacked bool
+	// ShowValues writes the value of each bar, or the total of each stack.
+	ShowValues bool
+	// ShowLegend writes the name of each series below the chart.
+	ShowLegend bool
+	// ValueFormat define the fmt format of the values.
+	// Default: %g
+	ValueFormat string
+	// GapPercent define the space between the groups of bars, in percentage of the group size.
+	// Default: 20
+	GapPercent float64
+	// LabelPercent define the width of the labels in horizontal charts, in percentage of the chart width.
+	// Default: 25
+	LabelPercent float64
+	// FontSize define the size of labels, values and legend, the font family, style and color
+	// are the ones from the document default font.
+	// Default: document default font size.
+	FontSize float64
+	// AxisColor define the color of the line drawn at the value zero.
+	// Default: black
+	AxisColor *Color
+	// Proportion define the height of the chart from the width of the col.
+	// Default: 16x9
+	Proportion Proportion
+}
+
+// PieChart represents properties from a pie chart.
+type PieChart struct {
+	// Palette define the colors of the slices, it repeats when there are more slices than colors.
+	Palette []Color
+	// HolePercent define the size of the hole in the middle of the pie, in percentage of the radius,
+	// a pie with a hole is a donut.
+	HolePercent float64
+	// ShowValues writes the percentage of each slice inside it.
+	ShowValues bool
+	// ShowLegend writes the label of each slice at the right of the chart.
+	ShowLegend bool
+	// PercentFormat define the fmt format of the percentages.
+	// Default: %.1f%%
+	PercentFormat string
+	// SliceBorderColor define the color of the border between slices, when nil there is no border.
+	SliceBorderColor *Color
+	// FontSize define the size of values and legend, the font family, style and color
+	// are the ones from the document default font.
+	// Default: document default font size.
+	FontSize float64
+	// Proportion define the height of the chart from the width of the col.
+	// Default: 16x9
+	Proportion Proportion
+}
+
+// LineChart represents properties from a line chart.
+type LineChart struct {
+	// Palette define the colors of the series, it repeats when there are more series than colors.
+	Palette []Color
+	// Area fills the space between each series and the zero, or the bottom of the chart
+	// when zero is not visible.
+	Area bool
+	// AreaIntensity define the color of the areas, in percentage of the series color mixed with white.
+	// Default: 30
+	AreaIntensity float64
+	// LineThickness define the thickness of the series lines.
+	// Default: 0.4
+	LineThickness float64
+	// MarkerSize define the diameter of the circle drawn at each point, when zero there is no marker.
+	MarkerSize float64
+	// ShowGrid draws a line at each tick of both axes.
+	ShowGrid bool
+	// GridColor define the color of the grid lines.
+	// Default: RGB(220, 220, 220)
+	GridColor *Color
+	// ShowLegend writes the name of each series below the chart.
+	ShowLegend bool
+	// TimeAxis interprets the x values as unix seconds, the ticks are placed at round times
+	// and the labels are written in UTC.
+	TimeAxis bool
+	// TimeFormat define the time layout of the x labels when TimeAxis is true.
+	// Default: chosen from the distance between the ticks.
+	TimeFormat string
+	// XFormat define the fmt format of the x labels when TimeAxis is false.
+	// Default: %g
+	XFormat string
+	// ValueFormat define the fmt format of the y labels.
+	// Default: %g
+	ValueFormat string
+	// Ticks define the number of ticks that each axis should have, the real number
+	// depends on the values to keep the ticks at round values.
+	// Default: 5
+	Ticks int
+	// LabelPercent define the width of the y labels, in percentage of the chart width.
+	// Default: 12
+	LabelPercent float64
+	// FontSize define the size of labels and legend, the font family, style and color
+	// are the ones from the document default font.
+	// Default: document default font size.
+	FontSize float64
+	// AxisColor define the color of the axes.
+	// Default: black
+	AxisColor *Color
+	// Proportion define the height of the chart from the width of the col.
+	// Default: 16x9
+	Proportion Proportion
+}
+
+// defaultPalette returns the palette used by charts without a palette.
+func defaultPalette() []Color {
+	return []Color{
+		{Red: 66, Green: 133, Blue: 244},
+		{Red: 234, Green: 67, Blue: 53},
+		{Red: 251, Green: 188, Blue: 5},
+		{Red: 52, Green: 168, Blue: 83},
+		{Red: 255, Green: 109, Blue: 1},
+		{Red: 70, Green: 189, Blue: 198},
+		{Red: 171, Green: 71, Blue: 188},
+		{Red: 158, Green: 157, Blue: 36},
+	}
+}
+
+// ToMap returns a map with the BarChart fields.
+func (b *BarChart) ToMap() map[string]interface{} {
+	m := make(map[string]interface{})
+
+	if len(b.Palette) > 0 {
+		m["prop_palette"] = paletteToString(b.Palette)
+	}
+
+	if b.Horizontal {
+		m["prop_horizontal"] = b.Horizontal
+	}
+
+	if b.Stacked {
+		m["prop_stacked"] = b.Stacked
+	}
+
+	if b.ShowValues {
+		m["prop_show_values"] = b.ShowValues
+	}
+
+	if b.ShowLegend {
+		m["prop_show_legend"] = b.ShowLegend
+	}
+
+	if b.ValueFormat != "" {
+		m["prop_value_format"] = b.ValueFormat
+	}
+
+	if b.GapPercent != 0 {
+		m["prop_gap_percent"] = b.GapPercent
+	}
+
+	if b.LabelPercent != 0 {
+		m["prop_label_percent"] = b.LabelPercent
+	}
+
+	if b.FontSize != 0 {
+		m["prop_font_size"] = b.FontSize
+	}
+
+	if b.AxisColor != nil {
+		m["prop_axis_color"] = b.AxisColor.ToString()
+	}
+
+	if b.Proportion.Width > 0 {
+		m["prop_proportion_width"] = b.Proportion.Width
+		m["prop_proportion_height"] = b.Proportion.Height
+	}
+
+	return m
+}
+
+// MakeValid from BarChart define default values for a BarChart.
+func (b *BarChart) MakeValid() {
+	if len(b.Palette) == 0 {
+		b.Palette = defaultPalette()
+	}
+
+	if b.ValueFormat == "" {
+		b.ValueFormat = "%g"
+	}
+
+	if b.GapPercent <= 0 || b.GapPercent >= 100 {
+		b.GapPercent = 20
+	}
+
+	if b.LabelPercent <= 0 || b.LabelPercent >= 100 {
+		b.LabelPercent = 25
+	}
+
+	if b.FontSize < 0 {
+		b.FontSize = 0
+	}
+
+	if b.AxisColor == nil {
+		b.AxisColor = &BlackColor
+	}
+
+	if b.Proportion.Width <= 0 || b.Proportion.Height <= 0 {
+		b.Proportion = Proportion{Width: 16, Height: 9}
+	}
+}
+
+// ToMap returns a map with the PieChart fields.
+func (p *PieChart) ToMap() map[string]interface{} {
+	m := make(map[string]interface{})
+
+	if len(p.Palette) > 0 {
+		m["prop_palette"] = paletteToString(p.Palette)
+	}
+
+	if p.HolePercent != 0 {
+		m["prop_hole_percent"] = p.HolePercent
+	}
+
+	if p.ShowValues {
+		m["prop_show_values"] = p.ShowValues
+	}
+
+	if p.ShowLegend {
+		m["prop_show_legend"] = p.ShowLegend
+	}
+
+	if p.PercentFormat != "" {
+		m["prop_percent_format"] = p.PercentFormat
+	}
+
+	if p.SliceBorderColor != nil {
+		m["prop_slice_border_color"] = p.SliceBorderColor.ToString()
+	}
+
+	if p.FontSize != 0 {
+		m["prop_font_size"] = p.FontSize
+	}
+
+	if p.Proportion.Width > 0 {
+		m["prop_proportion_width"] = p.Proportion.Width
+		m["prop_proportion_height"] = p.Proportion.Height
+	}
+
+	return m
+}
+
+// MakeValid from PieChart define default values for a PieChart.
+func (p *PieChart) MakeValid() {
+	if len(p.Palette) == 0 {
+		p.Palette = defaultPalette()
+	}
+
+	if p.HolePercent < 0 || p.HolePercent >= 100 {
+		p.HolePercent = 0
+	}
+
+	if p.PercentFormat == "" {
+		p.PercentFormat = "%.1f%%"
+	}
+
+	if p.FontSize < 0 {
+		p.FontSize = 0
+	}
+
+	if p.Proportion.Width <= 0 || p.Proportion.Height <= 0 {
+		p.Proportion = Proportion{Width: 16, Height: 9}
+	}
+}
+
+// ToMap returns a map with the LineChart fields.
+func (l *LineChart) ToMap() map[string]interface{} {
+	m := make(map[string]interface{})
+
+	if len(l.Palette) > 0 {
+		m["prop_palette"] = paletteToString(l.Palette)
+	}
+
+	if l.Area {
+		m["prop_area"] = l.Area
+	}
+
+	if l.AreaIntensity != 0 {
+		m["prop_area_intensity"] = l.AreaIntensity
+	}
+
+	if l.LineThickness != 0 {
+		m["prop_line_thickness"] = l.LineThickness
+	}
+
+	if l.MarkerSize != 0 {
+		m["prop_marker_size"] = l.MarkerSize
+	}
+
+	if l.ShowGrid {
+		m["prop_show_grid"] = l.ShowGrid
+	}
+
+	if l.GridColor != nil {
+		m["prop_grid_color"] = l.GridColor.ToString()
+	}
+
+	if l.ShowLegend {
+		m["prop_show_legend"] = l.ShowLegend
+	}
+
+	if l.TimeAxis {
+		m["prop_time_axis"] = l.TimeAxis
+	}
+
+	if l.TimeFormat != "" {
+		m["prop_time_format"] = l.TimeFormat
+	}
+
+	if l.XFormat != "" {
+		m["prop_x_format"] = l.XFormat
+	}
+
+	if l.ValueFormat != "" {
+		m["prop_value_format"] = l.ValueFormat
+	}
+
+	if l.Ticks != 0 {
+		m["prop_ticks"] = l.Ticks
+	}
+
+	if l.LabelPercent != 0 {
+		m["prop_label_percent"] = l.LabelPercent
+	}
+
+	if l.FontSize != 0 {
+		m["prop_font_size"] = l.FontSize
+	}
+
+	if l.AxisColor != nil {
+		m["prop_axis_color"] = l.AxisColor.ToString()
+	}
+
+	if l.Proportion.Width > 0 {
+		m["prop_proportion_width"] = l.Proportion.Width
+		m["prop_proportion_height"] = l.Proportion.Height
+	}
+
+	return m
+}
+
+// MakeValid from LineChart define default values for a LineChart.
+func (l *LineChart) MakeValid() {
+	if len(l.Palette) == 0 {
+		l.Palette = defaultPalette()
+	}
+
+	if l.AreaIntensity <= 0 || l.AreaIntensity > 100 {
+		l.AreaIntensity = 30
+	}
+
+	if l.LineThickness <= 0 {
+		l.LineThickness = 0.4
+	}
+
+	if l.MarkerSize < 0 {
+		l.MarkerSize = 0
+	}
+
+	if l.GridColor == nil {
+		l.GridColor = &Color{Red: 220, Green: 220, Blue: 220}
+	}
+
+	if l.XFormat == "" {
+		l.XFormat = "%g"
+	}
+
+	if l.ValueFormat == "" {
+		l.ValueFormat = "%g"
+	}
+
+	if l.Ticks <= 1 {
+		l.Ticks = 5
+	}
+
+	if l.LabelPercent <= 0 || l.LabelPercent >= 100 {
+		l.LabelPercent = 12
+	}
+
+	if l.FontSize < 0 {
+		l.FontSize = 0
+	}
+
+	if l.AxisColor == nil {
+		l.AxisColor = &BlackColor
+	}
+
+	if l.Proportion.Width <= 0 || l.Proportion.Height <= 0 {
+		l.Proportion = Proportion{Width: 16, Height: 9}
+	}
+}
+
+func paletteToString(palette []Color) []string {
+	colors := make([]string, len(palette))
+	for i, color := range palette {
+		colors[i] = color.ToString()
+	}
+
+	return colors
+}
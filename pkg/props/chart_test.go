@@ -0,0 +1,187 @@
+package props_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+func TestBarChart_ToMap(t *testing.T) {
+	t.Run("when prop is empty, should return empty map", func(t *testing.T) {
+		// Arrange
+		sut := props.BarChart{}
+
+		// Act
+		m := sut.ToMap()
+
+		// Assert
+		assert.Empty(t, m)
+	})
+	t.Run("when prop is filled, should return filled map", func(t *testing.T) {
+		// Arrange
+		sut := fixture.BarChartProp()
+
+		// Act
+		m := sut.ToMap()
+
+		// Assert
+		assert.Equal(t, []string{"RGB(100, 50, 200)", "RGB(255, 0, 0)"}, m["prop_palette"])
+		assert.Equal(t, true, m["prop_horizontal"])
+		assert.Equal(t, true, m["prop_stacked"])
+		assert.Equal(t, true, m["prop_show_values"])
+		assert.Equal(t, true, m["prop_show_legend"])
+		assert.Equal(t, "%.2f", m["prop_value_format"])
+		assert.Equal(t, 30.0, m["prop_gap_percent"])
+		assert.Equal(t, 20.0, m["prop_label_percent"])
+		assert.Equal(t, 8.0, m["prop_font_size"])
+		assert.Equal(t, "RGB(100, 50, 200)", m["prop_axis_color"])
+		assert.Equal(t, 4.0, m["prop_proportion_width"])
+		assert.Equal(t, 3.0, m["prop_proportion_height"])
+	})
+}
+
+func TestBarChart_MakeValid(t *testing.T) {
+	t.Run("when prop is empty, should use default", func(t *testing.T) {
+		// Arrange
+		sut := props.BarChart{FontSize: -1}
+
+		// Act
+		sut.MakeValid()
+
+		// Assert
+		assert.Len(t, sut.Palette, 8)
+		assert.Equal(t, "%g", sut.ValueFormat)
+		assert.Equal(t, 20.0, sut.GapPercent)
+		assert.Equal(t, 25.0, sut.LabelPercent)
+		assert.Equal(t, 0.0, sut.FontSize)
+		assert.Equal(t, &props.BlackColor, sut.AxisColor)
+		assert.Equal(t, props.Proportion{Width: 16, Height: 9}, sut.Proportion)
+	})
+	t.Run("when percentages are invalid, should use default", func(t *testing.T) {
+		// Arrange
+		sut := props.BarChart{GapPercent: 100, LabelPercent: -1}
+
+		// Act
+		sut.MakeValid()
+
+		// Assert
+		assert.Equal(t, 20.0, sut.GapPercent)
+		assert.Equal(t, 25.0, sut.LabelPercent)
+	})
+}
+
+func TestPieChart_ToMap(t *testing.T) {
+	t.Run("when prop is empty, should return empty map", func(t *testing.T) {
+		// Arrange
+		sut := props.PieChart{}
+
+		// Act
+		m := sut.ToMap()
+
+		// Assert
+		assert.Empty(t, m)
+	})
+	t.Run("when prop is filled, should return filled map", func(t *testing.T) {
+		// Arrange
+		sut := fixture.PieChartProp()
+
+		// Act
+		m := sut.ToMap()
+
+		// Assert
+		assert.Equal(t, []string{"RGB(100, 50, 200)", "RGB(255, 0, 0)"}, m["prop_palette"])
+		assert.Equal(t, 50.0, m["prop_hole_percent"])
+		assert.Equal(t, true, m["prop_show_values"])
+		assert.Equal(t, true, m["prop_show_legend"])
+		assert.Equal(t, "%.0f%%", m["prop_percent_format"])
+		assert.Equal(t, "RGB(255, 255, 255)", m["prop_slice_border_color"])
+		assert.Equal(t, 8.0, m["prop_font_size"])
+		assert.Equal(t, 4.0, m["prop_proportion_width"])
+		assert.Equal(t, 3.0, m["prop_proportion_height"])
+	})
+}
+
+func TestPieChart_MakeValid(t *testing.T) {
+	t.Run("when prop is empty, should use default", func(t *testing.T) {
+		// Arrange
+		sut := props.PieChart{HolePercent: 100, FontSize: -1}
+
+		// Act
+		sut.MakeValid()
+
+		// Assert
+		assert.Len(t, sut.Palette, 8)
+		assert.Equal(t, 0.0, sut.HolePercent)
+		assert.Equal(t, "%.1f%%", sut.PercentFormat)
+		assert.Equal(t, 0.0, sut.FontSize)
+		assert.Equal(t, props.Proportion{Width: 16, Height: 9}, sut.Proportion)
+	})
+}
+
+func TestLineChart_ToMap(t *testing.T) {
+	t.Run("when prop is empty, should return empty map", func(t *testing.T) {
+		// Arrange
+		sut := props.LineChart{}
+
+		// Act
+		m := sut.ToMap()
+
+		// Assert
+		assert.Empty(t, m)
+	})
+	t.Run("when prop is filled, should return filled map", func(t *testing.T) {
+		// Arrange
+		sut := fixture.LineChartProp()
+
+		// Act
+		m := sut.ToMap()
+
+		// Assert
+		assert.Equal(t, []string{"RGB(100, 50, 200)", "RGB(255, 0, 0)"}, m["prop_palette"])
+		assert.Equal(t, true, m["prop_area"])
+		assert.Equal(t, 50.0, m["prop_area_intensity"])
+		assert.Equal(t, 0.8, m["prop_line_thickness"])
+		assert.Equal(t, 2.0, m["prop_marker_size"])
+		assert.Equal(t, true, m["prop_show_grid"])
+		assert.Equal(t, "RGB(255, 255, 255)", m["prop_grid_color"])
+		assert.Equal(t, true, m["prop_show_legend"])
+		assert.Equal(t, true, m["prop_time_axis"])
+		assert.Equal(t, "15h", m["prop_time_format"])
+		assert.Equal(t, "%.1f", m["prop_x_format"])
+		assert.Equal(t, "%.2f", m["prop_value_format"])
+		assert.Equal(t, 5, m["prop_ticks"])
+		assert.Equal(t, 15.0, m["prop_label_percent"])
+		assert.Equal(t, 8.0, m["prop_font_size"])
+		assert.Equal(t, "RGB(100, 50, 200)", m["prop_axis_color"])
+		assert.Equal(t, 4.0, m["prop_proportion_width"])
+		assert.Equal(t, 3.0, m["prop_proportion_height"])
+	})
+}
+
+func TestLineChart_MakeValid(t *testing.T) {
+	t.Run("when prop is empty, should use default", func(t *testing.T) {
+		// Arrange
+		sut := props.LineChart{MarkerSize: -1, FontSize: -1}
+
+		// Act
+		sut.MakeValid()
+
+		// Assert
+		assert.Len(t, sut.Palette, 8)
+		assert.Equal(t, 30.0, sut.AreaIntensity)
+		assert.Equal(t, 0.4, sut.LineThickness)
+		assert.Equal(t, 0.0, sut.MarkerSize)
+		assert.Equal(t, &props.Color{Red: 220, Green: 220, Blue: 220}, sut.GridColor)
+		assert.Equal(t, "", sut.TimeFormat)
+		assert.Equal(t, "%g", sut.XFormat)
+		assert.Equal(t, "%g", sut.ValueFormat)
+		assert.Equal(t, 5, sut.Ticks)
+		assert.Equal(t, 12.0, sut.LabelPercent)
+		assert.Equal(t, 0.0, sut.FontSize)
+		assert.Equal(t, &props.BlackColor, sut.AxisColor)
+		assert.Equal(t, props.Proportion{Width: 16, Height: 9}, sut.Proportion)
+	})
+}
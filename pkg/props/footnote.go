@@ -0,0 +1,116 @@
+package props
+
+import (
+	"strings"
+
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
+)
+
+// Numbering is the representation of how the footnotes are numbered.
+type Numbering string
+
+const (
+	// PerDocument numbers the footnotes in sequence through the whole document.
+	PerDocument Numbering = "per_document"
+	// PerPage restarts the numbers of the footnotes on every page.
+	PerPage Numbering = "per_page"
+)
+
+// IsValid checks if the numbering is valid.
+func (n Numbering) IsValid() bool {
+	return n == PerDocument || n == PerPage
+}
+
+// Footnote represents properties from the footnotes written at the bottom of the pages.
+type Footnote struct {
+	// Numbering defines if the footnotes are numbered per document or per page, the default is per document.
+	Numbering Numbering
+	// Family of the footnotes, ex: consts.Arial, helvetica and etc.
+	Family string
+	// Style of the footnotes, ex: consts.Normal, bold and etc.
+	Style fontstyle.Type
+	// Size of the footnotes, the default is 80% of the default font size.
+	Size float64
+	// Color define the color of the footnotes.
+	Color *Color
+	// VerticalPadding define the space above each footnote.
+	VerticalPadding float64
+}
+
+// ToMap returns a map with the Footnote fields.
+func (f *Footnote) ToMap() map[string]interface{} {
+	m := make(map[string]interface{})
+
+	if f.Numbering != "" {
+		m["prop_numbering"] = f.Numbering
+	}
+
+	if f.Family != "" {
+		m["prop_font_family"] = f.Family
+	}
+
+	if f.Style != "" {
+		m["prop_font_style"] = f.Style
+	}
+
+	if f.Size != 0 {
+		m["prop_font_size"] = f.Size
+	}
+
+	if f.Color != nil {
+		m["prop_color"] = f.Color.ToString()
+	}
+
+	if f.VerticalPadding != 0 {
+		m["prop_vertical_padding"] = f.VerticalPadding
+	}
+
+	return m
+}
+
+// AppendMap appends the Footnote fields to a map.
+func (f *Footnote) AppendMap(m map[string]interface{}) map[string]interface{} {
+	for key, value := range f.ToMap() {
+		m["footnote_"+strings.TrimPrefix(key, "prop_")] = value
+	}
+
+	return m
+}
+
+// ToFontProp from Footnote return a Font based on Footnote.
+func (f *Footnote) ToFontProp() *Font {
+	return &Font{
+		Family: f.Family,
+		Style:  f.Style,
+		Size:   f.Size,
+		Color:  f.Color,
+	}
+}
+
+// MakeValid from Footnote define default values for a Footnote, the font of the footnotes
+// is a smaller default font when it is not defined.
+func (f *Footnote) MakeValid(font *Font) {
+	if !f.Numbering.IsValid() {
+		f.Numbering = PerDocument
+	}
+
+	if f.Family == "" {
+		f.Family = font.Family
+	}
+
+	if f.Style == "" {
+		f.Style = font.Style
+	}
+
+	if f.Size <= 0 {
+		f.Size = font.Size * 0.8
+	}
+
+	if f.Color == nil {
+		f.Color = font.Color
+	}
+
+	if f.VerticalPadding < 0 {
+		f.VerticalPadding = 0
+	}
+}
@@ -0,0 +1,102 @@
+package props_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontfamily"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+func TestNumbering_IsValid(t *testing.T) {
+	t.Run("when numbering is known, should be valid", func(t *testing.T) {
+		assert.True(t, props.PerDocument.IsValid())
+		assert.True(t, props.PerPage.IsValid())
+	})
+	t.Run("when numbering is unknown, should not be valid", func(t *testing.T) {
+		assert.False(t, props.Numbering("per_chapter").IsValid())
+	})
+}
+
+func TestFootnote_ToMap(t *testing.T) {
+	t.Run("when prop is empty, should return empty map", func(t *testing.T) {
+		// Arrange
+		sut := props.Footnote{}
+
+		// Act
+		m := sut.ToMap()
+
+		// Assert
+		assert.Empty(t, m)
+	})
+	t.Run("when prop is filled, should return filled map", func(t *testing.T) {
+		// Arrange
+		sut := fixture.FootnoteProp()
+
+		// Act
+		m := sut.ToMap()
+
+		// Assert
+		assert.Equal(t, props.PerPage, m["prop_numbering"])
+		assert.Equal(t, fontfamily.Helvetica, m["prop_font_family"])
+		assert.Equal(t, fontstyle.Bold, m["prop_font_style"])
+		assert.Equal(t, 8.0, m["prop_font_size"])
+		assert.Equal(t, "RGB(100, 50, 200)", m["prop_color"])
+		assert.Equal(t, 1.0, m["prop_vertical_padding"])
+	})
+}
+
+func TestFootnote_AppendMap(t *testing.T) {
+	t.Run("should append the fields with the footnote prefix", func(t *testing.T) {
+		// Arrange
+		sut := props.Footnote{Numbering: props.PerPage, Size: 8}
+
+		// Act
+		m := sut.AppendMap(map[string]interface{}{"config_debug": true})
+
+		// Assert
+		assert.Equal(t, map[string]interface{}{
+			"config_debug":       true,
+			"footnote_numbering": props.PerPage,
+			"footnote_font_size": 8.0,
+		}, m)
+	})
+}
+
+func TestFootnote_MakeValid(t *testing.T) {
+	t.Run("when prop is empty, should use a smaller default font and number per document", func(t *testing.T) {
+		// Arrange
+		font := fixture.FontProp()
+		sut := props.Footnote{Numbering: "per_chapter", VerticalPadding: -1}
+
+		// Act
+		sut.MakeValid(&font)
+
+		// Assert
+		assert.Equal(t, props.PerDocument, sut.Numbering)
+		assert.Equal(t, font.Family, sut.Family)
+		assert.Equal(t, font.Style, sut.Style)
+		assert.InDelta(t, font.Size*0.8, sut.Size, 0.0001)
+		assert.Equal(t, font.Color, sut.Color)
+		assert.Equal(t, 0.0, sut.VerticalPadding)
+	})
+}
+
+func TestFootnote_ToFontProp(t *testing.T) {
+	t.Run("should return the font of the footnotes", func(t *testing.T) {
+		// Arrange
+		sut := fixture.FootnoteProp()
+
+		// Act
+		font := sut.ToFontProp()
+
+		// Assert
+		assert.Equal(t, sut.Family, font.Family)
+		assert.Equal(t, sut.Style, font.Style)
+		assert.Equal(t, sut.Size, font.Size)
+		assert.Equal(t, sut.Color, font.Color)
+	})
+}
@@ -0,0 +1,47 @@
+package props
+
+import "github.com/johnfercher/maroto/v2/pkg/consts/pagesize"
+
+// HTML represents the properties used to convert an HTML fragment into rows.
+// Inline styles of the elements override these properties.
+type HTML struct {
+	// Paragraph defines the style of paragraphs and texts outside of other elements.
+	Paragraph Text
+	// ListItem defines the style of the bullet and numbered list items.
+	ListItem Text
+	// ListIndent defines the space added to the left of each nested list level.
+	ListIndent float64
+	// TableText defines the style and padding of the texts inside table cells.
+	TableText Text
+	// TableCell defines the style of the table cells, ex: borders.
+	TableCell *Cell
+	// Image defines the style of images.
+	Image Rect
+	// GridSize is the max grid size of the document, it is used to convert
+	// the table column widths into col sizes.
+	// Default: 12
+	GridSize int
+}
+
+// MakeValid from HTML define default values for an HTML.
+func (h *HTML) MakeValid() {
+	if h.Paragraph == (Text{}) {
+		h.Paragraph = Text{Bottom: 2}
+	}
+
+	if h.ListItem == (Text{}) {
+		h.ListItem = Text{Bottom: 1}
+	}
+
+	if h.ListIndent <= 0 {
+		h.ListIndent = 5
+	}
+
+	if h.TableText == (Text{}) {
+		h.TableText = Text{Top: 1, Bottom: 1, Left: 1, Right: 1}
+	}
+
+	if h.GridSize <= 0 {
+		h.GridSize = pagesize.DefaultMaxGridSum
+	}
+}
@@ -0,0 +1,52 @@
+package props_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+func TestHTML_MakeValid(t *testing.T) {
+	t.Run("when props are not defined, should use default", func(t *testing.T) {
+		// Arrange
+		sut := props.HTML{}
+
+		// Act
+		sut.MakeValid()
+
+		// Assert
+		assert.Equal(t, 2.0, sut.Paragraph.Bottom)
+		assert.Equal(t, 1.0, sut.ListItem.Bottom)
+		assert.Equal(t, 5.0, sut.ListIndent)
+		assert.Equal(t, props.Text{Top: 1, Bottom: 1, Left: 1, Right: 1}, sut.TableText)
+		assert.Nil(t, sut.TableCell)
+		assert.Equal(t, 12, sut.GridSize)
+	})
+	t.Run("when props are defined, should keep them", func(t *testing.T) {
+		// Arrange
+		textProp := fixture.TextProp()
+		cellProp := fixture.CellProp()
+		sut := props.HTML{
+			Paragraph:  textProp,
+			ListItem:   textProp,
+			ListIndent: 2,
+			TableText:  textProp,
+			TableCell:  &cellProp,
+			GridSize:   24,
+		}
+
+		// Act
+		sut.MakeValid()
+
+		// Assert
+		assert.Equal(t, textProp, sut.Paragraph)
+		assert.Equal(t, textProp, sut.ListItem)
+		assert.Equal(t, 2.0, sut.ListIndent)
+		assert.Equal(t, textProp, sut.TableText)
+		assert.Equal(t, &cellProp, sut.TableCell)
+		assert.Equal(t, 24, sut.GridSize)
+	})
+}
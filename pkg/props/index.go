@@ -0,0 +1,88 @@
+package props
+
+import "github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
+
+// Index represents properties from a back-of-book index.
+type Index struct {
+	// Family of the entries, ex: consts.Arial, helvetica and etc.
+	Family string
+	// Style of the entries, ex: consts.Normal, bold and etc.
+	Style fontstyle.Type
+	// Size of the entries.
+	Size float64
+	// Color define the color of the entries.
+	Color *Color
+	// RangeSeparator is written between the first and the last page of a range, the default is "–".
+	RangeSeparator string
+	// VerticalPadding define the space above each group of entries.
+	VerticalPadding float64
+}
+
+// ToMap returns a map with the Index fields.
+func (i *Index) ToMap() map[string]interface{} {
+	m := make(map[string]interface{})
+
+	if i.Family != "" {
+		m["prop_font_family"] = i.Family
+	}
+
+	if i.Style != "" {
+		m["prop_font_style"] = i.Style
+	}
+
+	if i.Size != 0 {
+		m["prop_font_size"] = i.Size
+	}
+
+	if i.Color != nil {
+		m["prop_color"] = i.Color.ToString()
+	}
+
+	if i.RangeSeparator != "" {
+		m["prop_range_separator"] = i.RangeSeparator
+	}
+
+	if i.VerticalPadding != 0 {
+		m["prop_vertical_padding"] = i.VerticalPadding
+	}
+
+	return m
+}
+
+// ToTextProp from Index return a Text based on Index.
+func (i *Index) ToTextProp() Text {
+	return Text{
+		Family: i.Family,
+		Style:  i.Style,
+		Size:   i.Size,
+		Color:  i.Color,
+	}
+}
+
+// MakeValid from Index define default values for an Index, the font of the entries
+// is the default font when it is not defined.
+func (i *Index) MakeValid(font *Font) {
+	if i.Family == "" {
+		i.Family = font.Family
+	}
+
+	if i.Style == "" {
+		i.Style = font.Style
+	}
+
+	if i.Size == 0 {
+		i.Size = font.Size
+	}
+
+	if i.Color == nil {
+		i.Color = font.Color
+	}
+
+	if i.RangeSeparator == "" {
+		i.RangeSeparator = "–"
+	}
+
+	if i.VerticalPadding < 0 {
+		i.VerticalPadding = 0
+	}
+}
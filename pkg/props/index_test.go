@@ -0,0 +1,75 @@
+package props_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontfamily"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+func TestIndex_ToMap(t *testing.T) {
+	t.Run("when prop is empty, should return empty map", func(t *testing.T) {
+		// Arrange
+		sut := props.Index{}
+
+		// Act
+		m := sut.ToMap()
+
+		// Assert
+		assert.Empty(t, m)
+	})
+	t.Run("when prop is filled, should return filled map", func(t *testing.T) {
+		// Arrange
+		sut := fixture.IndexProp()
+
+		// Act
+		m := sut.ToMap()
+
+		// Assert
+		assert.Equal(t, fontfamily.Helvetica, m["prop_font_family"])
+		assert.Equal(t, fontstyle.Bold, m["prop_font_style"])
+		assert.Equal(t, 14.0, m["prop_font_size"])
+		assert.Equal(t, "RGB(100, 50, 200)", m["prop_color"])
+		assert.Equal(t, "-", m["prop_range_separator"])
+		assert.Equal(t, 2.0, m["prop_vertical_padding"])
+	})
+}
+
+func TestIndex_MakeValid(t *testing.T) {
+	t.Run("when prop is empty, should use the default font and values", func(t *testing.T) {
+		// Arrange
+		font := fixture.FontProp()
+		sut := props.Index{VerticalPadding: -1}
+
+		// Act
+		sut.MakeValid(&font)
+
+		// Assert
+		assert.Equal(t, font.Family, sut.Family)
+		assert.Equal(t, font.Style, sut.Style)
+		assert.Equal(t, font.Size, sut.Size)
+		assert.Equal(t, font.Color, sut.Color)
+		assert.Equal(t, "–", sut.RangeSeparator)
+		assert.Equal(t, 0.0, sut.VerticalPadding)
+	})
+}
+
+func TestIndex_ToTextProp(t *testing.T) {
+	t.Run("should return the text of the entries", func(t *testing.T) {
+		// Arrange
+		sut := fixture.IndexProp()
+
+		// Act
+		text := sut.ToTextProp()
+
+		// Assert
+		assert.Equal(t, sut.Family, text.Family)
+		assert.Equal(t, sut.Style, text.Style)
+		assert.Equal(t, sut.Size, text.Size)
+		assert.Equal(t, sut.Color, text.Color)
+	})
+}
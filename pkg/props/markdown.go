@@ -0,0 +1,86 @@
+package props
+
+import (
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontfamily"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
+)
+
+// Markdown represents the mapping between markdown elements and the properties used to render them.
+// An element without properties uses the default ones, font family, size and color
+// not defined are taken from the document default font.
+type Markdown struct {
+	// Heading1 to Heading6 define the style of the headings (#, ##, ...).
+	Heading1 Text
+	Heading2 Text
+	Heading3 Text
+	Heading4 Text
+	Heading5 Text
+	Heading6 Text
+	// Paragraph defines the style of the paragraphs.
+	Paragraph Text
+	// ListItem defines the style of the bullet and numbered list items.
+	ListItem Text
+	// ListIndent defines the space added to the left of each nested list level.
+	ListIndent float64
+	// Code defines the style of code blocks and inline code, Top and Bottom are
+	// applied to the whole block.
+	Code Text
+	// CodeBackground defines the background color of code blocks.
+	CodeBackground *Color
+	// Rule defines the style of horizontal rules.
+	Rule Line
+	// RuleHeight defines the height of the row which contains a horizontal rule.
+	RuleHeight float64
+	// Image defines the style of images.
+	Image Rect
+}
+
+// MakeValid from Markdown define default values for a Markdown.
+func (m *Markdown) MakeValid() {
+	defaultHeadings := []struct {
+		text *Text
+		size float64
+		top  float64
+	}{
+		{&m.Heading1, 18, 4},
+		{&m.Heading2, 16, 4},
+		{&m.Heading3, 14, 3},
+		{&m.Heading4, 12, 3},
+		{&m.Heading5, 11, 2},
+		{&m.Heading6, 10, 2},
+	}
+
+	for _, heading := range defaultHeadings {
+		if *heading.text == (Text{}) {
+			*heading.text = Text{Style: fontstyle.Bold, Size: heading.size, Top: heading.top, Bottom: 2}
+		}
+	}
+
+	if m.Paragraph == (Text{}) {
+		m.Paragraph = Text{Bottom: 2}
+	}
+
+	if m.ListItem == (Text{}) {
+		m.ListItem = Text{Bottom: 1}
+	}
+
+	if m.ListIndent <= 0 {
+		m.ListIndent = 5
+	}
+
+	if m.Code == (Text{}) {
+		m.Code = Text{Family: fontfamily.Courier, Size: 9, Left: 2, Right: 2, Top: 1, Bottom: 1}
+	}
+
+	if m.CodeBackground == nil {
+		m.CodeBackground = &Color{Red: 240, Green: 240, Blue: 240}
+	}
+
+	if m.Rule == (Line{}) {
+		m.Rule = Line{OffsetPercent: 50, SizePercent: 100}
+	}
+
+	if m.RuleHeight <= 0 {
+		m.RuleHeight = 4
+	}
+}
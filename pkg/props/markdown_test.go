@@ -0,0 +1,63 @@
+package props_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontfamily"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+func TestMarkdown_MakeValid(t *testing.T) {
+	t.Run("when props are not defined, should use default", func(t *testing.T) {
+		// Arrange
+		sut := props.Markdown{}
+
+		// Act
+		sut.MakeValid()
+
+		// Assert
+		assert.Equal(t, fontstyle.Bold, sut.Heading1.Style)
+		assert.Equal(t, 18.0, sut.Heading1.Size)
+		assert.Equal(t, 10.0, sut.Heading6.Size)
+		assert.Equal(t, 2.0, sut.Paragraph.Bottom)
+		assert.Equal(t, 1.0, sut.ListItem.Bottom)
+		assert.Equal(t, 5.0, sut.ListIndent)
+		assert.Equal(t, fontfamily.Courier, sut.Code.Family)
+		assert.Equal(t, &props.Color{Red: 240, Green: 240, Blue: 240}, sut.CodeBackground)
+		assert.Equal(t, 50.0, sut.Rule.OffsetPercent)
+		assert.Equal(t, 4.0, sut.RuleHeight)
+	})
+	t.Run("when props are defined, should keep them", func(t *testing.T) {
+		// Arrange
+		textProp := fixture.TextProp()
+		lineProp := fixture.LineProp()
+		color := fixture.ColorProp()
+		sut := props.Markdown{
+			Heading1:       textProp,
+			Paragraph:      textProp,
+			ListItem:       textProp,
+			ListIndent:     2,
+			Code:           textProp,
+			CodeBackground: &color,
+			Rule:           lineProp,
+			RuleHeight:     1,
+		}
+
+		// Act
+		sut.MakeValid()
+
+		// Assert
+		assert.Equal(t, textProp, sut.Heading1)
+		assert.Equal(t, textProp, sut.Paragraph)
+		assert.Equal(t, textProp, sut.ListItem)
+		assert.Equal(t, 2.0, sut.ListIndent)
+		assert.Equal(t, textProp, sut.Code)
+		assert.Equal(t, &color, sut.CodeBackground)
+		assert.Equal(t, lineProp, sut.Rule)
+		assert.Equal(t, 1.0, sut.RuleHeight)
+	})
+}
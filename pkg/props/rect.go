@@ -15,6 +15,8 @@ type Rect struct {
 	JustReferenceWidth bool
 	// Center define that the rectangle will be vertically and horizontally centralized.
 	Center bool
+	// Frame is the index of the page drawn from a multi-page image, like a TIFF, starting at 0.
+	Frame int
 }
 
 // ToMap from Rect will return a map representation from Rect.
@@ -40,6 +42,10 @@ func (r *Rect) ToMap() map[string]interface{} {
 	if r.JustReferenceWidth {
 		m["prop_just_reference_Width"] = r.JustReferenceWidth
 	}
+
+	if r.Frame != 0 {
+		m["prop_frame"] = r.Frame
+	}
 	return m
 }
 
@@ -66,4 +72,8 @@ func (r *Rect) MakeValid() {
 	if r.Top < minValue {
 		r.Top = minValue
 	}
+
+	if r.Frame < 0 {
+		r.Frame = 0
+	}
 }
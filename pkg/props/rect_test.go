@@ -61,12 +61,23 @@ func TestRect_MakeValid(t *testing.T) {
 		// Assert
 		assert.Equal(t, prop.Top, 0.0)
 	})
+	t.Run("when frame is less than 0, should become 0", func(t *testing.T) {
+		// Arrange
+		prop := props.Rect{Frame: -1}
+
+		// Act
+		prop.MakeValid()
+
+		// Assert
+		assert.Equal(t, prop.Frame, 0)
+	})
 }
 
 func TestRect_ToMap(t *testing.T) {
 	// Arrange
 	sut := fixture.RectProp()
 	sut.Center = true
+	sut.Frame = 2
 
 	// Act
 	m := sut.ToMap()
@@ -76,4 +87,5 @@ func TestRect_ToMap(t *testing.T) {
 	assert.Equal(t, 10.0, m["prop_top"])
 	assert.Equal(t, 98.0, m["prop_percent"])
 	assert.Equal(t, true, m["prop_center"])
+	assert.Equal(t, 2, m["prop_frame"])
 }
@@ -0,0 +1,151 @@
+package props
+
+import (
+	"github.com/johnfercher/maroto/v2/pkg/consts/align"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
+)
+
+// Span represents properties from a piece of text inside a RichText.
+type Span struct {
+	// Family of the text, ex: consts.Arial, helvetica and etc.
+	Family string
+	// Style of the text, ex: consts.Normal, bold and etc.
+	Style fontstyle.Type
+	// Size of the text.
+	Size float64
+	// Color define the font style color.
+	Color *Color
+	// Hyperlink define a link to be opened when the text is clicked.
+	Hyperlink *string
+	// BaselineShift is the amount of space the text is moved up from the line baseline,
+	// positive values create a superscript and negative values a subscript.
+	BaselineShift float64
+}
+
+// RichText represents properties from a paragraph composed by spans.
+type RichText struct {
+	// Top is the amount of space between the upper cell limit and the text.
+	Top float64
+	// Bottom is the amount of space between the lower cell limit and the text. (Used by auto row only)
+	Bottom float64
+	// Left is the minimal amount of space between the left cell boundary and the text.
+	Left float64
+	// Right is the minimal amount of space between the right cell boundary and the text.
+	Right float64
+	// Align of the text.
+	Align align.Type
+	// VerticalPadding define an additional space between lines.
+	VerticalPadding float64
+}
+
+// ToMap converts a Span to a map.
+func (s *Span) ToMap() map[string]interface{} {
+	m := make(map[string]interface{})
+
+	if s.Family != "" {
+		m["prop_font_family"] = s.Family
+	}
+
+	if s.Style != "" {
+		m["prop_font_style"] = s.Style
+	}
+
+	if s.Size != 0 {
+		m["prop_font_size"] = s.Size
+	}
+
+	if s.Color != nil {
+		m["prop_color"] = s.Color.ToString()
+	}
+
+	if s.Hyperlink != nil {
+		m["prop_hyperlink"] = *s.Hyperlink
+	}
+
+	if s.BaselineShift != 0 {
+		m["prop_baseline_shift"] = s.BaselineShift
+	}
+
+	return m
+}
+
+// MakeValid from Span define default values for a Span.
+func (s *Span) MakeValid(font *Font) {
+	undefinedValue := 0.0
+
+	if s.Family == "" {
+		s.Family = font.Family
+	}
+
+	if s.Style == "" {
+		s.Style = font.Style
+	}
+
+	if s.Size == undefinedValue {
+		s.Size = font.Size
+	}
+
+	if s.Color == nil {
+		s.Color = font.Color
+	}
+}
+
+// ToMap converts a RichText to a map.
+func (r *RichText) ToMap() map[string]interface{} {
+	m := make(map[string]interface{})
+
+	if r.Top != 0 {
+		m["prop_top"] = r.Top
+	}
+
+	if r.Bottom != 0 {
+		m["prop_bottom"] = r.Bottom
+	}
+
+	if r.Left != 0 {
+		m["prop_left"] = r.Left
+	}
+
+	if r.Right != 0 {
+		m["prop_right"] = r.Right
+	}
+
+	if r.Align != "" {
+		m["prop_align"] = r.Align
+	}
+
+	if r.VerticalPadding != 0 {
+		m["prop_vertical_padding"] = r.VerticalPadding
+	}
+
+	return m
+}
+
+// MakeValid from RichText define default values for a RichText.
+func (r *RichText) MakeValid() {
+	minValue := 0.0
+
+	if r.Align == "" {
+		r.Align = align.Left
+	}
+
+	if r.Top < minValue {
+		r.Top = minValue
+	}
+
+	if r.Bottom < minValue {
+		r.Bottom = minValue
+	}
+
+	if r.Left < minValue {
+		r.Left = minValue
+	}
+
+	if r.Right < minValue {
+		r.Right = minValue
+	}
+
+	if r.VerticalPadding < minValue {
+		r.VerticalPadding = minValue
+	}
+}
@@ -0,0 +1,128 @@
+package props_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/pkg/consts/align"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontfamily"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+func TestSpan_ToMap(t *testing.T) {
+	t.Run("when prop is empty, should return empty map", func(t *testing.T) {
+		// Arrange
+		sut := props.Span{}
+
+		// Act
+		m := sut.ToMap()
+
+		// Assert
+		assert.Empty(t, m)
+	})
+	t.Run("when prop is filled, should return filled map", func(t *testing.T) {
+		// Arrange
+		sut := fixture.SpanProp()
+
+		// Act
+		m := sut.ToMap()
+
+		// Assert
+		assert.Equal(t, fontfamily.Courier, m["prop_font_family"])
+		assert.Equal(t, fontstyle.Italic, m["prop_font_style"])
+		assert.Equal(t, 8.0, m["prop_font_size"])
+		assert.Equal(t, "RGB(100, 50, 200)", m["prop_color"])
+		assert.Equal(t, "https://www.google.com", m["prop_hyperlink"])
+		assert.Equal(t, 2.0, m["prop_baseline_shift"])
+	})
+}
+
+func TestSpan_MakeValid(t *testing.T) {
+	t.Run("when font is not defined, should use the default font", func(t *testing.T) {
+		// Arrange
+		font := fixture.FontProp()
+		sut := props.Span{}
+
+		// Act
+		sut.MakeValid(&font)
+
+		// Assert
+		assert.Equal(t, font.Family, sut.Family)
+		assert.Equal(t, font.Style, sut.Style)
+		assert.Equal(t, font.Size, sut.Size)
+		assert.Equal(t, font.Color, sut.Color)
+	})
+	t.Run("when font is defined, should keep it", func(t *testing.T) {
+		// Arrange
+		font := fixture.FontProp()
+		color := &props.Color{Red: 1}
+		sut := props.Span{Family: fontfamily.Courier, Style: fontstyle.Italic, Size: 5, Color: color}
+
+		// Act
+		sut.MakeValid(&font)
+
+		// Assert
+		assert.Equal(t, fontfamily.Courier, sut.Family)
+		assert.Equal(t, fontstyle.Italic, sut.Style)
+		assert.Equal(t, 5.0, sut.Size)
+		assert.Equal(t, color, sut.Color)
+	})
+}
+
+func TestRichText_ToMap(t *testing.T) {
+	t.Run("when prop is empty, should return empty map", func(t *testing.T) {
+		// Arrange
+		sut := props.RichText{}
+
+		// Act
+		m := sut.ToMap()
+
+		// Assert
+		assert.Empty(t, m)
+	})
+	t.Run("when prop is filled, should return filled map", func(t *testing.T) {
+		// Arrange
+		sut := fixture.RichTextProp()
+
+		// Act
+		m := sut.ToMap()
+
+		// Assert
+		assert.Equal(t, 12.0, m["prop_top"])
+		assert.Equal(t, 13.0, m["prop_bottom"])
+		assert.Equal(t, 3.0, m["prop_left"])
+		assert.Equal(t, 4.0, m["prop_right"])
+		assert.Equal(t, align.Center, m["prop_align"])
+		assert.Equal(t, 2.0, m["prop_vertical_padding"])
+	})
+}
+
+func TestRichText_MakeValid(t *testing.T) {
+	t.Run("when align is not defined, should define left", func(t *testing.T) {
+		// Arrange
+		sut := props.RichText{}
+
+		// Act
+		sut.MakeValid()
+
+		// Assert
+		assert.Equal(t, align.Left, sut.Align)
+	})
+	t.Run("when spaces are less than zero, should become zero", func(t *testing.T) {
+		// Arrange
+		sut := props.RichText{Top: -1, Bottom: -1, Left: -1, Right: -1, VerticalPadding: -1}
+
+		// Act
+		sut.MakeValid()
+
+		// Assert
+		assert.Equal(t, 0.0, sut.Top)
+		assert.Equal(t, 0.0, sut.Bottom)
+		assert.Equal(t, 0.0, sut.Left)
+		assert.Equal(t, 0.0, sut.Right)
+		assert.Equal(t, 0.0, sut.VerticalPadding)
+	})
+}
@@ -0,0 +1,61 @@
+package props
+
+import (
+	"github.com/johnfercher/maroto/v2/pkg/consts/linestyle"
+)
+
+// Shape represents properties from a shape drawn inside a cell.
+type Shape struct {
+	// FillColor define the color used to fill the shape, when nil the shape is not filled.
+	FillColor *Color
+	// BorderColor define the color of the shape border, when nil the border is not drawn.
+	BorderColor *Color
+	// BorderThickness define the thickness of the shape border.
+	BorderThickness float64
+	// LineStyle define the style of the shape border (solid or dashed).
+	LineStyle linestyle.Type
+	// CornerRadius define the radius of the corners of rectangles, when zero the corners are square.
+	CornerRadius float64
+}
+
+// ToMap returns a map with the Shape fields.
+func (s *Shape) ToMap() map[string]interface{} {
+	m := make(map[string]interface{})
+
+	if s.FillColor != nil {
+		m["prop_fill_color"] = s.FillColor.ToString()
+	}
+
+	if s.BorderColor != nil {
+		m["prop_border_color"] = s.BorderColor.ToString()
+	}
+
+	if s.BorderThickness != 0 {
+		m["prop_border_thickness"] = s.BorderThickness
+	}
+
+	if s.LineStyle != "" {
+		m["prop_line_style"] = s.LineStyle
+	}
+
+	if s.CornerRadius != 0 {
+		m["prop_corner_radius"] = s.CornerRadius
+	}
+
+	return m
+}
+
+// MakeValid from Shape define default values for a Shape.
+func (s *Shape) MakeValid() {
+	if s.BorderThickness <= 0 {
+		s.BorderThickness = linestyle.DefaultLineThickness
+	}
+
+	if s.LineStyle == "" {
+		s.LineStyle = linestyle.Solid
+	}
+
+	if s.CornerRadius < 0 {
+		s.CornerRadius = 0
+	}
+}
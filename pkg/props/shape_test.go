@@ -0,0 +1,53 @@
+package props_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/pkg/consts/linestyle"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+func TestShape_ToMap(t *testing.T) {
+	t.Run("when prop is empty, should return empty map", func(t *testing.T) {
+		// Arrange
+		sut := props.Shape{}
+
+		// Act
+		m := sut.ToMap()
+
+		// Assert
+		assert.Empty(t, m)
+	})
+	t.Run("when prop is filled, should return filled map", func(t *testing.T) {
+		// Arrange
+		sut := fixture.ShapeProp()
+
+		// Act
+		m := sut.ToMap()
+
+		// Assert
+		assert.Equal(t, "RGB(100, 50, 200)", m["prop_fill_color"])
+		assert.Equal(t, "RGB(100, 50, 200)", m["prop_border_color"])
+		assert.Equal(t, 0.5, m["prop_border_thickness"])
+		assert.Equal(t, linestyle.Dashed, m["prop_line_style"])
+		assert.Equal(t, 2.0, m["prop_corner_radius"])
+	})
+}
+
+func TestShape_MakeValid(t *testing.T) {
+	t.Run("when border is not defined, should use default", func(t *testing.T) {
+		// Arrange
+		sut := props.Shape{BorderThickness: -1, CornerRadius: -1}
+
+		// Act
+		sut.MakeValid()
+
+		// Assert
+		assert.Equal(t, linestyle.DefaultLineThickness, sut.BorderThickness)
+		assert.Equal(t, linestyle.Solid, sut.LineStyle)
+		assert.Equal(t, 0.0, sut.CornerRadius)
+	})
+}
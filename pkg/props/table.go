@@ -0,0 +1,63 @@
+package props
+
+import (
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
+)
+
+// Table represents properties from a Table.
+type Table struct {
+	// HeaderStyle defines the cell style applied to every header cell.
+	HeaderStyle *Cell
+	// HeaderFontStyle defines the font style of the header titles.
+	// Default: normal
+	HeaderFontStyle fontstyle.Type
+	// StripeColor defines the background color applied to every other content row,
+	// when nil the content rows are not striped.
+	StripeColor *Color
+	// FooterStyle defines the cell style applied to every footer cell.
+	FooterStyle *Cell
+	// FooterFontStyle defines the font style of the footer values.
+	// Default: normal
+	FooterFontStyle fontstyle.Type
+	// RowHeight defines the height of header, content and footer rows,
+	// when zero the rows have automatic height.
+	RowHeight float64
+}
+
+// ToMap returns a map with the Table fields.
+func (t *Table) ToMap() map[string]interface{} {
+	m := make(map[string]interface{})
+
+	if t.HeaderFontStyle != "" {
+		m["prop_header_font_style"] = t.HeaderFontStyle
+	}
+
+	if t.StripeColor != nil {
+		m["prop_stripe_color"] = t.StripeColor.ToString()
+	}
+
+	if t.FooterFontStyle != "" {
+		m["prop_footer_font_style"] = t.FooterFontStyle
+	}
+
+	if t.RowHeight != 0 {
+		m["prop_row_height"] = t.RowHeight
+	}
+
+	return m
+}
+
+// MakeValid from Table define default values for a Table.
+func (t *Table) MakeValid() {
+	if !t.HeaderFontStyle.IsValid() {
+		t.HeaderFontStyle = fontstyle.Normal
+	}
+
+	if !t.FooterFontStyle.IsValid() {
+		t.FooterFontStyle = fontstyle.Normal
+	}
+
+	if t.RowHeight < 0 {
+		t.RowHeight = 0
+	}
+}
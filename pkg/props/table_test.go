@@ -0,0 +1,61 @@
+package props_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+func TestTable_ToMap(t *testing.T) {
+	t.Run("when prop is empty, should return empty map", func(t *testing.T) {
+		// Arrange
+		sut := props.Table{}
+
+		// Act
+		m := sut.ToMap()
+
+		// Assert
+		assert.Empty(t, m)
+	})
+	t.Run("when prop is filled, should return filled map", func(t *testing.T) {
+		// Arrange
+		sut := fixture.TableProp()
+
+		// Act
+		m := sut.ToMap()
+
+		// Assert
+		assert.Equal(t, fontstyle.BoldItalic, m["prop_header_font_style"])
+		assert.Equal(t, "RGB(100, 50, 200)", m["prop_stripe_color"])
+		assert.Equal(t, fontstyle.Italic, m["prop_footer_font_style"])
+		assert.Equal(t, 7.0, m["prop_row_height"])
+	})
+}
+
+func TestTable_MakeValid(t *testing.T) {
+	t.Run("when font styles are invalid, should become normal", func(t *testing.T) {
+		// Arrange
+		sut := props.Table{HeaderFontStyle: "invalid", FooterFontStyle: "invalid"}
+
+		// Act
+		sut.MakeValid()
+
+		// Assert
+		assert.Equal(t, fontstyle.Normal, sut.HeaderFontStyle)
+		assert.Equal(t, fontstyle.Normal, sut.FooterFontStyle)
+	})
+	t.Run("when row height is less than zero, should become zero", func(t *testing.T) {
+		// Arrange
+		sut := props.Table{RowHeight: -1}
+
+		// Act
+		sut.MakeValid()
+
+		// Assert
+		assert.Equal(t, 0.0, sut.RowHeight)
+	})
+}
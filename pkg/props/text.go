@@ -2,9 +2,12 @@
 package props
 
 import (
+	"math"
+
 	"github.com/johnfercher/maroto/v2/pkg/consts/align"
 	"github.com/johnfercher/maroto/v2/pkg/consts/breakline"
 	"github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
+	"github.com/johnfercher/maroto/v2/pkg/consts/overflow"
 )
 
 // Text represents properties from a Text inside a cell.
@@ -33,6 +36,20 @@ type Text struct {
 	Color *Color
 	// Hyperlink define a link to be opened when the text is clicked.
 	Hyperlink *string
+	// InternalLink define the name of an anchor of the document to go to when the text is clicked.
+	InternalLink *string
+	// Rotation is the angle, in degrees counterclockwise, in which the text is written, ex: 90 writes
+	// from bottom to top and 270 from top to bottom. A vertical text wraps against the height of the cell.
+	Rotation float64
+	// MaxLines is the maximum quantity of lines written, the lines beyond it are hidden. Zero means no limit.
+	MaxLines int
+	// Overflow defines what happens with the lines which don't fit the height of the cell, the default is visible.
+	Overflow overflow.Type
+	// ShrinkToFit lowers the font size, down to MinSize, until the lines fit the cell and MaxLines.
+	// An automatic row is as tall as its text, so the text only shrinks to fit MaxLines.
+	ShrinkToFit bool
+	// MinSize is the smallest font size used by ShrinkToFit, the default is half of the size.
+	MinSize float64
 }
 
 // ToMap converts a Text to a map.
@@ -85,6 +102,30 @@ func (t *Text) ToMap() map[string]interface{} {
 		m["prop_hyperlink"] = *t.Hyperlink
 	}
 
+	if t.InternalLink != nil {
+		m["prop_internal_link"] = *t.InternalLink
+	}
+
+	if t.Rotation != 0 {
+		m["prop_rotation"] = t.Rotation
+	}
+
+	if t.MaxLines != 0 {
+		m["prop_max_lines"] = t.MaxLines
+	}
+
+	if t.Overflow != "" {
+		m["prop_overflow"] = t.Overflow
+	}
+
+	if t.ShrinkToFit {
+		m["prop_shrink_to_fit"] = t.ShrinkToFit
+	}
+
+	if t.MinSize != 0 {
+		m["prop_min_size"] = t.MinSize
+	}
+
 	return m
 }
 
@@ -136,4 +177,25 @@ func (t *Text) MakeValid(font *Font) {
 	if t.BreakLineStrategy == "" {
 		t.BreakLineStrategy = breakline.EmptySpaceStrategy
 	}
+
+	if t.MaxLines < 0 {
+		t.MaxLines = 0
+	}
+
+	if !t.Overflow.IsValid() {
+		t.Overflow = ""
+	}
+
+	if t.ShrinkToFit && t.MinSize <= 0 {
+		t.MinSize = t.Size / 2
+	}
+
+	if t.MinSize > t.Size {
+		t.MinSize = t.Size
+	}
+
+	t.Rotation = math.Mod(t.Rotation, 360)
+	if t.Rotation < 0 {
+		t.Rotation += 360
+	}
 }
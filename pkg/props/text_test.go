@@ -8,6 +8,7 @@ import (
 	"github.com/johnfercher/maroto/v2/pkg/consts/align"
 	"github.com/johnfercher/maroto/v2/pkg/consts/fontfamily"
 	"github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
+	"github.com/johnfercher/maroto/v2/pkg/consts/overflow"
 	"github.com/johnfercher/maroto/v2/pkg/props"
 )
 
@@ -89,6 +90,61 @@ func TestText_MakeValid(t *testing.T) {
 				assert.Equal(t, prop.VerticalPadding, 0.0)
 			},
 		},
+		{
+			"When rotation is negative, should become the equivalent positive angle",
+			&props.Text{
+				Rotation: -90,
+			},
+			func(t *testing.T, prop *props.Text) {
+				assert.Equal(t, prop.Rotation, 270.0)
+			},
+		},
+		{
+			"When rotation is greater than a full turn, should become the equivalent angle",
+			&props.Text{
+				Rotation: 450,
+			},
+			func(t *testing.T, prop *props.Text) {
+				assert.Equal(t, prop.Rotation, 90.0)
+			},
+		},
+		{
+			"When max lines is less than 0, should become 0",
+			&props.Text{
+				MaxLines: -1,
+			},
+			func(t *testing.T, prop *props.Text) {
+				assert.Equal(t, prop.MaxLines, 0)
+			},
+		},
+		{
+			"When overflow is not valid, should become visible",
+			&props.Text{
+				Overflow: "invalid",
+			},
+			func(t *testing.T, prop *props.Text) {
+				assert.Equal(t, prop.Overflow, overflow.Type(""))
+			},
+		},
+		{
+			"When shrink to fit is set without min size, should define half of the size",
+			&props.Text{
+				ShrinkToFit: true,
+			},
+			func(t *testing.T, prop *props.Text) {
+				assert.Equal(t, prop.MinSize, 5.0)
+			},
+		},
+		{
+			"When min size is greater than size, should become the size",
+			&props.Text{
+				Size:    8,
+				MinSize: 12,
+			},
+			func(t *testing.T, prop *props.Text) {
+				assert.Equal(t, prop.MinSize, 8.0)
+			},
+		},
 	}
 
 	for _, c := range cases {
@@ -96,3 +152,20 @@ func TestText_MakeValid(t *testing.T) {
 		c.assert(t, c.fontProp)
 	}
 }
+
+func TestText_ToMap(t *testing.T) {
+	t.Run("when overflow props are sent, should map them", func(t *testing.T) {
+		// Arrange
+		sut := props.Text{MaxLines: 2, Overflow: overflow.Ellipsis, ShrinkToFit: true, MinSize: 6, Rotation: 90}
+
+		// Act
+		m := sut.ToMap()
+
+		// Assert
+		assert.Equal(t, 2, m["prop_max_lines"])
+		assert.Equal(t, overflow.Ellipsis, m["prop_overflow"])
+		assert.Equal(t, true, m["prop_shrink_to_fit"])
+		assert.Equal(t, 6.0, m["prop_min_size"])
+		assert.Equal(t, 90.0, m["prop_rotation"])
+	})
+}
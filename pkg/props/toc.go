@@ -0,0 +1,98 @@
+package props
+
+import "github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
+
+// TOC represents properties from a table of contents.
+type TOC struct {
+	// Family of the entries, ex: consts.Arial, helvetica and etc.
+	Family string
+	// Style of the entries, ex: consts.Normal, bold and etc.
+	Style fontstyle.Type
+	// Size of the entries.
+	Size float64
+	// Color define the color of the entries.
+	Color *Color
+	// Indent is the space added before the title for each level of the entry, the default is 5.
+	Indent float64
+	// Leader is the text repeated between the title and the page number, the default is ".".
+	Leader string
+	// VerticalPadding define the space below each entry.
+	VerticalPadding float64
+}
+
+// ToMap returns a map with the TOC fields.
+func (t *TOC) ToMap() map[string]interface{} {
+	m := make(map[string]interface{})
+
+	if t.Family != "" {
+		m["prop_font_family"] = t.Family
+	}
+
+	if t.Style != "" {
+		m["prop_font_style"] = t.Style
+	}
+
+	if t.Size != 0 {
+		m["prop_font_size"] = t.Size
+	}
+
+	if t.Color != nil {
+		m["prop_color"] = t.Color.ToString()
+	}
+
+	if t.Indent != 0 {
+		m["prop_indent"] = t.Indent
+	}
+
+	if t.Leader != "" {
+		m["prop_leader"] = t.Leader
+	}
+
+	if t.VerticalPadding != 0 {
+		m["prop_vertical_padding"] = t.VerticalPadding
+	}
+
+	return m
+}
+
+// ToFontProp from TOC return a Font based on TOC.
+func (t *TOC) ToFontProp() *Font {
+	return &Font{
+		Family: t.Family,
+		Style:  t.Style,
+		Size:   t.Size,
+		Color:  t.Color,
+	}
+}
+
+// MakeValid from TOC define default values for a TOC, the font of the entries
+// is the default font when it is not defined.
+func (t *TOC) MakeValid(font *Font) {
+	if t.Family == "" {
+		t.Family = font.Family
+	}
+
+	if t.Style == "" {
+		t.Style = font.Style
+	}
+
+	if t.Size == 0 {
+		t.Size = font.Size
+	}
+
+	if t.Color == nil {
+		t.Color = font.Color
+	}
+
+	if t.Indent <= 0 {
+		t.Indent = 5
+	}
+
+	if t.Leader == "" {
+		t.Leader = "."
+	}
+
+	if t.VerticalPadding < 0 {
+		t.VerticalPadding = 0
+	}
+}
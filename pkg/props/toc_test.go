@@ -0,0 +1,77 @@
+package props_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontfamily"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+func TestTOC_ToMap(t *testing.T) {
+	t.Run("when prop is empty, should return empty map", func(t *testing.T) {
+		// Arrange
+		sut := props.TOC{}
+
+		// Act
+		m := sut.ToMap()
+
+		// Assert
+		assert.Empty(t, m)
+	})
+	t.Run("when prop is filled, should return filled map", func(t *testing.T) {
+		// Arrange
+		sut := fixture.TOCProp()
+
+		// Act
+		m := sut.ToMap()
+
+		// Assert
+		assert.Equal(t, fontfamily.Helvetica, m["prop_font_family"])
+		assert.Equal(t, fontstyle.Bold, m["prop_font_style"])
+		assert.Equal(t, 14.0, m["prop_font_size"])
+		assert.Equal(t, "RGB(100, 50, 200)", m["prop_color"])
+		assert.Equal(t, 4.0, m["prop_indent"])
+		assert.Equal(t, "-", m["prop_leader"])
+		assert.Equal(t, 1.0, m["prop_vertical_padding"])
+	})
+}
+
+func TestTOC_MakeValid(t *testing.T) {
+	t.Run("when prop is empty, should use the default font and values", func(t *testing.T) {
+		// Arrange
+		font := fixture.FontProp()
+		sut := props.TOC{VerticalPadding: -1}
+
+		// Act
+		sut.MakeValid(&font)
+
+		// Assert
+		assert.Equal(t, font.Family, sut.Family)
+		assert.Equal(t, font.Style, sut.Style)
+		assert.Equal(t, font.Size, sut.Size)
+		assert.Equal(t, font.Color, sut.Color)
+		assert.Equal(t, 5.0, sut.Indent)
+		assert.Equal(t, ".", sut.Leader)
+		assert.Equal(t, 0.0, sut.VerticalPadding)
+	})
+}
+
+func TestTOC_ToFontProp(t *testing.T) {
+	t.Run("should return the font of the entries", func(t *testing.T) {
+		// Arrange
+		sut := fixture.TOCProp()
+
+		// Act
+		font := sut.ToFontProp()
+
+		// Assert
+		assert.Equal(t, sut.Family, font.Family)
+		assert.Equal(t, sut.Style, font.Style)
+		assert.Equal(t, sut.Size, font.Size)
+		assert.Equal(t, sut.Color, font.Color)
+	})
+}
@@ -0,0 +1,129 @@
+package props
+
+import (
+	"strings"
+
+	"github.com/johnfercher/maroto/v2/pkg/consts/align"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
+)
+
+// Watermark represents properties from a text or an image drawn across a page.
+type Watermark struct {
+	// Family of the text, ex: consts.Arial, helvetica and etc.
+	Family string
+	// Style of the text, ex: consts.Normal, bold and etc.
+	Style fontstyle.Type
+	// Size of the text, the default is 80.
+	Size float64
+	// Color define the color of the text.
+	Color *Color
+	// Angle is the rotation of the watermark in degrees counterclockwise, ex: 45 writes it diagonally.
+	Angle float64
+	// Opacity is how opaque the watermark is, from 0 to 1, the default is 0.3.
+	Opacity float64
+	// Percent is the width of an image watermark relative to the width of the page, the default is 50.
+	Percent float64
+	// Place defines where the watermark is placed in the page, the default is the center of the page.
+	Place Place
+	// Foreground defines that the watermark is drawn above the content of the page, instead of below it.
+	Foreground bool
+}
+
+// ToMap returns a map with the Watermark fields.
+func (w *Watermark) ToMap() map[string]interface{} {
+	m := make(map[string]interface{})
+
+	if w.Family != "" {
+		m["prop_font_family"] = w.Family
+	}
+
+	if w.Style != "" {
+		m["prop_font_style"] = w.Style
+	}
+
+	if w.Size != 0 {
+		m["prop_font_size"] = w.Size
+	}
+
+	if w.Color != nil {
+		m["prop_color"] = w.Color.ToString()
+	}
+
+	if w.Angle != 0 {
+		m["prop_angle"] = w.Angle
+	}
+
+	if w.Opacity != 0 {
+		m["prop_opacity"] = w.Opacity
+	}
+
+	if w.Percent != 0 {
+		m["prop_percent"] = w.Percent
+	}
+
+	if w.Place != "" {
+		m["prop_place"] = w.Place
+	}
+
+	if w.Foreground {
+		m["prop_foreground"] = w.Foreground
+	}
+
+	return m
+}
+
+// AppendMap appends the Watermark fields to a map.
+func (w *Watermark) AppendMap(m map[string]interface{}) map[string]interface{} {
+	for key, value := range w.ToMap() {
+		m["watermark_"+strings.TrimPrefix(key, "prop_")] = value
+	}
+
+	return m
+}
+
+// ToTextProp from Watermark return a Text based on Watermark, centralized in its cell.
+func (w *Watermark) ToTextProp() *Text {
+	return &Text{
+		Family: w.Family,
+		Style:  w.Style,
+		Size:   w.Size,
+		Color:  w.Color,
+		Align:  align.Center,
+	}
+}
+
+// MakeValid from Watermark define default values for a Watermark, the font of the text
+// is the default font with a bigger size when it is not defined.
+func (w *Watermark) MakeValid(font *Font) {
+	if w.Family == "" {
+		w.Family = font.Family
+	}
+
+	if w.Style == "" {
+		w.Style = font.Style
+	}
+
+	if w.Size <= 0 {
+		w.Size = 80
+	}
+
+	if w.Color == nil {
+		w.Color = font.Color
+	}
+
+	if w.Opacity <= 0 {
+		w.Opacity = 0.3
+	}
+
+	if w.Opacity > 1 {
+		w.Opacity = 1
+	}
+
+	if w.Percent <= 0 || w.Percent > 100 {
+		w.Percent = 50
+	}
+
+	if !w.Place.IsValid() {
+		w.Place = ""
+	}
+}
@@ -0,0 +1,113 @@
+package props_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/pkg/consts/align"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontfamily"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+func TestWatermark_ToMap(t *testing.T) {
+	t.Run("when prop is empty, should return empty map", func(t *testing.T) {
+		// Arrange
+		sut := props.Watermark{}
+
+		// Act
+		m := sut.ToMap()
+
+		// Assert
+		assert.Empty(t, m)
+	})
+	t.Run("when prop is filled, should return filled map", func(t *testing.T) {
+		// Arrange
+		sut := props.Watermark{
+			Family:     fontfamily.Helvetica,
+			Style:      fontstyle.Bold,
+			Size:       60,
+			Color:      &props.RedColor,
+			Angle:      45,
+			Opacity:    0.5,
+			Percent:    30,
+			Place:      props.RightTop,
+			Foreground: true,
+		}
+
+		// Act
+		m := sut.ToMap()
+
+		// Assert
+		assert.Equal(t, fontfamily.Helvetica, m["prop_font_family"])
+		assert.Equal(t, fontstyle.Bold, m["prop_font_style"])
+		assert.Equal(t, 60.0, m["prop_font_size"])
+		assert.Equal(t, "RGB(255, 0, 0)", m["prop_color"])
+		assert.Equal(t, 45.0, m["prop_angle"])
+		assert.Equal(t, 0.5, m["prop_opacity"])
+		assert.Equal(t, 30.0, m["prop_percent"])
+		assert.Equal(t, props.RightTop, m["prop_place"])
+		assert.Equal(t, true, m["prop_foreground"])
+	})
+}
+
+func TestWatermark_AppendMap(t *testing.T) {
+	t.Run("should append the fields with the watermark prefix", func(t *testing.T) {
+		// Arrange
+		sut := props.Watermark{Angle: 45}
+
+		// Act
+		m := sut.AppendMap(map[string]interface{}{"config_debug": true})
+
+		// Assert
+		assert.Equal(t, map[string]interface{}{"config_debug": true, "watermark_angle": 45.0}, m)
+	})
+}
+
+func TestWatermark_MakeValid(t *testing.T) {
+	t.Run("when prop is empty, should use a big default font and a low opacity", func(t *testing.T) {
+		// Arrange
+		font := fixture.FontProp()
+		sut := props.Watermark{Place: "center", Percent: 120}
+
+		// Act
+		sut.MakeValid(&font)
+
+		// Assert
+		assert.Equal(t, font.Family, sut.Family)
+		assert.Equal(t, font.Style, sut.Style)
+		assert.Equal(t, 80.0, sut.Size)
+		assert.Equal(t, font.Color, sut.Color)
+		assert.Equal(t, 0.3, sut.Opacity)
+		assert.Equal(t, 50.0, sut.Percent)
+		assert.Equal(t, props.Place(""), sut.Place)
+	})
+	t.Run("when opacity is greater than 1, should use 1", func(t *testing.T) {
+		// Arrange
+		font := fixture.FontProp()
+		sut := props.Watermark{Opacity: 2}
+
+		// Act
+		sut.MakeValid(&font)
+
+		// Assert
+		assert.Equal(t, 1.0, sut.Opacity)
+	})
+}
+
+func TestWatermark_ToTextProp(t *testing.T) {
+	t.Run("should return the centralized text of the watermark", func(t *testing.T) {
+		// Arrange
+		sut := props.Watermark{Family: fontfamily.Courier, Size: 60}
+
+		// Act
+		text := sut.ToTextProp()
+
+		// Assert
+		assert.Equal(t, fontfamily.Courier, text.Family)
+		assert.Equal(t, 60.0, text.Size)
+		assert.Equal(t, align.Center, text.Align)
+	})
+}